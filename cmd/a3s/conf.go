@@ -1,15 +1,21 @@
 package main
 
 import (
+	"context"
 	"crypto"
 	"crypto/x509"
 	"fmt"
+	"net/url"
+	"sort"
 	"strings"
 	"time"
 
+	"go.aporeto.io/a3s/internal/mfa"
 	"go.aporeto.io/a3s/pkgs/authenticator"
 	"go.aporeto.io/a3s/pkgs/conf"
 	"go.aporeto.io/a3s/pkgs/lombric"
+	"go.aporeto.io/a3s/pkgs/signer"
+	"go.aporeto.io/a3s/pkgs/token"
 	"go.aporeto.io/tg/tglib"
 )
 
@@ -29,6 +35,7 @@ type Conf struct {
 	InitData           string `mapstructure:"init-data"         desc:"Path to an import file containing initial provisionning data"`
 
 	JWT        JWTConf        `mapstructure:",squash"`
+	MFA        MFAConf        `mapstructure:",squash"`
 	MTLSHeader MTLSHeaderConf `mapstructure:",squash"`
 
 	conf.APIServerConf       `mapstructure:",squash"`
@@ -64,9 +71,14 @@ type JWTConf struct {
 	JWTCookiePolicy    string        `mapstructure:"jwt-cookie-policy"    desc:"Define same site policy applied to token cookies"      default:"strict" allowed:"strict,lax,none"`
 	JWTDefaultValidity time.Duration `mapstructure:"jwt-default-validity" desc:"Default duration of the validity of the issued tokens" default:"24h"`
 	JWTIssuer          string        `mapstructure:"jwt-issuer"           desc:"Value used for issuer jwt field"`
+	JWTKeyAlgorithm    string        `mapstructure:"jwt-key-algorithm"    desc:"Signing algorithm to use with the JWT key, like RS256, PS256 or EdDSA. If empty, it is derived from the key type"`
 	JWTKeyPass         string        `mapstructure:"jwt-key-pass"         desc:"JWT certificate key password"                          secret:"true" file:"true"`
-	JWTKeyPath         string        `mapstructure:"jwt-key"              desc:"Path to the JWT certificate key pem file"              secret:"true" file:"true"`
+	JWTKeyPath         string        `mapstructure:"jwt-key"              desc:"Path to the JWT certificate key pem file, or socket:<name> to use the key <name> of the signing daemon" secret:"true" file:"true"`
+	JWTKeyPublishLead  time.Duration `mapstructure:"jwt-key-publish-lead" desc:"Duration a rotation key is published in the JWKS before it becomes active" default:"168h"`
+	JWTKeyRetireGrace  time.Duration `mapstructure:"jwt-key-retire-grace" desc:"Duration a retired key stays in the JWKS. If not set, --jwt-max-validity is used"`
 	JWTMaxValidity     time.Duration `mapstructure:"jwt-max-validity"     desc:"Maximum duration of the validity of the issued tokens" default:"720h"`
+	JWTRotationKeys    []string      `mapstructure:"jwt-rotation-key"     desc:"List of additional signing keys in the form cert-path,key-path[,algorithm]@activation-date (RFC3339). A key retires when the next one activates"`
+	JWTSignerSocket    string        `mapstructure:"jwt-signer-socket"    desc:"Path to the unix socket of an external signing daemon holding the keys passed as socket:<name>"`
 	JWTTrustedIssuers  []string      `mapstructure:"jwt-trusted-issuer"   desc:"List of externally trusted issuers"`
 
 	jwtCert *x509.Certificate
@@ -80,15 +92,122 @@ func (c *JWTConf) JWTCertificate() (*x509.Certificate, crypto.PrivateKey, error)
 		return c.jwtCert, c.jwtKey, nil
 	}
 
-	jwtCert, jwtKey, err := tglib.ReadCertificatePEM(c.JWTCertPath, c.JWTKeyPath, c.JWTKeyPass)
+	p, err := c.signerProvider(c.JWTCertPath, c.JWTKeyPath)
 	if err != nil {
 		return nil, nil, fmt.Errorf("unable to read jwt certificate: %w", err)
 	}
 
-	c.jwtCert = jwtCert
-	c.jwtKey = jwtKey
+	c.jwtCert = p.Certificate()
+	c.jwtKey = p.Signer()
 
-	return jwtCert, jwtKey, nil
+	return c.jwtCert, c.jwtKey, nil
+}
+
+// signerProvider returns the signer.Provider for the given certificate
+// and key paths. If the key path is in the form socket:<name>, the key
+// <name> of the signing daemon is used, and the certificate path is ignored.
+func (c *JWTConf) signerProvider(certPath string, keyPath string) (signer.Provider, error) {
+
+	if name, ok := strings.CutPrefix(keyPath, "socket:"); ok {
+
+		if c.JWTSignerSocket == "" {
+			return nil, fmt.Errorf("--jwt-signer-socket must be set to use the key '%s'", keyPath)
+		}
+
+		return signer.NewSocketProvider(context.Background(), c.JWTSignerSocket, name)
+	}
+
+	return signer.NewFileProvider(certPath, keyPath, c.JWTKeyPass)
+}
+
+// JWKS builds the JWKS containing the main JWT certificate and
+// all the keys passed through --jwt-rotation-key, with their
+// rotation schedule. The main JWT certificate is considered active
+// since forever, and every key retires when the next one activates.
+func (c *JWTConf) JWKS() (*token.JWKS, error) {
+
+	jwtCert, jwtKey, err := c.JWTCertificate()
+	if err != nil {
+		return nil, err
+	}
+
+	type rotationKey struct {
+		cert       *x509.Certificate
+		key        crypto.PrivateKey
+		algorithm  string
+		activation time.Time
+	}
+
+	keys := make([]rotationKey, 0, len(c.JWTRotationKeys)+1)
+	keys = append(keys, rotationKey{cert: jwtCert, key: jwtKey, algorithm: c.JWTKeyAlgorithm})
+
+	for _, r := range c.JWTRotationKeys {
+
+		parts := strings.SplitN(r, "@", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid rotation key '%s': must be in the form cert-path,key-path[,algorithm]@activation-date", r)
+		}
+
+		paths := strings.SplitN(parts[0], ",", 3)
+		if len(paths) < 2 {
+			return nil, fmt.Errorf("invalid rotation key '%s': must be in the form cert-path,key-path[,algorithm]@activation-date", r)
+		}
+
+		var algorithm string
+		if len(paths) == 3 {
+			algorithm = paths[2]
+		}
+
+		activation, err := time.Parse(time.RFC3339, parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid activation date for rotation key '%s': %w", r, err)
+		}
+
+		p, err := c.signerProvider(paths[0], paths[1])
+		if err != nil {
+			return nil, fmt.Errorf("unable to read rotation key '%s': %w", r, err)
+		}
+
+		keys = append(keys, rotationKey{cert: p.Certificate(), key: p.Signer(), algorithm: algorithm, activation: activation})
+	}
+
+	sort.SliceStable(keys[1:], func(i, j int) bool {
+		return keys[i+1].activation.Before(keys[j+1].activation)
+	})
+
+	grace := c.JWTKeyRetireGrace
+	if grace == 0 {
+		grace = c.JWTMaxValidity
+	}
+
+	jwks := token.NewJWKS()
+
+	for i, k := range keys {
+
+		schedule := token.KeySchedule{
+			ActivateTime: k.activation,
+		}
+
+		if !k.activation.IsZero() {
+			schedule.PublishTime = k.activation.Add(-c.JWTKeyPublishLead)
+		}
+
+		if i < len(keys)-1 {
+			schedule.RetireTime = keys[i+1].activation
+			schedule.ExpireTime = schedule.RetireTime.Add(grace)
+		}
+
+		if err := jwks.AppendWithPrivate(
+			k.cert,
+			k.key,
+			token.OptionKeySchedule(schedule),
+			token.OptionKeyAlgorithm(k.algorithm),
+		); err != nil {
+			return nil, fmt.Errorf("unable to add key '%s' to the JWKS: %w", token.Fingerprint(k.cert), err)
+		}
+	}
+
+	return jwks, nil
 }
 
 // TrustedIssuers parses --jwt-trusted-issuers and returns a list
@@ -130,3 +249,37 @@ type MTLSHeaderConf struct {
 	HeaderKey  string `mapstructure:"mtls-header-key"        desc:"The header to check for user certificates" default:"x-tls-certificate"`
 	Passphrase string `mapstructure:"mtls-header-passphrase" desc:"The passphrase to decrypt the AES encrypted header content. It is mandatory if --mtls-header-enabled is set."`
 }
+
+// MFAConf holds the configuration for the second factors.
+type MFAConf struct {
+	IssuerName      string   `mapstructure:"mfa-issuer-name"     desc:"Name displayed by authenticator apps and WebAuthn authenticators" default:"a3s"`
+	WebAuthnRPID    string   `mapstructure:"mfa-webauthn-rp-id"  desc:"The WebAuthn relying party ID. If empty, the host of --public-api-url is used"`
+	WebAuthnOrigins []string `mapstructure:"mfa-webauthn-origin" desc:"List of origins WebAuthn ceremonies are allowed from. If empty, the origin of --public-api-url is used"`
+}
+
+// RelyingParty returns the WebAuthn relying party. If the relying
+// party ID or the origins are not set, they are derived from
+// the given public API URL.
+func (c *MFAConf) RelyingParty(publicAPIURL string) (*mfa.RelyingParty, error) {
+
+	u, err := url.Parse(publicAPIURL)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse public api url: %w", err)
+	}
+
+	rp := &mfa.RelyingParty{
+		ID:      c.WebAuthnRPID,
+		Name:    c.IssuerName,
+		Origins: c.WebAuthnOrigins,
+	}
+
+	if rp.ID == "" {
+		rp.ID = u.Hostname()
+	}
+
+	if len(rp.Origins) == 0 {
+		rp.Origins = []string{fmt.Sprintf("%s://%s", u.Scheme, u.Host)}
+	}
+
+	return rp, nil
+}
@@ -0,0 +1,250 @@
+package main
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"go.aporeto.io/a3s/pkgs/token"
+)
+
+func writeTestCert(dir string, name string, useRSA bool) (*x509.Certificate, string, string) {
+
+	var key crypto.Signer
+	var keyBlock *pem.Block
+
+	if useRSA {
+		k, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			panic(err)
+		}
+		key = k
+		keyBlock = &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(k)}
+	} else {
+		k, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			panic(err)
+		}
+		der, err := x509.MarshalECPrivateKey(k)
+		if err != nil {
+			panic(err)
+		}
+		key = k
+		keyBlock = &pem.Block{Type: "EC PRIVATE KEY", Bytes: der}
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		panic(err)
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		panic(err)
+	}
+
+	certPath := filepath.Join(dir, name+"-cert.pem")
+	keyPath := filepath.Join(dir, name+"-key.pem")
+
+	if err := os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600); err != nil {
+		panic(err)
+	}
+	if err := os.WriteFile(keyPath, pem.EncodeToMemory(keyBlock), 0600); err != nil {
+		panic(err)
+	}
+
+	return cert, certPath, keyPath
+}
+
+func TestJWTConf_JWKS(t *testing.T) {
+
+	dir := t.TempDir()
+
+	mainCert, mainCertPath, mainKeyPath := writeTestCert(dir, "main", false)
+	cert1, cert1Path, key1Path := writeTestCert(dir, "key1", false)
+	cert2, cert2Path, key2Path := writeTestCert(dir, "key2", false)
+	cert3, cert3Path, key3Path := writeTestCert(dir, "key3", true)
+
+	t1 := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := time.Date(2030, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	lead := 24 * time.Hour
+	maxValidity := 720 * time.Hour
+
+	key1 := fmt.Sprintf("%s,%s@%s", cert1Path, key1Path, t1.Format(time.RFC3339))
+	key2 := fmt.Sprintf("%s,%s@%s", cert2Path, key2Path, t2.Format(time.RFC3339))
+
+	tests := []struct {
+		name         string
+		rotationKeys []string
+		grace        time.Duration
+
+		wantKIDs      []string
+		wantAlgs      []string
+		wantSchedules []token.KeySchedule
+		wantErr       string
+	}{
+		{
+			"no rotation key",
+			nil,
+			0,
+			[]string{token.Fingerprint(mainCert)},
+			[]string{"ES256"},
+			[]token.KeySchedule{{}},
+			"",
+		},
+		{
+			"rotation keys in order",
+			[]string{key1, key2},
+			0,
+			[]string{token.Fingerprint(mainCert), token.Fingerprint(cert1), token.Fingerprint(cert2)},
+			[]string{"ES256", "ES256", "ES256"},
+			[]token.KeySchedule{
+				{RetireTime: t1, ExpireTime: t1.Add(maxValidity)},
+				{PublishTime: t1.Add(-lead), ActivateTime: t1, RetireTime: t2, ExpireTime: t2.Add(maxValidity)},
+				{PublishTime: t2.Add(-lead), ActivateTime: t2},
+			},
+			"",
+		},
+		{
+			"rotation keys out of order",
+			[]string{key2, key1},
+			0,
+			[]string{token.Fingerprint(mainCert), token.Fingerprint(cert1), token.Fingerprint(cert2)},
+			[]string{"ES256", "ES256", "ES256"},
+			[]token.KeySchedule{
+				{RetireTime: t1, ExpireTime: t1.Add(maxValidity)},
+				{PublishTime: t1.Add(-lead), ActivateTime: t1, RetireTime: t2, ExpireTime: t2.Add(maxValidity)},
+				{PublishTime: t2.Add(-lead), ActivateTime: t2},
+			},
+			"",
+		},
+		{
+			"rotation key with retire grace",
+			[]string{key1},
+			time.Hour,
+			[]string{token.Fingerprint(mainCert), token.Fingerprint(cert1)},
+			[]string{"ES256", "ES256"},
+			[]token.KeySchedule{
+				{RetireTime: t1, ExpireTime: t1.Add(time.Hour)},
+				{PublishTime: t1.Add(-lead), ActivateTime: t1},
+			},
+			"",
+		},
+		{
+			"rotation key with algorithm",
+			[]string{fmt.Sprintf("%s,%s,PS256@%s", cert3Path, key3Path, t1.Format(time.RFC3339))},
+			0,
+			[]string{token.Fingerprint(mainCert), token.Fingerprint(cert3)},
+			[]string{"ES256", "PS256"},
+			[]token.KeySchedule{
+				{RetireTime: t1, ExpireTime: t1.Add(maxValidity)},
+				{PublishTime: t1.Add(-lead), ActivateTime: t1},
+			},
+			"",
+		},
+		{
+			"rotation key with incompatible algorithm",
+			[]string{fmt.Sprintf("%s,%s,RS256@%s", cert1Path, key1Path, t1.Format(time.RFC3339))},
+			0,
+			nil,
+			nil,
+			nil,
+			fmt.Sprintf("unable to add key '%s' to the JWKS: %s", token.Fingerprint(cert1), token.ErrJWKSInvalidAlgorithm),
+		},
+		{
+			"rotation key with invalid activation date",
+			[]string{fmt.Sprintf("%s,%s@tomorrow", cert1Path, key1Path)},
+			0,
+			nil,
+			nil,
+			nil,
+			fmt.Sprintf("invalid activation date for rotation key '%s,%s@tomorrow': ", cert1Path, key1Path),
+		},
+		{
+			"rotation key without activation date",
+			[]string{fmt.Sprintf("%s,%s", cert1Path, key1Path)},
+			0,
+			nil,
+			nil,
+			nil,
+			fmt.Sprintf("invalid rotation key '%s,%s': must be in the form cert-path,key-path[,algorithm]@activation-date", cert1Path, key1Path),
+		},
+		{
+			"rotation key without key path",
+			[]string{fmt.Sprintf("%s@%s", cert1Path, t1.Format(time.RFC3339))},
+			0,
+			nil,
+			nil,
+			nil,
+			fmt.Sprintf("invalid rotation key '%s@%s': must be in the form cert-path,key-path[,algorithm]@activation-date", cert1Path, t1.Format(time.RFC3339)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			c := &JWTConf{
+				JWTCertPath:       mainCertPath,
+				JWTKeyPath:        mainKeyPath,
+				JWTKeyPublishLead: lead,
+				JWTKeyRetireGrace: tt.grace,
+				JWTMaxValidity:    maxValidity,
+				JWTRotationKeys:   tt.rotationKeys,
+			}
+
+			jwks, err := c.JWKS()
+
+			if tt.wantErr != "" {
+				if err == nil || !strings.HasPrefix(err.Error(), tt.wantErr) {
+					t.Fatalf("JWKS() error = %v, wantErr %s", err, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("JWKS() unexpected error = %v", err)
+			}
+
+			var kids, algs []string
+			var schedules []token.KeySchedule
+			for _, k := range jwks.Keys {
+				kids = append(kids, k.KID)
+				algs = append(algs, k.Alg)
+				schedules = append(schedules, k.Schedule())
+			}
+
+			if !reflect.DeepEqual(kids, tt.wantKIDs) {
+				t.Errorf("JWKS() kids = %v, want %v", kids, tt.wantKIDs)
+			}
+
+			if !reflect.DeepEqual(algs, tt.wantAlgs) {
+				t.Errorf("JWKS() algs = %v, want %v", algs, tt.wantAlgs)
+			}
+
+			if !reflect.DeepEqual(schedules, tt.wantSchedules) {
+				t.Errorf("JWKS() schedules = %v, want %v", schedules, tt.wantSchedules)
+			}
+		})
+	}
+}
@@ -15,7 +15,12 @@ import (
 
 	"github.com/ghodss/yaml"
 	"go.aporeto.io/a3s/internal/hasher"
+	"go.aporeto.io/a3s/internal/introspection"
+	"go.aporeto.io/a3s/internal/mfa"
 	"go.aporeto.io/a3s/internal/processors"
+	"go.aporeto.io/a3s/internal/reference"
+	"go.aporeto.io/a3s/internal/refreshfamily"
+	"go.aporeto.io/a3s/internal/samlceremony"
 	"go.aporeto.io/a3s/internal/ui"
 	"go.aporeto.io/a3s/pkgs/api"
 	"go.aporeto.io/a3s/pkgs/authenticator"
@@ -30,6 +35,7 @@ import (
 	"go.aporeto.io/a3s/pkgs/nscache"
 	"go.aporeto.io/a3s/pkgs/permissions"
 	"go.aporeto.io/a3s/pkgs/push"
+	"go.aporeto.io/a3s/pkgs/revocation"
 	"go.aporeto.io/a3s/pkgs/token"
 	"go.aporeto.io/bahamut"
 	"go.aporeto.io/elemental"
@@ -50,6 +56,12 @@ var (
 		api.IssueIdentity.Category,
 		api.PermissionsIdentity.Category,
 		api.AuthzIdentity.Category,
+		api.PasswordChangeIdentity.Category,
+	}
+	// selfServiceResources only require the
+	// request to be authenticated.
+	selfServiceResources = []string{
+		api.MFAEnrollmentIdentity.Category,
 	}
 	pushExcludedResources = []elemental.Identity{
 		api.PermissionsIdentity,
@@ -57,6 +69,10 @@ var (
 		// safety: these ones are not an identifiable, so it would not be pushed anyway.
 		api.IssueIdentity,
 		api.AuthzIdentity,
+		api.SigningKeyIdentity,
+		api.PasswordChangeIdentity,
+		api.PasswordResetIdentity,
+		api.MFAEnrollmentIdentity,
 	}
 )
 
@@ -95,6 +111,26 @@ func main() {
 		zap.L().Fatal("Unable to create exp expiration index for oidccache", zap.Error(err))
 	}
 
+	if err := manipmongo.EnsureIndex(m, elemental.MakeIdentity(samlceremony.CacheCollection, samlceremony.CacheCollection), mongo.IndexModel{
+		Keys:    bson.D{{Key: "time", Value: 1}},
+		Options: options.Index().SetName("index_expiration_exp").SetExpireAfterSeconds(300),
+	}); err != nil {
+		zap.L().Fatal("Unable to create exp expiration index for samlcache", zap.Error(err))
+	}
+
+	if err := manipmongo.EnsureIndex(m, elemental.MakeIdentity(mfa.CacheCollection, mfa.CacheCollection),
+		mongo.IndexModel{
+			Keys:    bson.D{{Key: "time", Value: 1}},
+			Options: options.Index().SetName("index_expiration_exp").SetExpireAfterSeconds(300),
+		},
+		mongo.IndexModel{
+			Keys:    bson.D{{Key: "challenge", Value: 1}},
+			Options: options.Index().SetName("index_challenge"),
+		},
+	); err != nil {
+		zap.L().Fatal("Unable to create indexes for mfacache", zap.Error(err))
+	}
+
 	if err := manipmongo.EnsureIndex(m, api.NamespaceDeletionRecordIdentity, mongo.IndexModel{
 		Keys:    bson.D{{Key: "deletetime", Value: 1}},
 		Options: options.Index().SetName("index_expiration_deletetime").SetExpireAfterSeconds(int32((24 * time.Hour).Seconds())),
@@ -102,6 +138,33 @@ func main() {
 		zap.L().Fatal("Unable to create expiration index for namesapce deletion records", zap.Error(err))
 	}
 
+	if err := manipmongo.EnsureIndex(m, api.RevocationIdentity, mongo.IndexModel{
+		Keys:    bson.D{{Key: "expiration", Value: 1}},
+		Options: options.Index().SetName("index_expiration_expiration").SetExpireAfterSeconds(0),
+	}); err != nil {
+		zap.L().Fatal("Unable to create expiration index for revocations", zap.Error(err))
+	}
+
+	if err := manipmongo.EnsureIndex(m, refreshfamily.Identity, mongo.IndexModel{
+		Keys:    bson.D{{Key: "expiration", Value: 1}},
+		Options: options.Index().SetName("index_expiration_expiration").SetExpireAfterSeconds(0),
+	}); err != nil {
+		zap.L().Fatal("Unable to create expiration index for refresh families", zap.Error(err))
+	}
+
+	if err := manipmongo.EnsureIndex(m, reference.Identity,
+		mongo.IndexModel{
+			Keys:    bson.D{{Key: "expiration", Value: 1}},
+			Options: options.Index().SetName("index_expiration_expiration").SetExpireAfterSeconds(0),
+		},
+		mongo.IndexModel{
+			Keys:    bson.D{{Key: "tokenid", Value: 1}},
+			Options: options.Index().SetName("index_tokenid"),
+		},
+	); err != nil {
+		zap.L().Fatal("Unable to create indexes for references", zap.Error(err))
+	}
+
 	if err := createRootNamespaceIfNeeded(m); err != nil {
 		zap.L().Fatal("Unable to handle root namespace", zap.Error(err))
 	}
@@ -148,9 +211,9 @@ func main() {
 		}
 	}
 
-	jwtCert, jwtKey, err := cfg.JWT.JWTCertificate()
+	jwks, err := cfg.JWT.JWKS()
 	if err != nil {
-		zap.L().Fatal("Unable to get JWT certificate", zap.Error(err))
+		zap.L().Fatal("Unable to build JWKS", zap.Error(err))
 	}
 
 	zap.L().Info("JWT info configured",
@@ -158,9 +221,13 @@ func main() {
 		zap.String("aud", cfg.JWT.JWTAudience),
 	)
 
-	jwks := token.NewJWKS()
-	if err := jwks.AppendWithPrivate(jwtCert, jwtKey); err != nil {
-		zap.L().Fatal("unable to build JWKS", zap.Error(err))
+	for _, k := range jwks.Keys {
+		zap.L().Info("JWT signing key configured",
+			zap.String("kid", k.KID),
+			zap.String("state", string(k.State(time.Now()))),
+			zap.Time("activate", k.Schedule().ActivateTime),
+			zap.Time("retire", k.Schedule().RetireTime),
+		)
 	}
 
 	if cfg.MTLSHeader.Enabled {
@@ -208,6 +275,12 @@ func main() {
 	}
 	zap.L().Info("Cookie domain set", zap.String("domain", cookieDomain))
 
+	webAuthn, err := cfg.MFA.RelyingParty(publicAPIURL)
+	if err != nil {
+		zap.L().Fatal("Unable to configure WebAuthn relying party", zap.Error(err))
+	}
+	zap.L().Info("WebAuthn relying party set", zap.String("id", webAuthn.ID), zap.Strings("origins", webAuthn.Origins))
+
 	trustedIssuers, err := cfg.JWT.TrustedIssuers()
 	if err != nil {
 		zap.L().Fatal("Unable to build trusted issuers list", zap.Error(err))
@@ -239,19 +312,37 @@ func main() {
 	pubsub := bootstrap.MakeNATSClient(cfg.NATSConf)
 	defer pubsub.Disconnect() // nolint: errcheck
 
+	revocations := revocation.NewCache(pubsub)
+	revocations.Start(ctx)
+	if err := revocations.Load(ctx, m); err != nil {
+		zap.L().Fatal("Unable to load revocations", zap.Error(err))
+	}
+
+	references := reference.NewResolver(m)
+
+	authnOptions := []authenticator.Option{
+		authenticator.OptionIgnoredResources(publicResources...),
+		authenticator.OptionExternalTrustedIssuers(trustedIssuers...),
+		authenticator.OptionRevocations(revocations),
+		authenticator.OptionReferenceResolver(references),
+	}
+	if cfg.MTLSHeader.Enabled {
+		authnOptions = append(authnOptions, authenticator.OptionMTLSHeader(cfg.MTLSHeader.HeaderKey, cfg.MTLSHeader.Passphrase))
+	}
+
 	pauthn := authenticator.New(
 		jwks,
 		cfg.JWT.JWTIssuer,
 		cfg.JWT.JWTAudience,
-		authenticator.OptionIgnoredResources(publicResources...),
-		authenticator.OptionExternalTrustedIssuers(trustedIssuers...),
+		authnOptions...,
 	)
 	retriever := permissions.NewRetriever(m)
 	pauthz := authorizer.New(
 		ctx,
 		retriever,
 		pubsub,
-		authorizer.OptionIgnoredResources(publicResources...),
+		authorizer.OptionIgnoredResources(append(publicResources, selfServiceResources...)...),
+		authorizer.OptionReferenceResolver(references),
 	)
 
 	opts := append(
@@ -321,6 +412,14 @@ func main() {
 		zap.L().Fatal("Unable to install jwks handler", zap.Error(err))
 	}
 
+	if err := server.RegisterCustomRouteHandler("/.well-known/openid-configuration", makeDiscoveryHandler(cfg.JWT.JWTIssuer, publicAPIURL, jwks)); err != nil {
+		zap.L().Fatal("Unable to install discovery handler", zap.Error(err))
+	}
+
+	if err := server.RegisterCustomRouteHandler("/oauth2/introspect", introspection.MakeHandler(pauthn, pauthz)); err != nil {
+		zap.L().Fatal("Unable to install introspection handler", zap.Error(err))
+	}
+
 	if err := server.RegisterCustomRouteHandler("/ui/login.html", makeUILoginHandler(publicAPIURL)); err != nil {
 		zap.L().Fatal("Unable to install UI login handler", zap.Error(err))
 	}
@@ -330,10 +429,23 @@ func main() {
 		zap.L().Fatal("Unable to install UI request handler", zap.Error(err))
 	}
 
+	if err := server.RegisterCustomRouteHandler(samlceremony.MetadataPath, samlceremony.MakeMetadataHandler(m, publicAPIURL)); err != nil {
+		zap.L().Fatal("Unable to install SAML metadata handler", zap.Error(err))
+	}
+
+	if err := server.RegisterCustomRouteHandler(samlceremony.ACSPath, samlceremony.MakeACSHandler(m)); err != nil {
+		zap.L().Fatal("Unable to install SAML assertion consumer service handler", zap.Error(err))
+	}
+
+	revocationsProcessor := processors.NewRevocationsProcessor(m, pubsub, server.Push, jwks, cfg.JWT.JWTIssuer, cfg.JWT.JWTMaxValidity)
+
 	bahamut.RegisterProcessorOrDie(server,
 		processors.NewIssueProcessor(
 			m,
 			jwks,
+			revocations,
+			revocationsProcessor,
+			pauthz,
 			cfg.JWT.JWTDefaultValidity,
 			cfg.JWT.JWTMaxValidity,
 			cfg.JWT.JWTIssuer,
@@ -343,6 +455,8 @@ func main() {
 			cfg.MTLSHeader.Enabled,
 			cfg.MTLSHeader.HeaderKey,
 			cfg.MTLSHeader.Passphrase,
+			publicAPIURL,
+			webAuthn,
 		),
 		api.IssueIdentity,
 	)
@@ -351,12 +465,27 @@ func main() {
 	bahamut.RegisterProcessorOrDie(server, processors.NewOIDCSourcesProcessor(m), api.OIDCSourceIdentity)
 	bahamut.RegisterProcessorOrDie(server, processors.NewHTTPSourcesProcessor(m), api.HTTPSourceIdentity)
 	bahamut.RegisterProcessorOrDie(server, processors.NewA3SSourcesProcessor(m), api.A3SSourceIdentity)
+	bahamut.RegisterProcessorOrDie(server, processors.NewAWSSourcesProcessor(m), api.AWSSourceIdentity)
+	bahamut.RegisterProcessorOrDie(server, processors.NewAzureSourcesProcessor(m), api.AzureSourceIdentity)
+	bahamut.RegisterProcessorOrDie(server, processors.NewGCPSourcesProcessor(m), api.GCPSourceIdentity)
+	bahamut.RegisterProcessorOrDie(server, processors.NewKubernetesSourcesProcessor(m), api.KubernetesSourceIdentity)
+	bahamut.RegisterProcessorOrDie(server, processors.NewJWTSourcesProcessor(m), api.JWTSourceIdentity)
+	bahamut.RegisterProcessorOrDie(server, processors.NewSPIFFESourcesProcessor(m), api.SPIFFESourceIdentity)
+	bahamut.RegisterProcessorOrDie(server, processors.NewLocalSourcesProcessor(m), api.LocalSourceIdentity)
+	bahamut.RegisterProcessorOrDie(server, processors.NewLocalUsersProcessor(m), api.LocalUserIdentity)
+	bahamut.RegisterProcessorOrDie(server, processors.NewPasswordChangesProcessor(m), api.PasswordChangeIdentity)
+	bahamut.RegisterProcessorOrDie(server, processors.NewPasswordResetsProcessor(m), api.PasswordResetIdentity)
+	bahamut.RegisterProcessorOrDie(server, processors.NewMFACredentialsProcessor(m), api.MFACredentialIdentity)
+	bahamut.RegisterProcessorOrDie(server, processors.NewMFAEnrollmentsProcessor(m, webAuthn, cfg.MFA.IssuerName, cfg.JWT.JWTIssuer), api.MFAEnrollmentIdentity)
+	bahamut.RegisterProcessorOrDie(server, processors.NewSAMLSourcesProcessor(m), api.SAMLSourceIdentity)
 	bahamut.RegisterProcessorOrDie(server, processors.NewPermissionsProcessor(retriever), api.PermissionsIdentity)
-	bahamut.RegisterProcessorOrDie(server, processors.NewAuthzProcessor(pauthz, jwks, cfg.JWT.JWTIssuer, cfg.JWT.JWTAudience), api.AuthzIdentity)
+	bahamut.RegisterProcessorOrDie(server, processors.NewAuthzProcessor(pauthz, jwks, revocations, references, cfg.JWT.JWTIssuer, cfg.JWT.JWTAudience), api.AuthzIdentity)
 	bahamut.RegisterProcessorOrDie(server, processors.NewNamespacesProcessor(m, pubsub), api.NamespaceIdentity)
 	bahamut.RegisterProcessorOrDie(server, processors.NewNamespaceDeletionRecordsProcessor(m), api.NamespaceDeletionRecordIdentity)
 	bahamut.RegisterProcessorOrDie(server, processors.NewAuthorizationProcessor(m, pubsub, retriever, cfg.JWT.JWTIssuer), api.AuthorizationIdentity)
-	bahamut.RegisterProcessorOrDie(server, processors.NewImportProcessor(bmanipMaker, pauthz), api.ImportIdentity)
+	bahamut.RegisterProcessorOrDie(server, processors.NewImportProcessor(bmanipMaker, pauthz, references), api.ImportIdentity)
+	bahamut.RegisterProcessorOrDie(server, processors.NewSigningKeysProcessor(jwks), api.SigningKeyIdentity)
+	bahamut.RegisterProcessorOrDie(server, revocationsProcessor, api.RevocationIdentity)
 
 	// Object clean up
 	notification.Subscribe(
@@ -630,6 +759,14 @@ func initData(ctx context.Context, m manipulate.Manipulator, dataPath string) (b
 		importFile.LDAPSources,
 		importFile.OIDCSources,
 		importFile.A3SSources,
+		importFile.AWSSources,
+		importFile.AzureSources,
+		importFile.GCPSources,
+		importFile.KubernetesSources,
+		importFile.JWTSources,
+		importFile.SPIFFESources,
+		importFile.LocalSources,
+		importFile.SAMLSources,
 		importFile.MTLSSources,
 		importFile.HTTPSources,
 		importFile.Authorizations,
@@ -673,10 +810,22 @@ func makeJWKSHandler(jwks *token.JWKS) http.HandlerFunc {
 
 	return func(w http.ResponseWriter, req *http.Request) {
 
-		jwks.RLock()
-		defer jwks.RUnlock()
+		data, err := elemental.Encode(elemental.EncodingTypeJSON, jwks.Published())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = w.Write(data)
+	}
+}
+
+func makeDiscoveryHandler(issuer string, api string, jwks *token.JWKS) http.HandlerFunc {
+
+	return func(w http.ResponseWriter, req *http.Request) {
 
-		data, err := elemental.Encode(elemental.EncodingTypeJSON, jwks)
+		data, err := elemental.Encode(elemental.EncodingTypeJSON, token.NewDiscovery(issuer, api, jwks))
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
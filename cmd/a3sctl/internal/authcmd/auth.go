@@ -37,13 +37,19 @@ func New(mmaker manipcli.ManipulatorMaker) *cobra.Command {
 		makeAutoCmd(mmaker),
 		makeMTLSCmd(mmaker, restrictions),
 		makeLDAPCmd(mmaker, restrictions),
+		makeLocalCmd(mmaker, restrictions),
 		makeHTTPCmd(mmaker, restrictions),
 		makeAzureCmd(mmaker, restrictions),
 		makeGCPCmd(mmaker, restrictions),
 		makeAWSCmd(mmaker, restrictions),
+		makeKubernetesCmd(mmaker, restrictions),
+		makeJWTCmd(mmaker, restrictions),
+		makeSPIFFECmd(mmaker, restrictions),
 		makeOIDCCmd(mmaker, restrictions),
+		makeSAMLCmd(mmaker, restrictions),
 		makeRemoteA3SCmd(mmaker, restrictions),
 		makeA3SCmd(mmaker, restrictions),
+		makeTOTPCmd(mmaker, restrictions),
 	)
 
 	return cmd
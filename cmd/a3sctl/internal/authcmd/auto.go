@@ -167,6 +167,7 @@ func HandleAutoAuth(
 				overrideIfNeeded("autoauth.mtls.cloak", overrideCloak),
 				viper.GetDuration("validity"),
 				refresh,
+				false,
 				nil,
 			)
 			if err != nil {
@@ -193,6 +194,25 @@ func HandleAutoAuth(
 			}
 			data = []byte(t)
 
+		case "local", "Local":
+			zap.L().Debug("autoauth: retrieving token using autoauth.local")
+			t, err := GetLocalToken(
+				mmaker,
+				helpers.ReadFlag("username: ", "autoauth.local.user", false),
+				helpers.ReadFlag("password: ", "autoauth.local.pass", true),
+				viper.GetString("autoauth.local.source.namespace"),
+				viper.GetString("autoauth.local.source.name"),
+				overrideIfNeeded("autoauth.local.audience", overrideAudience),
+				overrideIfNeeded("autoauth.local.cloak", overrideCloak),
+				viper.GetDuration("validity"),
+				refresh,
+				nil,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to retrieve token from autoauth info: %w", err)
+			}
+			data = []byte(t)
+
 		case "http", "HTTP":
 			zap.L().Debug("autoauth: retrieving token using autoauth.http")
 			t, err := GetHTTPToken(
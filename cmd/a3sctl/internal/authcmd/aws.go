@@ -23,6 +23,8 @@ func makeAWSCmd(mmaker manipcli.ManipulatorMaker, restrictions *permissions.Rest
 			fToken := viper.GetString("access-token")
 			fAccessKeyID := viper.GetString("access-key-id")
 			fSecretAccessKey := viper.GetString("access-key-secret")
+			fSourceName := viper.GetString("source-name")
+			fSourceNamespace := viper.GetString("source-namespace")
 			fAudience := viper.GetStringSlice("audience")
 			fCloak := viper.GetStringSlice("cloak")
 			fQRCode := viper.GetBool("qrcode")
@@ -41,6 +43,8 @@ func makeAWSCmd(mmaker manipcli.ManipulatorMaker, restrictions *permissions.Rest
 				fAccessKeyID,
 				fSecretAccessKey,
 				fToken,
+				fSourceNamespace,
+				fSourceName,
 				authlib.OptAudience(fAudience...),
 				authlib.OptCloak(fCloak...),
 				authlib.OptRestrictions(*restrictions),
@@ -64,9 +68,10 @@ func makeAWSCmd(mmaker manipcli.ManipulatorMaker, restrictions *permissions.Rest
 	cmd.Flags().String("access-token", "", "Valid AWS token.")
 	cmd.Flags().String("access-key-id", "", "Access key ID for the token.")
 	cmd.Flags().String("access-key-secret", "", "Secret for access key.")
+	cmd.Flags().String("source-name", "default", "The name of the auth source.")
+	cmd.Flags().String("source-namespace", "", "The namespace of the auth source. If omitted, uses --namespace.")
 
 	cmd.SetHelpFunc(func(cmd *cobra.Command, args []string) {
-		_ = cmd.Flags().MarkHidden("namespace")
 		_ = cmd.Flags().MarkHidden("token")
 		cmd.Parent().HelpFunc()(cmd, args)
 	})
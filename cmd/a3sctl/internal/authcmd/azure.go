@@ -21,6 +21,8 @@ func makeAzureCmd(mmaker manipcli.ManipulatorMaker, restrictions *permissions.Re
 		RunE: func(cmd *cobra.Command, args []string) error {
 
 			fToken := viper.GetString("access-token")
+			fSourceName := viper.GetString("source-name")
+			fSourceNamespace := viper.GetString("source-namespace")
 			fAudience := viper.GetStringSlice("audience")
 			fCloak := viper.GetStringSlice("cloak")
 			fQRCode := viper.GetBool("qrcode")
@@ -37,6 +39,8 @@ func makeAzureCmd(mmaker manipcli.ManipulatorMaker, restrictions *permissions.Re
 			t, err := client.AuthFromAzure(
 				context.Background(),
 				fToken,
+				fSourceNamespace,
+				fSourceName,
 				authlib.OptAudience(fAudience...),
 				authlib.OptCloak(fCloak...),
 				authlib.OptRestrictions(*restrictions),
@@ -58,9 +62,10 @@ func makeAzureCmd(mmaker manipcli.ManipulatorMaker, restrictions *permissions.Re
 	}
 
 	cmd.Flags().String("access-token", "", "Valid Azure token.")
+	cmd.Flags().String("source-name", "default", "The name of the auth source.")
+	cmd.Flags().String("source-namespace", "", "The namespace of the auth source. If omitted, uses --namespace.")
 
 	cmd.SetHelpFunc(func(cmd *cobra.Command, args []string) {
-		_ = cmd.Flags().MarkHidden("namespace")
 		_ = cmd.Flags().MarkHidden("token")
 		cmd.Parent().HelpFunc()(cmd, args)
 	})
@@ -21,6 +21,8 @@ func makeGCPCmd(mmaker manipcli.ManipulatorMaker, restrictions *permissions.Rest
 		RunE: func(cmd *cobra.Command, args []string) error {
 
 			fToken := viper.GetString("access-token")
+			fSourceName := viper.GetString("source-name")
+			fSourceNamespace := viper.GetString("source-namespace")
 			fTokenAudience := viper.GetString("token-audience")
 			fAudience := viper.GetStringSlice("audience")
 			fCloak := viper.GetStringSlice("cloak")
@@ -39,6 +41,8 @@ func makeGCPCmd(mmaker manipcli.ManipulatorMaker, restrictions *permissions.Rest
 				context.Background(),
 				fToken,
 				fTokenAudience,
+				fSourceNamespace,
+				fSourceName,
 				authlib.OptAudience(fAudience...),
 				authlib.OptCloak(fCloak...),
 				authlib.OptRestrictions(*restrictions),
@@ -61,9 +65,10 @@ func makeGCPCmd(mmaker manipcli.ManipulatorMaker, restrictions *permissions.Rest
 
 	cmd.Flags().String("access-token", "", "Valid GCP token.")
 	cmd.Flags().String("token-audience", "a3s", "Required GCP token audience.")
+	cmd.Flags().String("source-name", "default", "The name of the auth source.")
+	cmd.Flags().String("source-namespace", "", "The namespace of the auth source. If omitted, uses --namespace.")
 
 	cmd.SetHelpFunc(func(cmd *cobra.Command, args []string) {
-		_ = cmd.Flags().MarkHidden("namespace")
 		_ = cmd.Flags().MarkHidden("token")
 		cmd.Parent().HelpFunc()(cmd, args)
 	})
@@ -0,0 +1,79 @@
+package authcmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.aporeto.io/a3s/pkgs/authlib"
+	"go.aporeto.io/a3s/pkgs/permissions"
+	"go.aporeto.io/a3s/pkgs/token"
+	"go.aporeto.io/manipulate/manipcli"
+)
+
+func makeJWTCmd(mmaker manipcli.ManipulatorMaker, restrictions *permissions.Restrictions) *cobra.Command {
+
+	cmd := &cobra.Command{
+		Use:              "jwt",
+		Short:            "Use a JWT issued by a trusted external system.",
+		TraverseChildren: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			fToken := viper.GetString("access-token")
+			fSourceName := viper.GetString("source-name")
+			fSourceNamespace := viper.GetString("source-namespace")
+			fAudience := viper.GetStringSlice("audience")
+			fCloak := viper.GetStringSlice("cloak")
+			fQRCode := viper.GetBool("qrcode")
+			fCheck := viper.GetBool("check")
+			fValidity := viper.GetDuration("validity")
+			fRefresh := viper.GetBool("refresh")
+
+			if fToken == "" {
+				return fmt.Errorf("you must pass the JWT using --access-token")
+			}
+
+			m, err := mmaker()
+			if err != nil {
+				return err
+			}
+
+			client := authlib.NewClient(m)
+			t, err := client.AuthFromJWT(
+				context.Background(),
+				fToken,
+				fSourceNamespace,
+				fSourceName,
+				authlib.OptAudience(fAudience...),
+				authlib.OptCloak(fCloak...),
+				authlib.OptRestrictions(*restrictions),
+				authlib.OptValidity(fValidity),
+				authlib.OptRefresh(fRefresh),
+			)
+			if err != nil {
+				return err
+			}
+
+			return token.Fprint(
+				os.Stdout,
+				t,
+				token.PrintOptionDecoded(fCheck),
+				token.PrintOptionQRCode(fQRCode),
+				token.PrintOptionRaw(true),
+			)
+		},
+	}
+
+	cmd.Flags().String("access-token", "", "Valid JWT.")
+	cmd.Flags().String("source-name", "default", "The name of the auth source.")
+	cmd.Flags().String("source-namespace", "", "The namespace of the auth source. If omitted, uses --namespace.")
+
+	cmd.SetHelpFunc(func(cmd *cobra.Command, args []string) {
+		_ = cmd.Flags().MarkHidden("token")
+		cmd.Parent().HelpFunc()(cmd, args)
+	})
+
+	return cmd
+}
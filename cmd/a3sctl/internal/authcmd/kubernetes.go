@@ -0,0 +1,77 @@
+package authcmd
+
+import (
+	"context"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.aporeto.io/a3s/pkgs/authlib"
+	"go.aporeto.io/a3s/pkgs/permissions"
+	"go.aporeto.io/a3s/pkgs/token"
+	"go.aporeto.io/manipulate/manipcli"
+)
+
+func makeKubernetesCmd(mmaker manipcli.ManipulatorMaker, restrictions *permissions.Restrictions) *cobra.Command {
+
+	cmd := &cobra.Command{
+		Use:              "kubernetes",
+		Short:            "Use a Kubernetes service account token.",
+		TraverseChildren: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			fToken := viper.GetString("access-token")
+			fTokenPath := viper.GetString("token-path")
+			fSourceName := viper.GetString("source-name")
+			fSourceNamespace := viper.GetString("source-namespace")
+			fAudience := viper.GetStringSlice("audience")
+			fCloak := viper.GetStringSlice("cloak")
+			fQRCode := viper.GetBool("qrcode")
+			fCheck := viper.GetBool("check")
+			fValidity := viper.GetDuration("validity")
+			fRefresh := viper.GetBool("refresh")
+
+			m, err := mmaker()
+			if err != nil {
+				return err
+			}
+
+			client := authlib.NewClient(m)
+			t, err := client.AuthFromKubernetes(
+				context.Background(),
+				fToken,
+				fTokenPath,
+				fSourceNamespace,
+				fSourceName,
+				authlib.OptAudience(fAudience...),
+				authlib.OptCloak(fCloak...),
+				authlib.OptRestrictions(*restrictions),
+				authlib.OptValidity(fValidity),
+				authlib.OptRefresh(fRefresh),
+			)
+			if err != nil {
+				return err
+			}
+
+			return token.Fprint(
+				os.Stdout,
+				t,
+				token.PrintOptionDecoded(fCheck),
+				token.PrintOptionQRCode(fQRCode),
+				token.PrintOptionRaw(true),
+			)
+		},
+	}
+
+	cmd.Flags().String("access-token", "", "Valid Kubernetes service account token.")
+	cmd.Flags().String("token-path", "", "Path to the projected service account token. If omitted, uses the default service account token path.")
+	cmd.Flags().String("source-name", "default", "The name of the auth source.")
+	cmd.Flags().String("source-namespace", "", "The namespace of the auth source. If omitted, uses --namespace.")
+
+	cmd.SetHelpFunc(func(cmd *cobra.Command, args []string) {
+		_ = cmd.Flags().MarkHidden("token")
+		cmd.Parent().HelpFunc()(cmd, args)
+	})
+
+	return cmd
+}
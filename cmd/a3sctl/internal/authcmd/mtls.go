@@ -35,6 +35,7 @@ func makeMTLSCmd(mmaker manipcli.ManipulatorMaker, restrictions *permissions.Res
 			fCheck := viper.GetBool("check")
 			fValidity := viper.GetDuration("validity")
 			fRefresh := viper.GetBool("refresh")
+			fBound := viper.GetBool("bound")
 
 			if fSourceNamespace == "" {
 				fSourceNamespace = viper.GetString("namespace")
@@ -51,6 +52,7 @@ func makeMTLSCmd(mmaker manipcli.ManipulatorMaker, restrictions *permissions.Res
 				fCloak,
 				fValidity,
 				fRefresh,
+				fBound,
 				restrictions,
 			)
 			if err != nil {
@@ -72,6 +74,7 @@ func makeMTLSCmd(mmaker manipcli.ManipulatorMaker, restrictions *permissions.Res
 	cmd.Flags().String("pass", "", "Passphrase for the certificate key. Use '-' to prompt.")
 	cmd.Flags().String("source-name", "default", "The name of the auth source.")
 	cmd.Flags().String("source-namespace", "", "The namespace of the auth source. If omitted, uses --namespace.")
+	cmd.Flags().Bool("bound", false, "If set, the token will be bound to the certificate and can only be used with it.")
 
 	cmd.SetHelpFunc(func(cmd *cobra.Command, args []string) {
 		_ = cmd.Flags().MarkHidden("token")
@@ -94,6 +97,7 @@ func GetMTLSToken(
 	cloak []string,
 	validity time.Duration,
 	refresh bool,
+	bound bool,
 	restrictions *permissions.Restrictions,
 ) (string, error) {
 
@@ -117,6 +121,7 @@ func GetMTLSToken(
 		authlib.OptCloak(cloak...),
 		authlib.OptValidity(validity),
 		authlib.OptRefresh(refresh),
+		authlib.OptCertificateBound(bound),
 	}
 
 	if restrictions != nil {
@@ -36,10 +36,40 @@ func makeOIDCCmd(mmaker manipcli.ManipulatorMaker, restrictions *permissions.Res
 			fCheck := viper.GetBool("check")
 			fRefresh := viper.GetBool("refresh")
 
+			fDevice := viper.GetBool("device")
+
 			if fSourceNamespace == "" {
 				fSourceNamespace = viper.GetString("namespace")
 			}
 
+			if fDevice {
+				m, err := mmaker()
+				if err != nil {
+					return err
+				}
+
+				t, err := authFromOIDCDevice(
+					authlib.NewClient(m),
+					fSourceNamespace,
+					fSourceName,
+					authlib.OptAudience(fAudience...),
+					authlib.OptCloak(fCloak...),
+					authlib.OptRestrictions(*restrictions),
+					authlib.OptRefresh(fRefresh),
+				)
+				if err != nil {
+					return err
+				}
+
+				return token.Fprint(
+					os.Stdout,
+					t,
+					token.PrintOptionDecoded(fCheck),
+					token.PrintOptionQRCode(fQRCode),
+					token.PrintOptionRaw(true),
+				)
+			}
+
 			srvCtx, srvCancel := context.WithCancel(context.Background())
 			defer srvCancel()
 
@@ -97,6 +127,7 @@ func makeOIDCCmd(mmaker manipcli.ManipulatorMaker, restrictions *permissions.Res
 	}
 	cmd.Flags().String("source-name", "default", "The name of the auth source.")
 	cmd.Flags().String("source-namespace", "", "The namespace of the auth source. If omitted, uses --namespace.")
+	cmd.Flags().Bool("device", false, "Use the device authorization flow. This does not require a browser on the current machine.")
 
 	cmd.SetHelpFunc(func(cmd *cobra.Command, args []string) {
 		_ = cmd.Flags().MarkHidden("token")
@@ -106,6 +137,35 @@ func makeOIDCCmd(mmaker manipcli.ManipulatorMaker, restrictions *permissions.Res
 	return cmd
 }
 
+func authFromOIDCDevice(client *authlib.Client, sourceNamespace string, sourceName string, options ...authlib.Option) (string, error) {
+
+	// The user may take some time to approve
+	// the request from another device.
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Minute)
+	defer cancel()
+
+	da, err := client.AuthFromOIDCDeviceStep1(ctx, sourceNamespace, sourceName)
+	if err != nil {
+		return "", err
+	}
+
+	if da.VerificationURIComplete != "" {
+		fmt.Fprintln(os.Stderr, "Open this URL in any browser:", da.VerificationURIComplete)
+		fmt.Fprintln(os.Stderr, "Or open", da.VerificationURI, "and enter the code:", da.UserCode)
+	} else {
+		fmt.Fprintln(os.Stderr, "Open", da.VerificationURI, "in any browser and enter the code:", da.UserCode)
+	}
+
+	return client.AuthFromOIDCDeviceStep2(
+		ctx,
+		sourceNamespace,
+		sourceName,
+		da.State,
+		time.Duration(da.Interval)*time.Second,
+		options...,
+	)
+}
+
 func startOIDCCallbackServer(srvCtx context.Context, out chan oidcAuthData) {
 
 	mux := http.NewServeMux()
@@ -0,0 +1,100 @@
+package authcmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.aporeto.io/a3s/pkgs/authlib"
+	"go.aporeto.io/a3s/pkgs/permissions"
+	"go.aporeto.io/a3s/pkgs/token"
+	"go.aporeto.io/manipulate/manipcli"
+)
+
+func makeSAMLCmd(mmaker manipcli.ManipulatorMaker, restrictions *permissions.Restrictions) *cobra.Command {
+
+	cmd := &cobra.Command{
+		Use:   "saml",
+		Short: "Authenticate using SAML source.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			fSourceName := viper.GetString("source-name")
+			fSourceNamespace := viper.GetString("source-namespace")
+			fAudience := viper.GetStringSlice("audience")
+			fCloak := viper.GetStringSlice("cloak")
+			fQRCode := viper.GetBool("qrcode")
+			fCheck := viper.GetBool("check")
+			fRefresh := viper.GetBool("refresh")
+
+			if fSourceNamespace == "" {
+				fSourceNamespace = viper.GetString("namespace")
+			}
+
+			srvCtx, srvCancel := context.WithCancel(context.Background())
+			defer srvCancel()
+
+			authDataCh := make(chan oidcAuthData)
+
+			go startOIDCCallbackServer(srvCtx, authDataCh)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			m, err := mmaker()
+			if err != nil {
+				return err
+			}
+
+			client := authlib.NewClient(m)
+			url, verifier, err := client.AuthFromSAMLStep1(
+				ctx,
+				fSourceNamespace,
+				fSourceName,
+				"http://localhost:65333",
+			)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintln(os.Stderr, "Open this URL in your browser:", url)
+
+			authD := <-authDataCh
+			srvCancel()
+
+			t, err := client.AuthFromSAMLStep2(
+				ctx,
+				fSourceNamespace,
+				fSourceName,
+				authD.state,
+				verifier,
+				authlib.OptAudience(fAudience...),
+				authlib.OptCloak(fCloak...),
+				authlib.OptRestrictions(*restrictions),
+				authlib.OptRefresh(fRefresh),
+			)
+			if err != nil {
+				return err
+			}
+
+			return token.Fprint(
+				os.Stdout,
+				t,
+				token.PrintOptionDecoded(fCheck),
+				token.PrintOptionQRCode(fQRCode),
+				token.PrintOptionRaw(true),
+			)
+		},
+	}
+	cmd.Flags().String("source-name", "default", "The name of the auth source.")
+	cmd.Flags().String("source-namespace", "", "The namespace of the auth source. If omitted, uses --namespace.")
+
+	cmd.SetHelpFunc(func(cmd *cobra.Command, args []string) {
+		_ = cmd.Flags().MarkHidden("token")
+		cmd.Parent().HelpFunc()(cmd, args)
+	})
+
+	return cmd
+}
@@ -0,0 +1,90 @@
+package authcmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.aporeto.io/a3s/pkgs/authlib"
+	"go.aporeto.io/a3s/pkgs/permissions"
+	"go.aporeto.io/a3s/pkgs/token"
+	"go.aporeto.io/manipulate/manipcli"
+)
+
+func makeSPIFFECmd(mmaker manipcli.ManipulatorMaker, restrictions *permissions.Restrictions) *cobra.Command {
+
+	cmd := &cobra.Command{
+		Use:              "spiffe",
+		Short:            "Use a SPIFFE JWT-SVID.",
+		TraverseChildren: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			fSVID := viper.GetString("svid")
+			fSVIDPath := viper.GetString("svid-path")
+			fSourceName := viper.GetString("source-name")
+			fSourceNamespace := viper.GetString("source-namespace")
+			fAudience := viper.GetStringSlice("audience")
+			fCloak := viper.GetStringSlice("cloak")
+			fQRCode := viper.GetBool("qrcode")
+			fCheck := viper.GetBool("check")
+			fValidity := viper.GetDuration("validity")
+			fRefresh := viper.GetBool("refresh")
+
+			if fSVID == "" && fSVIDPath == "" {
+				return fmt.Errorf("you must pass the JWT-SVID using --svid or --svid-path")
+			}
+
+			if fSVIDPath != "" {
+				data, err := os.ReadFile(fSVIDPath)
+				if err != nil {
+					return fmt.Errorf("unable to read svid file: %w", err)
+				}
+				fSVID = strings.TrimSpace(string(data))
+			}
+
+			m, err := mmaker()
+			if err != nil {
+				return err
+			}
+
+			client := authlib.NewClient(m)
+			t, err := client.AuthFromSPIFFE(
+				context.Background(),
+				fSVID,
+				fSourceNamespace,
+				fSourceName,
+				authlib.OptAudience(fAudience...),
+				authlib.OptCloak(fCloak...),
+				authlib.OptRestrictions(*restrictions),
+				authlib.OptValidity(fValidity),
+				authlib.OptRefresh(fRefresh),
+			)
+			if err != nil {
+				return err
+			}
+
+			return token.Fprint(
+				os.Stdout,
+				t,
+				token.PrintOptionDecoded(fCheck),
+				token.PrintOptionQRCode(fQRCode),
+				token.PrintOptionRaw(true),
+			)
+		},
+	}
+
+	cmd.Flags().String("svid", "", "Valid JWT-SVID.")
+	cmd.Flags().String("svid-path", "", "Path to a file containing a valid JWT-SVID.")
+	cmd.Flags().String("source-name", "default", "The name of the auth source.")
+	cmd.Flags().String("source-namespace", "", "The namespace of the auth source. If omitted, uses --namespace.")
+
+	cmd.SetHelpFunc(func(cmd *cobra.Command, args []string) {
+		_ = cmd.Flags().MarkHidden("token")
+		cmd.Parent().HelpFunc()(cmd, args)
+	})
+
+	return cmd
+}
@@ -0,0 +1,73 @@
+package authcmd
+
+import (
+	"context"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.aporeto.io/a3s/cmd/a3sctl/internal/helpers"
+	"go.aporeto.io/a3s/pkgs/authlib"
+	"go.aporeto.io/a3s/pkgs/permissions"
+	"go.aporeto.io/a3s/pkgs/token"
+	"go.aporeto.io/manipulate/manipcli"
+)
+
+func makeTOTPCmd(mmaker manipcli.ManipulatorMaker, restrictions *permissions.Restrictions) *cobra.Command {
+
+	cmd := &cobra.Command{
+		Use:              "totp",
+		Short:            "Upgrade an A3S identity token using an enrolled TOTP second factor.",
+		TraverseChildren: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			fToken := viper.GetString("access-token")
+			fCode := helpers.ReadFlag("code: ", "code", false)
+			fAudience := viper.GetStringSlice("audience")
+			fCloak := viper.GetStringSlice("cloak")
+			fQRCode := viper.GetBool("qrcode")
+			fCheck := viper.GetBool("check")
+			fValidity := viper.GetDuration("validity")
+
+			if fToken == "" {
+				fToken = viper.GetString("token")
+			}
+
+			m, err := mmaker()
+			if err != nil {
+				return err
+			}
+
+			client := authlib.NewClient(m)
+			t, err := client.AuthFromTOTP(
+				context.Background(),
+				fToken,
+				fCode,
+				authlib.OptAudience(fAudience...),
+				authlib.OptCloak(fCloak...),
+				authlib.OptRestrictions(*restrictions),
+				authlib.OptValidity(fValidity),
+			)
+			if err != nil {
+				return err
+			}
+
+			return token.Fprint(
+				os.Stdout,
+				t,
+				token.PrintOptionDecoded(fCheck),
+				token.PrintOptionQRCode(fQRCode),
+				token.PrintOptionRaw(true),
+			)
+		},
+	}
+
+	cmd.Flags().String("access-token", "", "Valid a3s token to upgrade. If empty, uses --token.")
+	cmd.Flags().String("code", "", "The current code of the TOTP application. Use '-' to prompt.")
+	cmd.SetHelpFunc(func(cmd *cobra.Command, args []string) {
+		_ = cmd.Flags().MarkHidden("namespace")
+		cmd.Parent().HelpFunc()(cmd, args)
+	})
+
+	return cmd
+}
@@ -0,0 +1,178 @@
+package introspection
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+
+	"go.aporeto.io/a3s/pkgs/authorizer"
+	"go.aporeto.io/a3s/pkgs/permissions"
+	"go.aporeto.io/a3s/pkgs/token"
+	"go.aporeto.io/elemental"
+)
+
+// The permission the caller of the introspection
+// endpoint must have in the requested namespace.
+const (
+	PermissionResource  = "token"
+	PermissionOperation = "introspect"
+)
+
+// An Authenticator authenticates the caller of the introspection
+// endpoint and verifies the introspected tokens. If audience is empty,
+// the audience of the introspected token is not verified.
+type Authenticator interface {
+	AuthenticateHTTPRequest(req *http.Request) (*token.IdentityToken, error)
+	Verify(ctx context.Context, tokenString string, audience string) (*token.IdentityToken, error)
+}
+
+// An Authorizer checks the caller of the introspection
+// endpoint is allowed to introspect tokens.
+type Authorizer interface {
+	CheckAuthorization(ctx context.Context, claims []string, operation string, ns string, resource string, opts ...authorizer.OptionCheck) (bool, error)
+}
+
+// A Response is the response of the introspection
+// endpoint, as described by RFC 7662.
+type Response struct {
+	Active       bool                      `json:"active"`
+	TokenType    string                    `json:"token_type,omitempty"`
+	Subject      string                    `json:"sub,omitempty"`
+	Audience     []string                  `json:"aud,omitempty"`
+	Issuer       string                    `json:"iss,omitempty"`
+	ID           string                    `json:"jti,omitempty"`
+	ExpiresAt    int64                     `json:"exp,omitempty"`
+	IssuedAt     int64                     `json:"iat,omitempty"`
+	NotBefore    int64                     `json:"nbf,omitempty"`
+	Identity     []string                  `json:"identity,omitempty"`
+	Restrictions *permissions.Restrictions `json:"restrictions,omitempty"`
+	Source       *token.Source             `json:"source,omitempty"`
+	Opaque       map[string]string         `json:"opaque,omitempty"`
+	Confirmation *token.Confirmation       `json:"cnf,omitempty"`
+	Actor        *token.Actor              `json:"act,omitempty"`
+}
+
+// NewResponse returns the active Response describing
+// the given IdentityToken.
+func NewResponse(idt *token.IdentityToken) *Response {
+
+	source := idt.Source
+
+	r := &Response{
+		Active:       true,
+		TokenType:    "Bearer",
+		Subject:      idt.Subject,
+		Audience:     idt.Audience,
+		Issuer:       idt.Issuer,
+		ID:           idt.ID,
+		Identity:     idt.Identity,
+		Restrictions: idt.Restrictions,
+		Source:       &source,
+		Opaque:       idt.Opaque,
+		Confirmation: idt.Confirmation,
+		Actor:        idt.Actor,
+	}
+
+	if idt.ExpiresAt != nil {
+		r.ExpiresAt = idt.ExpiresAt.Unix()
+	}
+
+	if idt.IssuedAt != nil {
+		r.IssuedAt = idt.IssuedAt.Unix()
+	}
+
+	if idt.NotBefore != nil {
+		r.NotBefore = idt.NotBefore.Unix()
+	}
+
+	return r
+}
+
+// MakeHandler returns an http.HandlerFunc implementing the token
+// introspection endpoint described by RFC 7662. The caller must be
+// authenticated and have the token:introspect permission in the namespace
+// set by the X-Namespace header, or / if absent. Only tokens delivered by
+// a source in that namespace or below can be reported as active.
+// The token is read from the token parameter of the form encoded request
+// body, and is verified using the given Authenticator. The optional audience
+// parameter can be used to also verify the audience of the token. Any token
+// that cannot be verified is reported as inactive, without further details.
+func MakeHandler(authenticator Authenticator, authz Authorizer) http.HandlerFunc {
+
+	return func(w http.ResponseWriter, req *http.Request) {
+
+		if req.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			writeJSON(w, http.StatusMethodNotAllowed, map[string]string{"error": "invalid_request"})
+			return
+		}
+
+		caller, err := authenticator.AuthenticateHTTPRequest(req)
+		if err != nil {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_token"})
+			return
+		}
+
+		ns := req.Header.Get("X-Namespace")
+		if ns == "" {
+			ns = "/"
+		}
+
+		if !isAuthorized(req, authz, caller, ns) {
+			writeJSON(w, http.StatusForbidden, map[string]string{"error": "insufficient_scope"})
+			return
+		}
+
+		if err := req.ParseForm(); err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+			return
+		}
+
+		tokenString := req.PostForm.Get("token")
+		if tokenString == "" {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+			return
+		}
+
+		idt, err := authenticator.Verify(req.Context(), tokenString, req.PostForm.Get("audience"))
+		if err != nil {
+			writeJSON(w, http.StatusOK, &Response{})
+			return
+		}
+
+		if sns := idt.Source.Namespace; ns != "/" && sns != ns && !elemental.IsNamespaceChildrenOfNamespace(sns, ns) {
+			writeJSON(w, http.StatusOK, &Response{})
+			return
+		}
+
+		writeJSON(w, http.StatusOK, NewResponse(idt))
+	}
+}
+
+func isAuthorized(req *http.Request, authz Authorizer, caller *token.IdentityToken, ns string) bool {
+
+	opts := []authorizer.OptionCheck{}
+
+	if caller.Restrictions != nil {
+		opts = append(opts, authorizer.OptionCheckRestrictions(*caller.Restrictions))
+	}
+
+	if ip, _, err := net.SplitHostPort(req.RemoteAddr); err == nil {
+		opts = append(opts, authorizer.OptionCheckSourceIP(ip))
+	}
+
+	ok, err := authz.CheckAuthorization(req.Context(), caller.Identity, PermissionOperation, ns, PermissionResource, opts...)
+
+	return err == nil && ok
+}
+
+func writeJSON(w http.ResponseWriter, status int, data any) {
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(data)
+}
@@ -0,0 +1,203 @@
+package introspection
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.aporeto.io/a3s/pkgs/authorizer"
+	"go.aporeto.io/a3s/pkgs/permissions"
+	"go.aporeto.io/a3s/pkgs/token"
+)
+
+type fakeVerifier struct {
+	idt       *token.IdentityToken
+	err       error
+	token     string
+	audience  string
+	caller    *token.IdentityToken
+	callerErr error
+}
+
+func (v *fakeVerifier) AuthenticateHTTPRequest(req *http.Request) (*token.IdentityToken, error) {
+	return v.caller, v.callerErr
+}
+
+func (v *fakeVerifier) Verify(ctx context.Context, tokenString string, audience string) (*token.IdentityToken, error) {
+	v.token = tokenString
+	v.audience = audience
+	return v.idt, v.err
+}
+
+type fakeAuthorizer struct {
+	ok        bool
+	err       error
+	claims    []string
+	operation string
+	ns        string
+	resource  string
+}
+
+func (a *fakeAuthorizer) CheckAuthorization(ctx context.Context, claims []string, operation string, ns string, resource string, opts ...authorizer.OptionCheck) (bool, error) {
+	a.claims = claims
+	a.operation = operation
+	a.ns = ns
+	a.resource = resource
+	return a.ok, a.err
+}
+
+func introspect(h http.HandlerFunc, method string, ns string, form url.Values) (*httptest.ResponseRecorder, map[string]any) {
+
+	req := httptest.NewRequest(method, "/oauth2/introspect", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if ns != "" {
+		req.Header.Set("X-Namespace", ns)
+	}
+
+	w := httptest.NewRecorder()
+	h(w, req)
+
+	out := map[string]any{}
+	_ = json.Unmarshal(w.Body.Bytes(), &out)
+
+	return w, out
+}
+
+func TestMakeHandler(t *testing.T) {
+
+	Convey("Given I have a handler", t, func() {
+
+		now := time.Now().Truncate(time.Second)
+
+		idt := token.NewIdentityToken(token.Source{Type: "mtls", Namespace: "/a", Name: "src"})
+		idt.Identity = []string{"@source:type=mtls", "commonname=john"}
+		idt.Restrictions = &permissions.Restrictions{Namespace: "/a/b"}
+		idt.Subject = "john"
+		idt.Issuer = "iss"
+		idt.ID = "jti"
+		idt.Audience = jwt.ClaimStrings{"aud"}
+		idt.ExpiresAt = jwt.NewNumericDate(now.Add(time.Hour))
+		idt.IssuedAt = jwt.NewNumericDate(now)
+		idt.Confirmation = &token.Confirmation{X5TS256: "thumbprint"}
+		idt.Actor = &token.Actor{Subject: "service", Identity: []string{"app=service"}}
+
+		caller := token.NewIdentityToken(token.Source{Type: "mtls", Namespace: "/", Name: "gateway"})
+		caller.Identity = []string{"@source:type=mtls", "commonname=gateway"}
+
+		v := &fakeVerifier{idt: idt, caller: caller}
+		a := &fakeAuthorizer{ok: true}
+		h := MakeHandler(v, a)
+
+		Convey("When I introspect a valid token", func() {
+
+			w, out := introspect(h, http.MethodPost, "", url.Values{"token": {"tkn"}, "audience": {"aud"}})
+
+			So(w.Code, ShouldEqual, http.StatusOK)
+			So(a.claims, ShouldResemble, caller.Identity)
+			So(a.operation, ShouldEqual, "introspect")
+			So(a.resource, ShouldEqual, "token")
+			So(a.ns, ShouldEqual, "/")
+			So(w.Header().Get("Content-Type"), ShouldEqual, "application/json")
+			So(w.Header().Get("Cache-Control"), ShouldEqual, "no-store")
+			So(v.token, ShouldEqual, "tkn")
+			So(v.audience, ShouldEqual, "aud")
+			So(out["active"], ShouldEqual, true)
+			So(out["token_type"], ShouldEqual, "Bearer")
+			So(out["sub"], ShouldEqual, "john")
+			So(out["iss"], ShouldEqual, "iss")
+			So(out["jti"], ShouldEqual, "jti")
+			So(out["aud"], ShouldResemble, []any{"aud"})
+			So(out["exp"], ShouldEqual, float64(now.Add(time.Hour).Unix()))
+			So(out["iat"], ShouldEqual, float64(now.Unix()))
+			So(out["identity"], ShouldResemble, []any{"@source:type=mtls", "commonname=john"})
+			So(out["restrictions"], ShouldNotBeNil)
+			So(out["source"], ShouldResemble, map[string]any{"type": "mtls", "namespace": "/a", "name": "src"})
+			So(out["cnf"], ShouldResemble, map[string]any{"x5t#S256": "thumbprint"})
+			So(out["act"], ShouldResemble, map[string]any{"sub": "service", "identity": []any{"app=service"}})
+		})
+
+		Convey("When I introspect an invalid token", func() {
+
+			v.err = fmt.Errorf("boom")
+
+			w, out := introspect(h, http.MethodPost, "", url.Values{"token": {"tkn"}})
+
+			So(w.Code, ShouldEqual, http.StatusOK)
+			So(out, ShouldResemble, map[string]any{"active": false})
+		})
+
+		Convey("When I introspect a token from a namespace I can introspect", func() {
+
+			w, out := introspect(h, http.MethodPost, "/a", url.Values{"token": {"tkn"}})
+
+			So(w.Code, ShouldEqual, http.StatusOK)
+			So(a.ns, ShouldEqual, "/a")
+			So(out["active"], ShouldEqual, true)
+		})
+
+		Convey("When I introspect a token from a namespace I cannot introspect", func() {
+
+			w, out := introspect(h, http.MethodPost, "/b", url.Values{"token": {"tkn"}})
+
+			So(w.Code, ShouldEqual, http.StatusOK)
+			So(out, ShouldResemble, map[string]any{"active": false})
+		})
+
+		Convey("When I introspect without being authenticated", func() {
+
+			v.callerErr = fmt.Errorf("boom")
+
+			w, out := introspect(h, http.MethodPost, "", url.Values{"token": {"tkn"}})
+
+			So(w.Code, ShouldEqual, http.StatusUnauthorized)
+			So(w.Header().Get("WWW-Authenticate"), ShouldEqual, "Bearer")
+			So(out, ShouldResemble, map[string]any{"error": "invalid_token"})
+			So(v.token, ShouldBeEmpty)
+		})
+
+		Convey("When I introspect without being authorized", func() {
+
+			a.ok = false
+
+			w, out := introspect(h, http.MethodPost, "", url.Values{"token": {"tkn"}})
+
+			So(w.Code, ShouldEqual, http.StatusForbidden)
+			So(out, ShouldResemble, map[string]any{"error": "insufficient_scope"})
+			So(v.token, ShouldBeEmpty)
+		})
+
+		Convey("When the authorizer returns an error", func() {
+
+			a.err = fmt.Errorf("boom")
+
+			w, out := introspect(h, http.MethodPost, "", url.Values{"token": {"tkn"}})
+
+			So(w.Code, ShouldEqual, http.StatusForbidden)
+			So(out, ShouldResemble, map[string]any{"error": "insufficient_scope"})
+		})
+
+		Convey("When I introspect without token", func() {
+
+			w, out := introspect(h, http.MethodPost, "", url.Values{})
+
+			So(w.Code, ShouldEqual, http.StatusBadRequest)
+			So(out, ShouldResemble, map[string]any{"error": "invalid_request"})
+		})
+
+		Convey("When I use the wrong method", func() {
+
+			w, _ := introspect(h, http.MethodGet, "", url.Values{})
+
+			So(w.Code, ShouldEqual, http.StatusMethodNotAllowed)
+			So(w.Header().Get("Allow"), ShouldEqual, http.MethodPost)
+		})
+	})
+}
@@ -9,6 +9,18 @@ import (
 	"go.aporeto.io/a3s/pkgs/token"
 )
 
+// A RefreshIssuer is a token.Issuer that can also issue
+// the next refresh token of a refresh token family.
+type RefreshIssuer interface {
+	token.Issuer
+
+	// Refresh returns the next refresh token of the family
+	// if the initial token is a refresh token, or nil otherwise.
+	// The returned token still holds the ID of the initial token
+	// until it is signed.
+	Refresh() *token.IdentityToken
+}
+
 // New retrurns new A3S issuer.
 func New(
 	tokenString string,
@@ -16,7 +28,7 @@ func New(
 	requiredIssuer string,
 	audience jwt.ClaimStrings,
 	validity time.Duration,
-) (token.Issuer, error) {
+) (RefreshIssuer, error) {
 
 	c := newA3SIssuer()
 	if err := c.fromToken(
@@ -33,7 +45,8 @@ func New(
 }
 
 type a3sIssuer struct {
-	token *token.IdentityToken
+	token   *token.IdentityToken
+	refresh *token.IdentityToken
 }
 
 func newA3SIssuer() *a3sIssuer {
@@ -57,6 +70,11 @@ func (c *a3sIssuer) fromToken(
 		return ErrInputToken{Err: err}
 	}
 
+	// The @source, @issuer and @actor claims are added back
+	// when the token is signed. Without this, they would be
+	// duplicated every time a refresh token is rotated.
+	c.token.Identity = token.StripReservedClaims(c.token.Identity)
+
 	if len(audience) == 0 && len(c.token.Audience) != 0 {
 		return ErrInputToken{Err: fmt.Errorf("you cannot request a token with no audience from a token that has one")}
 	}
@@ -71,11 +89,21 @@ func (c *a3sIssuer) fromToken(
 		c.token.Restrictions = &orest
 	}
 
+	// If the initial token is a refresh token, we prepare the
+	// next refresh token of the family before touching the expiration
+	// and the token we issue becomes a classic identity token.
+	if c.token.Refresh {
+		c.refresh = nextRefresh(c.token)
+		c.token.RefreshFamily = c.refresh.RefreshFamily
+	}
+
 	c.token.ExpiresAt, err = computeNewValidity(c.token.ExpiresAt, validity, c.token.Refresh)
 	if err != nil {
 		return ErrComputeRestrictions{Err: err}
 	}
 
+	c.token.Refresh = false
+
 	return nil
 }
 
@@ -85,6 +113,31 @@ func (c *a3sIssuer) Issue() *token.IdentityToken {
 	return c.token
 }
 
+// Refresh returns the next refresh token of the family.
+func (c *a3sIssuer) Refresh() *token.IdentityToken {
+
+	return c.refresh
+}
+
+func nextRefresh(idt *token.IdentityToken) *token.IdentityToken {
+
+	next := *idt
+	next.Identity = append([]string{}, idt.Identity...)
+
+	if idt.Restrictions != nil {
+		r := *idt.Restrictions
+		next.Restrictions = &r
+	}
+
+	// The first refresh token of a family
+	// gives the family its ID.
+	if next.RefreshFamily == "" {
+		next.RefreshFamily = idt.ID
+	}
+
+	return &next
+}
+
 func computeNewValidity(originalExpUNIX *jwt.NumericDate, requestedValidity time.Duration, isRefresh bool) (*jwt.NumericDate, error) {
 
 	if originalExpUNIX == nil || originalExpUNIX.Unix() == 0 {
@@ -143,6 +143,48 @@ func TestFromToken(t *testing.T) {
 		So(err, ShouldNotBeNil)
 		So(err.Error(), ShouldEqual, "unable to parse input token: you cannot request a token with no audience from a token that has one")
 	})
+
+	Convey("Using a refresh token", t, func() {
+
+		exp := time.Now().Add(time.Hour).Truncate(time.Second)
+
+		mc := token.NewIdentityToken(token.Source{Type: "mtls"})
+		mc.ExpiresAt = jwt.NewNumericDate(exp)
+		mc.Identity = []string{"color=blue"}
+		mc.Refresh = true
+
+		token, _ := mc.JWT(key, kid, "iss", jwt.ClaimStrings{"aud"}, time.Time{}, nil)
+		c := newA3SIssuer()
+		err := c.fromToken(token, keychain, "iss", jwt.ClaimStrings{"aud"}, 10*time.Hour)
+
+		So(err, ShouldBeNil)
+		So(c.token.Refresh, ShouldBeFalse)
+		So(c.token.Identity, ShouldResemble, []string{"color=blue"})
+		So(c.token.ExpiresAt.Time.After(exp), ShouldBeTrue)
+
+		refresh := c.Refresh()
+		So(refresh, ShouldNotBeNil)
+		So(refresh.Refresh, ShouldBeTrue)
+		So(refresh.RefreshFamily, ShouldNotBeEmpty)
+		So(refresh.RefreshFamily, ShouldEqual, refresh.ID)
+		So(c.token.RefreshFamily, ShouldEqual, refresh.RefreshFamily)
+		So(refresh.ExpiresAt.Time.Equal(exp), ShouldBeTrue)
+		So(refresh.Identity, ShouldResemble, []string{"color=blue"})
+	})
+
+	Convey("Using a non refresh token", t, func() {
+
+		mc := token.NewIdentityToken(token.Source{Type: "mtls"})
+		mc.ExpiresAt = jwt.NewNumericDate(time.Now().Add(time.Hour))
+
+		token, _ := mc.JWT(key, kid, "iss", jwt.ClaimStrings{"aud"}, time.Time{}, nil)
+		c := newA3SIssuer()
+		err := c.fromToken(token, keychain, "iss", jwt.ClaimStrings{"aud"}, 0)
+
+		So(err, ShouldBeNil)
+		So(c.Refresh(), ShouldBeNil)
+		So(c.token.RefreshFamily, ShouldBeEmpty)
+	})
 }
 
 func Test_computeNewValidity(t *testing.T) {
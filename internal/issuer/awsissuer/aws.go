@@ -1,22 +1,27 @@
 package awsissuer
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/sts"
+	"go.aporeto.io/a3s/internal/identitymodifier"
+	"go.aporeto.io/a3s/pkgs/api"
 	"go.aporeto.io/a3s/pkgs/token"
 )
 
 // New returns a new Issuer from the given information.
-func New(ID string, secret string, tokenString string) (token.Issuer, error) {
+// The caller must be allowed by the given source.
+func New(ctx context.Context, source *api.AWSSource, ID string, secret string, tokenString string) (token.Issuer, error) {
 
-	c := newAWSIssuer()
-	if err := c.fromToken(ID, secret, tokenString); err != nil {
+	c := newAWSIssuer(source)
+	if err := c.fromToken(ctx, ID, secret, tokenString); err != nil {
 		return nil, err
 	}
 
@@ -24,13 +29,17 @@ func New(ID string, secret string, tokenString string) (token.Issuer, error) {
 }
 
 type awsIssuer struct {
-	token *token.IdentityToken
+	token  *token.IdentityToken
+	source *api.AWSSource
 }
 
-func newAWSIssuer() *awsIssuer {
+func newAWSIssuer(source *api.AWSSource) *awsIssuer {
 	return &awsIssuer{
+		source: source,
 		token: token.NewIdentityToken(token.Source{
-			Type: "aws",
+			Type:      "aws",
+			Namespace: source.Namespace,
+			Name:      source.Name,
 		}),
 	}
 }
@@ -39,19 +48,28 @@ func (c *awsIssuer) Issue() *token.IdentityToken {
 	return c.token
 }
 
-func (c *awsIssuer) fromToken(ID string, secret string, token string) error {
+func (c *awsIssuer) fromToken(ctx context.Context, ID string, secret string, token string) error {
 
 	config := &aws.Config{
 		Credentials:                   credentials.NewStaticCredentials(ID, secret, token),
 		CredentialsChainVerboseErrors: aws.Bool(true),
 	}
 
+	if c.source.Region != "" {
+		config.Region = aws.String(c.source.Region)
+		config.STSRegionalEndpoint = endpoints.RegionalSTSEndpoint
+	}
+
+	if c.source.Endpoint != "" {
+		config.Endpoint = aws.String(c.source.Endpoint)
+	}
+
 	session, err := session.NewSession(config)
 	if err != nil {
 		return ErrAWSSTS{Err: fmt.Errorf("unable to start aws session: %w", err)}
 	}
 
-	out, err := sts.New(session).GetCallerIdentity(&sts.GetCallerIdentityInput{})
+	out, err := sts.New(session).GetCallerIdentityWithContext(ctx, &sts.GetCallerIdentityInput{})
 	if err != nil {
 		return ErrAWSSTS{Err: fmt.Errorf("unable to retrieve aws identity: %w", err)}
 	}
@@ -73,11 +91,118 @@ func (c *awsIssuer) fromToken(ID string, secret string, token string) error {
 		return ErrAWSSTS{Err: fmt.Errorf("unable to parse arn '%s': %w", *out.Arn, err)}
 	}
 
+	if err := isAllowed(c.source, *out.Account, parn); err != nil {
+		return ErrAWSSTS{Err: err}
+	}
+
 	c.token.Identity = computeSTSClaims(out, parn)
 
+	if srcmod := c.source.Modifier; srcmod != nil {
+
+		m, err := identitymodifier.NewRemote(srcmod, c.token.Source)
+		if err != nil {
+			return fmt.Errorf("unable to prepare source modifier: %w", err)
+		}
+
+		if c.token.Identity, err = m.Modify(ctx, c.token.Identity); err != nil {
+			return fmt.Errorf("unable to call modifier: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// isAllowed returns an error if the caller with the given
+// account and arn is not allowed by the given source.
+func isAllowed(source *api.AWSSource, account string, parn arn.ARN) error {
+
+	if len(source.AllowedAccounts) > 0 {
+
+		var allowed bool
+		for _, a := range source.AllowedAccounts {
+			if a == account {
+				allowed = true
+				break
+			}
+		}
+
+		if !allowed {
+			return fmt.Errorf("account '%s' is not allowed", account)
+		}
+	}
+
+	if len(source.AllowedRoles) > 0 {
+
+		arns := roleARNs(parn)
+		for _, pattern := range source.AllowedRoles {
+			for _, a := range arns {
+				if matchPattern(pattern, a) {
+					return nil
+				}
+			}
+		}
+
+		return fmt.Errorf("arn '%s' is not allowed", parn.String())
+	}
+
 	return nil
 }
 
+// roleARNs returns the ARNs to match against the allowed
+// roles: the ARN of the caller, and, if it is an assumed
+// role, the ARN of the role.
+func roleARNs(parn arn.ARN) []string {
+
+	arns := []string{parn.String()}
+
+	if parn.Service != "sts" || !strings.HasPrefix(parn.Resource, "assumed-role/") {
+		return arns
+	}
+
+	parts := strings.SplitN(parn.Resource, "/", 3)
+	if len(parts) < 2 {
+		return arns
+	}
+
+	return append(arns, arn.ARN{
+		Partition: parn.Partition,
+		Service:   "iam",
+		AccountID: parn.AccountID,
+		Resource:  "role/" + parts[1],
+	}.String())
+}
+
+// matchPattern returns true if the given value matches the
+// given pattern, where '*' matches any sequence of characters.
+func matchPattern(pattern string, value string) bool {
+
+	parts := strings.Split(pattern, "*")
+
+	// Without any '*', the value must be the pattern.
+	if len(parts) == 1 {
+		return pattern == value
+	}
+
+	// The value must start with the first part and end with
+	// the last one, and the other parts must appear in order
+	// in between.
+	first, last := parts[0], parts[len(parts)-1]
+	if len(value) < len(first)+len(last) || !strings.HasPrefix(value, first) || !strings.HasSuffix(value, last) {
+		return false
+	}
+
+	value = value[len(first) : len(value)-len(last)]
+	for _, p := range parts[1 : len(parts)-1] {
+		i := strings.Index(value, p)
+		if i < 0 {
+			return false
+		}
+		value = value[i+len(p):]
+	}
+
+	return true
+}
+
 func computeSTSClaims(out *sts.GetCallerIdentityOutput, parn arn.ARN) (claims []string) {
 
 	claims = []string{
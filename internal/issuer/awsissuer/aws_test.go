@@ -1,6 +1,7 @@
 package awsissuer
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"testing"
@@ -9,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/sts"
 	. "github.com/smartystreets/goconvey/convey"
+	"go.aporeto.io/a3s/pkgs/api"
 )
 
 func TestErrAWSSTS(t *testing.T) {
@@ -22,17 +24,21 @@ func TestErrAWSSTS(t *testing.T) {
 
 func TestNewAWSSTSIssuer(t *testing.T) {
 	Convey("Calling NewAWSSTSIssuer should work", t, func() {
-		iss := newAWSIssuer()
+		src := &api.AWSSource{Namespace: "/ns", Name: "aws"}
+		iss := newAWSIssuer(src)
 		So(iss.token, ShouldNotBeNil)
 		So(iss.token.Source.Type, ShouldEqual, "aws")
+		So(iss.token.Source.Namespace, ShouldEqual, "/ns")
+		So(iss.token.Source.Name, ShouldEqual, "aws")
+		So(iss.source, ShouldEqual, src)
 		So(iss.Issue(), ShouldEqual, iss.token)
 	})
 }
 
 func TestFromAWSSTS(t *testing.T) {
 	Convey("Given an AWSSTSIssuer", t, func() {
-		iss := newAWSIssuer()
-		err := iss.fromToken("id", "secret", "token")
+		iss := newAWSIssuer(&api.AWSSource{AllowedAccounts: []string{"123456789012"}})
+		err := iss.fromToken(context.Background(), "id", "secret", "token")
 		So(err, ShouldNotBeNil)
 		So(err.Error(), ShouldStartWith, "aws error: unable to retrieve aws identity: InvalidClientTokenId: The security token included in the request is invalid.")
 	})
@@ -91,3 +97,60 @@ func Test_computeSTSClaims(t *testing.T) {
 		})
 	}
 }
+
+func TestIsAllowed(t *testing.T) {
+
+	Convey("Given I have an assumed role arn", t, func() {
+
+		parn, err := arn.Parse("arn:aws:sts::123456789012:assumed-role/admin/session")
+		So(err, ShouldBeNil)
+
+		Convey("It should be allowed by its account", func() {
+			src := &api.AWSSource{AllowedAccounts: []string{"000000000000", "123456789012"}}
+			So(isAllowed(src, "123456789012", parn), ShouldBeNil)
+		})
+
+		Convey("It should not be allowed by another account", func() {
+			src := &api.AWSSource{AllowedAccounts: []string{"000000000000"}}
+			err := isAllowed(src, "123456789012", parn)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "account '123456789012' is not allowed")
+		})
+
+		Convey("It should be allowed by the assumed role arn", func() {
+			src := &api.AWSSource{AllowedRoles: []string{"arn:aws:sts::123456789012:assumed-role/admin/*"}}
+			So(isAllowed(src, "123456789012", parn), ShouldBeNil)
+		})
+
+		Convey("It should be allowed by the role arn", func() {
+			src := &api.AWSSource{AllowedRoles: []string{"arn:aws:iam::*:role/admin"}}
+			So(isAllowed(src, "123456789012", parn), ShouldBeNil)
+		})
+
+		Convey("It should not be allowed by another role", func() {
+			src := &api.AWSSource{
+				AllowedAccounts: []string{"123456789012"},
+				AllowedRoles:    []string{"arn:aws:iam::123456789012:role/admin-*"},
+			}
+			err := isAllowed(src, "123456789012", parn)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "arn 'arn:aws:sts::123456789012:assumed-role/admin/session' is not allowed")
+		})
+	})
+}
+
+func TestMatchPattern(t *testing.T) {
+
+	Convey("Calling matchPattern should work", t, func() {
+		So(matchPattern("arn:aws:iam::123:role/admin", "arn:aws:iam::123:role/admin"), ShouldBeTrue)
+		So(matchPattern("arn:aws:iam::123:role/*", "arn:aws:iam::123:role/path/admin"), ShouldBeTrue)
+		So(matchPattern("arn:aws:iam::*:role/admin", "arn:aws:iam::123:role/admin"), ShouldBeTrue)
+		So(matchPattern("arn:aws:iam::123:role/admin", "arn:aws:iam::123:role/admin2"), ShouldBeFalse)
+		So(matchPattern("arn:aws:iam::123:role/a.min", "arn:aws:iam::123:role/admin"), ShouldBeFalse)
+		So(matchPattern("arn:aws:iam::*:role/*-admin", "arn:aws:iam::123:role/path/ops-admin"), ShouldBeTrue)
+		So(matchPattern("arn:aws:iam::*:role/*-admin", "arn:aws:iam::123:role/path/ops-admin2"), ShouldBeFalse)
+		So(matchPattern("arn:aws:iam::123:role/a*a", "arn:aws:iam::123:role/a"), ShouldBeFalse)
+		So(matchPattern("arn:aws:iam::123:role/a*a", "arn:aws:iam::123:role/aa"), ShouldBeTrue)
+		So(matchPattern("*", "anything"), ShouldBeTrue)
+	})
+}
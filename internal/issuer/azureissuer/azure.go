@@ -4,21 +4,23 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/coreos/go-oidc/v3/oidc"
+	"go.aporeto.io/a3s/internal/identitymodifier"
+	"go.aporeto.io/a3s/internal/keyset"
+	"go.aporeto.io/a3s/pkgs/api"
 	"go.aporeto.io/a3s/pkgs/token"
 )
 
-const (
-	azureJWTCertURL  = "https://login.microsoftonline.com/common/discovery/keys"
-	azureJWTAudience = "https://management.azure.com/"
-	azureJWTIssuer   = "https://sts.windows.net/65888785-a93c-4c8f-89eb-d42bf7d03244/"
-)
+// keySets caches the Azure key sets across requests.
+var keySets = keyset.NewCache(time.Hour)
 
 // New returns a new Azure issuer.
-func New(ctx context.Context, tokenString string) (token.Issuer, error) {
+// The token must be allowed by the given source.
+func New(ctx context.Context, source *api.AzureSource, tokenString string) (token.Issuer, error) {
 
-	c := newAzureIssuer()
+	c := newAzureIssuer(source)
 	if err := c.fromToken(ctx, tokenString); err != nil {
 		return nil, err
 	}
@@ -27,13 +29,17 @@ func New(ctx context.Context, tokenString string) (token.Issuer, error) {
 }
 
 type azureIssuer struct {
-	token *token.IdentityToken
+	token  *token.IdentityToken
+	source *api.AzureSource
 }
 
-func newAzureIssuer() *azureIssuer {
+func newAzureIssuer(source *api.AzureSource) *azureIssuer {
 	return &azureIssuer{
+		source: source,
 		token: token.NewIdentityToken(token.Source{
-			Type: "azure",
+			Type:      "azure",
+			Namespace: source.Namespace,
+			Name:      source.Name,
 		}),
 	}
 }
@@ -46,8 +52,19 @@ func (c *azureIssuer) Issue() *token.IdentityToken {
 
 func (c *azureIssuer) fromToken(ctx context.Context, tokenString string) (err error) {
 
-	ks := oidc.NewRemoteKeySet(ctx, azureJWTCertURL)
-	verifier := oidc.NewVerifier(azureJWTIssuer, ks, &oidc.Config{ClientID: azureJWTAudience})
+	// The issuer depends on the tenant, so it
+	// is verified once the claims are decoded.
+	ks, err := keySets.Get(c.source.KeysURL, "")
+	if err != nil {
+		return ErrAzure{Err: err}
+	}
+
+	verifier := oidc.NewVerifier(
+		"",
+		ks,
+		&oidc.Config{ClientID: c.source.Audience, SkipIssuerCheck: true},
+	)
+
 	idt, err := verifier.Verify(ctx, tokenString)
 	if err != nil {
 		return ErrAzure{Err: err}
@@ -58,11 +75,93 @@ func (c *azureIssuer) fromToken(ctx context.Context, tokenString string) (err er
 		return ErrAzure{Err: err}
 	}
 
+	if atoken.TID == "" {
+		return ErrAzure{Err: fmt.Errorf("missing tenant id")}
+	}
+
+	if !isTenantIssuer(idt.Issuer, atoken.TID) {
+		return ErrAzure{Err: fmt.Errorf("invalid issuer '%s' for tenant '%s'", idt.Issuer, atoken.TID)}
+	}
+
+	if err := isAllowed(c.source, atoken); err != nil {
+		return ErrAzure{Err: err}
+	}
+
 	c.token.Identity = computeAzureClaims(atoken)
 
+	if srcmod := c.source.Modifier; srcmod != nil {
+
+		m, err := identitymodifier.NewRemote(srcmod, c.token.Source)
+		if err != nil {
+			return fmt.Errorf("unable to prepare source modifier: %w", err)
+		}
+
+		if c.token.Identity, err = m.Modify(ctx, c.token.Identity); err != nil {
+			return fmt.Errorf("unable to call modifier: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// isTenantIssuer returns true if the given issuer is
+// the v1 or v2 issuer of the given tenant.
+func isTenantIssuer(issuer string, tenant string) bool {
+
+	return issuer == fmt.Sprintf("https://sts.windows.net/%s/", tenant) ||
+		issuer == fmt.Sprintf("https://login.microsoftonline.com/%s/v2.0", tenant)
+}
+
+// isAllowed returns an error if the tenant or the subscription
+// of the given token is not allowed by the given source.
+func isAllowed(source *api.AzureSource, token azureJWT) error {
+
+	if len(source.AllowedTenants) > 0 {
+
+		var allowed bool
+		for _, tenant := range source.AllowedTenants {
+			if strings.EqualFold(tenant, token.TID) {
+				allowed = true
+				break
+			}
+		}
+
+		if !allowed {
+			return fmt.Errorf("tenant '%s' is not allowed", token.TID)
+		}
+	}
+
+	if len(source.AllowedSubscriptions) > 0 {
+
+		subscription := computeAzureSubscription(token)
+
+		var allowed bool
+		for _, sub := range source.AllowedSubscriptions {
+			if subscription != "" && strings.EqualFold(sub, subscription) {
+				allowed = true
+				break
+			}
+		}
+
+		if !allowed {
+			return fmt.Errorf("subscription '%s' is not allowed", subscription)
+		}
+	}
+
 	return nil
 }
 
+// computeAzureSubscription returns the subscription of the managed
+// identity of the given token, or an empty string if it has none.
+func computeAzureSubscription(token azureJWT) string {
+
+	if parts := strings.Split(token.XmsMIRID, "/"); len(parts) == 9 && parts[1] == "subscriptions" {
+		return parts[2]
+	}
+
+	return ""
+}
+
 func computeAzureClaims(token azureJWT) []string {
 
 	var out []string
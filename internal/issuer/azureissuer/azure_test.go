@@ -2,11 +2,21 @@ package azureissuer
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/golang-jwt/jwt/v4"
 	. "github.com/smartystreets/goconvey/convey"
+	"go.aporeto.io/a3s/pkgs/api"
 )
 
 func TestErrAzure(t *testing.T) {
@@ -20,16 +30,111 @@ func TestErrAzure(t *testing.T) {
 
 func TestNewAzureIssuer(t *testing.T) {
 	Convey("NewAzureIssuer should work", t, func() {
-		iss := newAzureIssuer()
+		src := &api.AzureSource{Namespace: "/ns", Name: "azure"}
+		iss := newAzureIssuer(src)
 		So(iss.Issue().Source.Type, ShouldEqual, "azure")
+		So(iss.Issue().Source.Namespace, ShouldEqual, "/ns")
+		So(iss.Issue().Source.Name, ShouldEqual, "azure")
+		So(iss.source, ShouldEqual, src)
 	})
 }
 
 func TestAzureFromToken(t *testing.T) {
-	Convey("Call FromAzureToken should work", t, func() {
-		iss := newAzureIssuer()
-		err := iss.fromToken(context.Background(), "not a token")
-		So(err, ShouldNotBeNil)
+
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"keys": []map[string]string{
+				{
+					"kty": "RSA",
+					"alg": "RS256",
+					"kid": "kid",
+					"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+					"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+				},
+			},
+		})
+	}))
+	defer ts.Close()
+
+	makeToken := func(issuer string, tenant string, subscription string) string {
+		t := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"iss":       issuer,
+			"aud":       "https://management.azure.com/",
+			"exp":       time.Now().Add(time.Hour).Unix(),
+			"oid":       "oid",
+			"tid":       tenant,
+			"xms_mirid": fmt.Sprintf("/subscriptions/%s/resourcegroups/grp/providers/prov/type/id", subscription),
+		})
+		t.Header["kid"] = "kid"
+		s, _ := t.SignedString(key)
+		return s
+	}
+
+	Convey("Given an Azure source", t, func() {
+
+		src := api.NewAzureSource()
+		src.Namespace = "/ns"
+		src.Name = "azure"
+		src.KeysURL = ts.URL
+		src.AllowedTenants = []string{"tenant"}
+		src.AllowedSubscriptions = []string{"sub"}
+
+		Convey("Calling fromToken with an invalid token should fail", func() {
+			iss := newAzureIssuer(src)
+			err := iss.fromToken(context.Background(), "not a token")
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Calling fromToken with an allowed token should work", func() {
+			iss := newAzureIssuer(src)
+			err := iss.fromToken(context.Background(), makeToken("https://sts.windows.net/tenant/", "tenant", "sub"))
+			So(err, ShouldBeNil)
+			So(iss.Issue().Identity, ShouldResemble, []string{
+				"oid=oid",
+				"tid=tenant",
+				"subscriptions=sub",
+				"resourcegroups=grp",
+				"providers=prov",
+				"providertype=type",
+				"identity=id",
+			})
+		})
+
+		Convey("Calling fromToken with a v2 token should work", func() {
+			iss := newAzureIssuer(src)
+			err := iss.fromToken(context.Background(), makeToken("https://login.microsoftonline.com/tenant/v2.0", "tenant", "sub"))
+			So(err, ShouldBeNil)
+		})
+
+		Convey("Calling fromToken with an issuer from another tenant should fail", func() {
+			iss := newAzureIssuer(src)
+			err := iss.fromToken(context.Background(), makeToken("https://sts.windows.net/other/", "tenant", "sub"))
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "azure error: invalid issuer 'https://sts.windows.net/other/' for tenant 'tenant'")
+		})
+
+		Convey("Calling fromToken with the wrong audience should fail", func() {
+			src.Audience = "other"
+			iss := newAzureIssuer(src)
+			err := iss.fromToken(context.Background(), makeToken("https://sts.windows.net/tenant/", "tenant", "sub"))
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Calling fromToken with a tenant not allowed should fail", func() {
+			iss := newAzureIssuer(src)
+			err := iss.fromToken(context.Background(), makeToken("https://sts.windows.net/other/", "other", "sub"))
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "azure error: tenant 'other' is not allowed")
+		})
+
+		Convey("Calling fromToken with a subscription not allowed should fail", func() {
+			iss := newAzureIssuer(src)
+			err := iss.fromToken(context.Background(), makeToken("https://sts.windows.net/tenant/", "tenant", "other"))
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "azure error: subscription 'other' is not allowed")
+		})
 	})
 }
 
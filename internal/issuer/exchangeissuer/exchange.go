@@ -0,0 +1,168 @@
+package exchangeissuer
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"go.aporeto.io/a3s/pkgs/token"
+)
+
+// An ExchangeIssuer is a token.Issuer that issues a token
+// for a subject on behalf of which an actor is acting.
+type ExchangeIssuer interface {
+	token.Issuer
+
+	// Actor returns the verified token of the actor.
+	Actor() *token.IdentityToken
+}
+
+// New returns a new token exchange issuer. The issued token holds the
+// identity of the subject token, and an act claim describing the bearer
+// of the actor token. The actor token must have been issued for the given
+// actorAudience, and the subject token must have been issued for all of the
+// requested audience. The issued token cannot outlive any of the input tokens.
+func New(
+	subjectToken string,
+	actorToken string,
+	keychain *token.JWKS,
+	issuer string,
+	actorAudience string,
+	audience jwt.ClaimStrings,
+	validity time.Duration,
+) (ExchangeIssuer, error) {
+
+	c := newExchangeIssuer()
+	if err := c.fromTokens(
+		subjectToken,
+		actorToken,
+		keychain,
+		issuer,
+		actorAudience,
+		audience,
+		validity,
+	); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+type exchangeIssuer struct {
+	token *token.IdentityToken
+	actor *token.IdentityToken
+}
+
+func newExchangeIssuer() *exchangeIssuer {
+	return &exchangeIssuer{}
+}
+
+func (c *exchangeIssuer) fromTokens(
+	subjectToken string,
+	actorToken string,
+	keychain *token.JWKS,
+	issuer string,
+	actorAudience string,
+	audience jwt.ClaimStrings,
+	validity time.Duration,
+) (err error) {
+
+	if c.token, err = token.Parse(subjectToken, keychain, issuer, ""); err != nil {
+		return ErrSubjectToken{Err: err}
+	}
+
+	if c.token.Refresh {
+		return ErrSubjectToken{Err: fmt.Errorf("a refresh token cannot be exchanged")}
+	}
+
+	// The exchanged token cannot be used anywhere
+	// the subject token could not be used.
+	if len(audience) == 0 && len(c.token.Audience) != 0 {
+		return ErrSubjectToken{Err: fmt.Errorf("you cannot request a token with no audience from a token that has one")}
+	}
+
+	for _, aud := range audience {
+		if !c.token.VerifyAudience(aud, true) {
+			return ErrSubjectToken{Err: fmt.Errorf("requested audience '%s' is not declared in subject token", aud)}
+		}
+	}
+
+	if c.actor, err = token.Parse(actorToken, keychain, issuer, actorAudience); err != nil {
+		return ErrActorToken{Err: err}
+	}
+
+	if c.actor.Refresh {
+		return ErrActorToken{Err: fmt.Errorf("a refresh token cannot be used as actor token")}
+	}
+
+	// The previous actor of the subject
+	// token becomes the nested actor.
+	c.token.Actor = &token.Actor{
+		Subject:  c.actor.Subject,
+		Identity: actorClaims(c.actor.Identity),
+		Actor:    c.token.Actor,
+	}
+
+	// The @source, @issuer and @actor claims are
+	// added back when the token is signed.
+	c.token.Identity = token.StripReservedClaims(c.token.Identity)
+
+	// The exchanged token will be used by the actor,
+	// so it is bound to the same certificate.
+	c.token.Confirmation = c.actor.Confirmation
+
+	c.token.ExpiresAt = computeExpiration(validity, c.token.ExpiresAt, c.actor.ExpiresAt)
+
+	return nil
+}
+
+// Issue issues a token.IdentityToken derived from the subject token.
+func (c *exchangeIssuer) Issue() *token.IdentityToken {
+
+	return c.token
+}
+
+// Actor returns the verified token of the actor.
+func (c *exchangeIssuer) Actor() *token.IdentityToken {
+
+	return c.actor
+}
+
+// actorClaims returns the claims describing the actor. Its @source
+// claims are kept, but not its own @issuer and @actor claims.
+func actorClaims(claims []string) []string {
+
+	out := make([]string, 0, len(claims))
+	for _, c := range claims {
+		if strings.HasPrefix(c, "@issuer=") || strings.HasPrefix(c, "@actor:") {
+			continue
+		}
+		out = append(out, c)
+	}
+
+	return out
+}
+
+func computeExpiration(validity time.Duration, limits ...*jwt.NumericDate) *jwt.NumericDate {
+
+	var exp time.Time
+	if validity > 0 {
+		exp = time.Now().Add(validity)
+	}
+
+	for _, limit := range limits {
+		if limit == nil {
+			continue
+		}
+		if exp.IsZero() || limit.Time.Before(exp) {
+			exp = limit.Time
+		}
+	}
+
+	if exp.IsZero() {
+		return nil
+	}
+
+	return jwt.NewNumericDate(exp)
+}
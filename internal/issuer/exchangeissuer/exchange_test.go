@@ -0,0 +1,212 @@
+package exchangeissuer
+
+import (
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.aporeto.io/a3s/pkgs/token"
+	"go.aporeto.io/tg/tglib"
+)
+
+func getECCert(subject pkix.Name, opts ...tglib.IssueOption) (*x509.Certificate, crypto.PrivateKey) {
+
+	certBlock, keyBlock, err := tglib.Issue(subject, opts...)
+	if err != nil {
+		panic(err)
+	}
+
+	cert, err := tglib.ParseCertificate(pem.EncodeToMemory(certBlock))
+	if err != nil {
+		panic(err)
+	}
+
+	key, err := tglib.PEMToKey(keyBlock)
+	if err != nil {
+		panic(err)
+	}
+
+	return cert, key
+}
+
+func TestNew(t *testing.T) {
+
+	cert, key := getECCert(pkix.Name{})
+	keychain := token.NewJWKS()
+	_ = keychain.Append(cert)
+	kid := token.Fingerprint(cert)
+
+	sign := func(idt *token.IdentityToken, audience string, exp time.Time) string {
+		tkn, err := idt.JWT(key, kid, "iss", jwt.ClaimStrings{audience}, exp, nil)
+		if err != nil {
+			panic(err)
+		}
+		return tkn
+	}
+
+	Convey("Given I have a subject and an actor token", t, func() {
+
+		subjectExp := time.Now().Add(time.Hour).Truncate(time.Second)
+		actorExp := time.Now().Add(2 * time.Hour).Truncate(time.Second)
+
+		subject := token.NewIdentityToken(token.Source{Type: "oidc", Namespace: "/a", Name: "users"})
+		subject.Identity = []string{"user=john"}
+		subject.Subject = "john"
+
+		actor := token.NewIdentityToken(token.Source{Type: "mtls", Namespace: "/a", Name: "services"})
+		actor.Identity = []string{"app=service-a"}
+		actor.Subject = "service-a"
+		actor.Confirmation = &token.Confirmation{X5TS256: "thumbprint"}
+
+		Convey("When I exchange them", func() {
+
+			iss, err := New(
+				sign(subject, "service-a", subjectExp),
+				sign(actor, "a3s", actorExp),
+				keychain,
+				"iss",
+				"a3s",
+				jwt.ClaimStrings{"service-a"},
+				0,
+			)
+
+			So(err, ShouldBeNil)
+
+			idt := iss.Issue()
+			So(idt.Subject, ShouldEqual, "john")
+			So(idt.Identity, ShouldResemble, []string{"user=john"})
+			So(idt.Source, ShouldResemble, token.Source{Type: "oidc", Namespace: "/a", Name: "users"})
+			So(idt.Actor, ShouldNotBeNil)
+			So(idt.Actor.Subject, ShouldEqual, "service-a")
+			So(idt.Actor.Identity, ShouldResemble, []string{
+				"@source:name=services",
+				"@source:namespace=/a",
+				"@source:type=mtls",
+				"app=service-a",
+			})
+			So(idt.Actor.Actor, ShouldBeNil)
+			So(idt.Confirmation, ShouldResemble, &token.Confirmation{X5TS256: "thumbprint"})
+			So(idt.ExpiresAt.Time.Equal(subjectExp), ShouldBeTrue)
+
+			So(iss.Actor().Subject, ShouldEqual, "service-a")
+
+			Convey("Then exchanging the result again should nest the actors", func() {
+
+				actor2 := token.NewIdentityToken(token.Source{Type: "mtls"})
+				actor2.Identity = []string{"app=service-b"}
+				actor2.Subject = "service-b"
+
+				iss2, err := New(
+					sign(idt, "service-b", time.Time{}),
+					sign(actor2, "a3s", actorExp),
+					keychain,
+					"iss",
+					"a3s",
+					jwt.ClaimStrings{"service-b"},
+					time.Minute,
+				)
+
+				So(err, ShouldBeNil)
+
+				idt2 := iss2.Issue()
+				So(idt2.Identity, ShouldResemble, []string{"user=john"})
+				So(idt2.Actor.Subject, ShouldEqual, "service-b")
+				So(idt2.Actor.Actor.Subject, ShouldEqual, "service-a")
+				So(idt2.Confirmation, ShouldBeNil)
+				So(idt2.ExpiresAt.Time.Before(subjectExp), ShouldBeTrue)
+			})
+		})
+
+		Convey("When the actor token has the wrong audience", func() {
+
+			iss, err := New(
+				sign(subject, "service-a", subjectExp),
+				sign(actor, "other", actorExp),
+				keychain,
+				"iss",
+				"a3s",
+				jwt.ClaimStrings{"service-a"},
+				0,
+			)
+
+			So(iss, ShouldBeNil)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "unable to parse actor token: audience '[other]' is not acceptable. want 'a3s'")
+		})
+
+		Convey("When the requested audience is not declared in the subject token", func() {
+
+			iss, err := New(
+				sign(subject, "service-a", subjectExp),
+				sign(actor, "a3s", actorExp),
+				keychain,
+				"iss",
+				"a3s",
+				jwt.ClaimStrings{"service-a", "other"},
+				0,
+			)
+
+			So(iss, ShouldBeNil)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "unable to parse subject token: requested audience 'other' is not declared in subject token")
+		})
+
+		Convey("When no audience is requested from a subject token that has one", func() {
+
+			iss, err := New(
+				sign(subject, "service-a", subjectExp),
+				sign(actor, "a3s", actorExp),
+				keychain,
+				"iss",
+				"a3s",
+				nil,
+				0,
+			)
+
+			So(iss, ShouldBeNil)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "unable to parse subject token: you cannot request a token with no audience from a token that has one")
+		})
+
+		Convey("When the subject token is a refresh token", func() {
+
+			subject.Refresh = true
+
+			iss, err := New(
+				sign(subject, "service-a", subjectExp),
+				sign(actor, "a3s", actorExp),
+				keychain,
+				"iss",
+				"a3s",
+				jwt.ClaimStrings{"service-a"},
+				0,
+			)
+
+			So(iss, ShouldBeNil)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "unable to parse subject token: a refresh token cannot be exchanged")
+		})
+
+		Convey("When the subject token is invalid", func() {
+
+			iss, err := New(
+				"not a token",
+				sign(actor, "a3s", actorExp),
+				keychain,
+				"iss",
+				"a3s",
+				jwt.ClaimStrings{"service-a"},
+				0,
+			)
+
+			So(iss, ShouldBeNil)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldStartWith, "unable to parse subject token: unable to parse jwt:")
+		})
+	})
+}
@@ -0,0 +1,31 @@
+package exchangeissuer
+
+import "fmt"
+
+// An ErrSubjectToken represents a generic subject token error.
+type ErrSubjectToken struct {
+	Err error
+}
+
+func (e ErrSubjectToken) Error() string {
+	return fmt.Sprintf("unable to parse subject token: %s", e.Err)
+}
+
+// Unwrap returns the wrapped error.
+func (e ErrSubjectToken) Unwrap() error {
+	return e.Err
+}
+
+// An ErrActorToken represents a generic actor token error.
+type ErrActorToken struct {
+	Err error
+}
+
+func (e ErrActorToken) Error() string {
+	return fmt.Sprintf("unable to parse actor token: %s", e.Err)
+}
+
+// Unwrap returns the wrapped error.
+func (e ErrActorToken) Unwrap() error {
+	return e.Err
+}
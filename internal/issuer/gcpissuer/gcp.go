@@ -1,27 +1,26 @@
 package gcpissuer
 
 import (
-	"crypto/rsa"
-	"encoding/json"
+	"context"
 	"fmt"
-	"net/http"
 	"time"
 
-	"github.com/golang-jwt/jwt/v4"
+	"github.com/coreos/go-oidc/v3/oidc"
+	"go.aporeto.io/a3s/internal/identitymodifier"
+	"go.aporeto.io/a3s/internal/keyset"
+	"go.aporeto.io/a3s/pkgs/api"
 	"go.aporeto.io/a3s/pkgs/token"
-	"go.aporeto.io/tg/tglib"
 )
 
-const (
-	gcpClaimsCertURL        = "https://www.googleapis.com/oauth2/v1/certs"
-	gcpClaimsRequiredIssuer = "https://accounts.google.com"
-)
+// keySets caches the GCP key sets across requests.
+var keySets = keyset.NewCache(time.Hour)
 
 // New returns a new GCP issuer.
-func New(tokenString string, audience string) (token.Issuer, error) {
+// The token must be allowed by the given source.
+func New(ctx context.Context, source *api.GCPSource, tokenString string, audience string) (token.Issuer, error) {
 
-	c := newGCPIssuer()
-	if err := c.fromToken(tokenString, audience); err != nil {
+	c := newGCPIssuer(source)
+	if err := c.fromToken(ctx, tokenString, audience); err != nil {
 		return nil, err
 	}
 
@@ -29,13 +28,17 @@ func New(tokenString string, audience string) (token.Issuer, error) {
 }
 
 type gcpIssuer struct {
-	token *token.IdentityToken
+	token  *token.IdentityToken
+	source *api.GCPSource
 }
 
-func newGCPIssuer() *gcpIssuer {
+func newGCPIssuer(source *api.GCPSource) *gcpIssuer {
 	return &gcpIssuer{
+		source: source,
 		token: token.NewIdentityToken(token.Source{
-			Type: "gcp",
+			Type:      "gcp",
+			Namespace: source.Namespace,
+			Name:      source.Name,
 		}),
 	}
 }
@@ -44,57 +47,108 @@ func (c *gcpIssuer) Issue() *token.IdentityToken {
 	return c.token
 }
 
-func (c *gcpIssuer) fromToken(tokenString string, audience string) (err error) {
+func (c *gcpIssuer) fromToken(ctx context.Context, tokenString string, audience string) (err error) {
 
-	resp, err := http.Get(gcpClaimsCertURL)
+	ks, err := keySets.Get(c.source.KeysURL, "")
 	if err != nil {
-		return err
+		return ErrGCP{Err: err}
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("invalid status code returned: %d", resp.StatusCode)
-	}
+	verifier := oidc.NewVerifier(
+		c.source.Issuer,
+		ks,
+		&oidc.Config{SkipClientIDCheck: true},
+	)
 
-	certsMap := map[string]string{}
-	if err = json.NewDecoder(resp.Body).Decode(&certsMap); err != nil {
-		return err
+	idt, err := verifier.Verify(ctx, tokenString)
+	if err != nil {
+		return ErrGCP{Err: err}
 	}
 
 	gcpToken := gcpJWT{}
-	for _, v := range certsMap {
-		cert, err := tglib.ParseCertificate([]byte(v))
-		if err != nil {
-			return err
-		}
-		if _, err := jwt.ParseWithClaims(tokenString, &gcpToken, func(t *jwt.Token) (any, error) {
-			if _, ok := t.Method.(*jwt.SigningMethodRSA); ok {
-				return cert.PublicKey.(*rsa.PublicKey), nil
-			}
-			return nil, fmt.Errorf("unexpected signing method: %s", t.Header["alg"])
-		}); err == nil {
-			break
+	if err := idt.Claims(&gcpToken); err != nil {
+		return ErrGCP{Err: err}
+	}
+
+	if c.source.Audience != "" {
+		audience = c.source.Audience
+	}
+
+	if audience != "" {
+		if !hasAudience(idt.Audience, audience) {
+			return ErrGCP{Err: fmt.Errorf("invalid audience '%s' want '%s'", idt.Audience, audience)}
 		}
 	}
 
-	if ok := gcpToken.VerifyExpiresAt(time.Now(), true); !ok {
-		return ErrGCP{Err: fmt.Errorf("GCP token is expired")}
+	if err := isAllowed(c.source, gcpToken); err != nil {
+		return ErrGCP{Err: err}
 	}
 
-	if gcpToken.Issuer != gcpClaimsRequiredIssuer {
-		return ErrGCP{Err: fmt.Errorf("Invalid issuer from gcp token '%s'. Want '%s'", gcpToken.Issuer, gcpClaimsRequiredIssuer)}
+	c.token.Identity = computeGCPClaims(gcpToken)
+
+	if srcmod := c.source.Modifier; srcmod != nil {
+
+		m, err := identitymodifier.NewRemote(srcmod, c.token.Source)
+		if err != nil {
+			return fmt.Errorf("unable to prepare source modifier: %w", err)
+		}
+
+		if c.token.Identity, err = m.Modify(ctx, c.token.Identity); err != nil {
+			return fmt.Errorf("unable to call modifier: %w", err)
+		}
 	}
 
-	if audience != "" {
-		if !gcpToken.VerifyAudience(audience, true) {
-			return ErrGCP{Err: fmt.Errorf("invalid audience '%s' want '%s'", gcpToken.Audience, audience)}
+	return nil
+}
+
+// isAllowed returns an error if the project of the
+// given token is not allowed by the given source.
+func isAllowed(source *api.GCPSource, token gcpJWT) error {
+
+	if len(source.AllowedProjectIDs) > 0 {
+
+		var allowed bool
+		for _, id := range source.AllowedProjectIDs {
+			if id == token.Google.ComputeEngine.ProjectID {
+				allowed = true
+				break
+			}
+		}
+
+		if !allowed {
+			return fmt.Errorf("project id '%s' is not allowed", token.Google.ComputeEngine.ProjectID)
 		}
 	}
 
-	c.token.Identity = computeGCPClaims(gcpToken)
+	if len(source.AllowedProjectNumbers) > 0 {
+
+		var allowed bool
+		for _, number := range source.AllowedProjectNumbers {
+			if number == token.Google.ComputeEngine.ProjectNumber {
+				allowed = true
+				break
+			}
+		}
+
+		if !allowed {
+			return fmt.Errorf("project number '%d' is not allowed", token.Google.ComputeEngine.ProjectNumber)
+		}
+	}
 
 	return nil
 }
 
+func hasAudience(audiences []string, audience string) bool {
+
+	for _, aud := range audiences {
+		if aud == audience {
+			return true
+		}
+	}
+
+	return false
+}
+
 func computeGCPClaims(token gcpJWT) []string {
 
 	var out []string
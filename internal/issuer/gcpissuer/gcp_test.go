@@ -1,11 +1,22 @@
 package gcpissuer
 
 import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/golang-jwt/jwt/v4"
 	. "github.com/smartystreets/goconvey/convey"
+	"go.aporeto.io/a3s/pkgs/api"
 )
 
 func TestErrGCP(t *testing.T) {
@@ -19,18 +30,111 @@ func TestErrGCP(t *testing.T) {
 
 func TestNewGCPIssuer(t *testing.T) {
 	Convey("NewGCPIssuer should work", t, func() {
-		iss := newGCPIssuer()
+		src := &api.GCPSource{Namespace: "/ns", Name: "gcp"}
+		iss := newGCPIssuer(src)
 		So(iss.Issue().Source.Type, ShouldEqual, "gcp")
+		So(iss.Issue().Source.Namespace, ShouldEqual, "/ns")
+		So(iss.Issue().Source.Name, ShouldEqual, "gcp")
+		So(iss.source, ShouldEqual, src)
 	})
 }
 
 func TestGCPFromToken(t *testing.T) {
-	Convey("Call FromToken should work", t, func() {
-		iss := newGCPIssuer()
-		err := iss.fromToken("not a token", "aud")
-		So(err, ShouldNotBeNil)
+
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"keys": []map[string]string{
+				{
+					"kty": "RSA",
+					"alg": "RS256",
+					"kid": "kid",
+					"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+					"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+				},
+			},
+		})
+	}))
+	defer ts.Close()
+
+	makeToken := func(issuer string, audience string, projectID string, projectNumber int) string {
+		claims := gcpJWT{}
+		claims.Issuer = issuer
+		claims.Subject = "sub"
+		claims.Audience = jwt.ClaimStrings{audience}
+		claims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(time.Hour))
+		claims.Google.ComputeEngine.ProjectID = projectID
+		claims.Google.ComputeEngine.ProjectNumber = projectNumber
+		t := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		t.Header["kid"] = "kid"
+		s, _ := t.SignedString(key)
+		return s
+	}
+
+	Convey("Given a GCP source", t, func() {
+
+		src := api.NewGCPSource()
+		src.Namespace = "/ns"
+		src.Name = "gcp"
+		src.KeysURL = ts.URL
+		src.AllowedProjectIDs = []string{"my-project"}
+		src.AllowedProjectNumbers = []int{42}
+
+		Convey("Calling fromToken with an invalid token should fail", func() {
+			iss := newGCPIssuer(src)
+			err := iss.fromToken(context.Background(), "not a token", "aud")
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Calling fromToken with an allowed token should work", func() {
+			iss := newGCPIssuer(src)
+			err := iss.fromToken(context.Background(), makeToken("https://accounts.google.com", "aud", "my-project", 42), "aud")
+			So(err, ShouldBeNil)
+			So(iss.Issue().Identity, ShouldResemble, []string{
+				"subject=sub",
+				"projectid=my-project",
+				"projectnumber=42",
+			})
+		})
+
+		Convey("Calling fromToken with a token from another issuer should fail", func() {
+			iss := newGCPIssuer(src)
+			err := iss.fromToken(context.Background(), makeToken("https://not-google.com", "aud", "my-project", 42), "aud")
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Calling fromToken with the wrong audience should fail", func() {
+			iss := newGCPIssuer(src)
+			err := iss.fromToken(context.Background(), makeToken("https://accounts.google.com", "aud", "my-project", 42), "other")
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "gcp error: invalid audience '[aud]' want 'other'")
+		})
+
+		Convey("Calling fromToken with the wrong source audience should fail", func() {
+			src.Audience = "other"
+			iss := newGCPIssuer(src)
+			err := iss.fromToken(context.Background(), makeToken("https://accounts.google.com", "aud", "my-project", 42), "aud")
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "gcp error: invalid audience '[aud]' want 'other'")
+		})
+
+		Convey("Calling fromToken with a project id not allowed should fail", func() {
+			iss := newGCPIssuer(src)
+			err := iss.fromToken(context.Background(), makeToken("https://accounts.google.com", "aud", "other", 42), "aud")
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "gcp error: project id 'other' is not allowed")
+		})
+
+		Convey("Calling fromToken with a project number not allowed should fail", func() {
+			iss := newGCPIssuer(src)
+			err := iss.fromToken(context.Background(), makeToken("https://accounts.google.com", "aud", "my-project", 43), "aud")
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "gcp error: project number '43' is not allowed")
+		})
 	})
 }
+
 func Test_computeGCPClaims(t *testing.T) {
 	type args struct {
 		token gcpJWT
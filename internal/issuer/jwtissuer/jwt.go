@@ -0,0 +1,186 @@
+package jwtissuer
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"go.aporeto.io/a3s/internal/identitymodifier"
+	"go.aporeto.io/a3s/internal/issuer/oidcissuer"
+	"go.aporeto.io/a3s/internal/keyset"
+	"go.aporeto.io/a3s/internal/oidcceremony"
+	"go.aporeto.io/a3s/pkgs/api"
+	"go.aporeto.io/a3s/pkgs/token"
+)
+
+// keySets caches the remote key sets across requests.
+var keySets = keyset.NewCache(time.Hour)
+
+// keysURLs caches the JWKS URLs discovered from the issuers.
+var keysURLs sync.Map
+
+// supportedSigningAlgs lists the algorithms accepted
+// for the tokens, as external issuers do not all use RS256.
+var supportedSigningAlgs = []string{
+	oidc.RS256, oidc.RS384, oidc.RS512,
+	oidc.ES256, oidc.ES384, oidc.ES512,
+	oidc.PS256, oidc.PS384, oidc.PS512,
+	oidc.EdDSA,
+}
+
+// New returns a new JWT issuer.
+// The token must be verified and allowed by the given source.
+func New(ctx context.Context, source *api.JWTSource, tokenString string) (token.Issuer, error) {
+
+	c := newJWTIssuer(source)
+	if err := c.fromToken(ctx, tokenString); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+type jwtIssuer struct {
+	token  *token.IdentityToken
+	source *api.JWTSource
+}
+
+func newJWTIssuer(source *api.JWTSource) *jwtIssuer {
+	return &jwtIssuer{
+		source: source,
+		token: token.NewIdentityToken(token.Source{
+			Type:      "jwt",
+			Namespace: source.Namespace,
+			Name:      source.Name,
+		}),
+	}
+}
+
+// Issue returns the IdentityToken.
+func (c *jwtIssuer) Issue() *token.IdentityToken {
+
+	return c.token
+}
+
+func (c *jwtIssuer) fromToken(ctx context.Context, tokenString string) (err error) {
+
+	ks, err := c.keySet(ctx)
+	if err != nil {
+		return ErrJWT{Err: err}
+	}
+
+	verifier := oidc.NewVerifier(
+		c.source.Issuer,
+		ks,
+		&oidc.Config{
+			ClientID:             c.source.Audience,
+			SupportedSigningAlgs: supportedSigningAlgs,
+		},
+	)
+
+	idt, err := verifier.Verify(ctx, tokenString)
+	if err != nil {
+		return ErrJWT{Err: err}
+	}
+
+	claims := map[string]any{}
+	if err := idt.Claims(&claims); err != nil {
+		return ErrJWT{Err: err}
+	}
+
+	identity := oidcissuer.ComputeClaims(claims)
+
+	if err := hasRequiredClaims(identity, c.source.RequiredClaims); err != nil {
+		return ErrJWT{Err: err}
+	}
+
+	inc, exc := oidcissuer.ComputeInclusion(c.source.IncludedKeys, c.source.IgnoredKeys)
+
+	c.token.Identity = oidcissuer.FilterClaims(identity, inc, exc)
+
+	if srcmod := c.source.Modifier; srcmod != nil {
+
+		m, err := identitymodifier.NewRemote(srcmod, c.token.Source)
+		if err != nil {
+			return fmt.Errorf("unable to prepare source modifier: %w", err)
+		}
+
+		if c.token.Identity, err = m.Modify(ctx, c.token.Identity); err != nil {
+			return fmt.Errorf("unable to call modifier: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// keySet returns the key set to use to verify the tokens.
+// A static JWKS takes precedence over the remote keys.
+func (c *jwtIssuer) keySet(ctx context.Context) (oidc.KeySet, error) {
+
+	if c.source.JWKS != "" {
+
+		jwks, err := token.ParseJWKS([]byte(c.source.JWKS))
+		if err != nil {
+			return nil, err
+		}
+
+		keys := make([]crypto.PublicKey, 0, len(jwks.Keys))
+		for _, k := range jwks.Keys {
+			if pk := k.PublicKey(); pk != nil {
+				keys = append(keys, pk)
+			}
+		}
+
+		return &oidc.StaticKeySet{PublicKeys: keys}, nil
+	}
+
+	keysURL := c.source.KeysURL
+	if keysURL == "" {
+
+		cacheKey := c.source.Issuer + "\n" + c.source.CA
+		if u, ok := keysURLs.Load(cacheKey); ok {
+			keysURL = u.(string)
+		} else {
+
+			client, err := oidcceremony.MakeOIDCProviderClient(c.source.CA)
+			if err != nil {
+				return nil, fmt.Errorf("unable to create discovery http client: %w", err)
+			}
+
+			u, err := token.DiscoverJWKSURL(ctx, client, c.source.Issuer, c.source.Issuer)
+			if err != nil {
+				return nil, fmt.Errorf("unable to discover keys url: %w", err)
+			}
+
+			keysURLs.Store(cacheKey, u)
+			keysURL = u
+		}
+	}
+
+	return keySets.Get(keysURL, c.source.CA)
+}
+
+// hasRequiredClaims returns an error if one of
+// the required claims is missing from the given claims.
+func hasRequiredClaims(claims []string, required []string) error {
+
+	for _, r := range required {
+
+		var found bool
+		for _, claim := range claims {
+			if claim == r {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("missing required claim '%s'", r)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,233 @@
+package jwtissuer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.aporeto.io/a3s/pkgs/api"
+)
+
+func TestErrJWT(t *testing.T) {
+	Convey("ErrJWT should behave correctly ", t, func() {
+		e := fmt.Errorf("boom")
+		err := ErrJWT{Err: e}
+		So(err.Error(), ShouldEqual, "jwt error: boom")
+		So(err.Unwrap(), ShouldEqual, e)
+	})
+}
+
+func TestNewJWTIssuer(t *testing.T) {
+	Convey("NewJWTIssuer should work", t, func() {
+		src := &api.JWTSource{Namespace: "/ns", Name: "jwt"}
+		iss := newJWTIssuer(src)
+		So(iss.Issue().Source.Type, ShouldEqual, "jwt")
+		So(iss.Issue().Source.Namespace, ShouldEqual, "/ns")
+		So(iss.Issue().Source.Name, ShouldEqual, "jwt")
+		So(iss.source, ShouldEqual, src)
+	})
+}
+
+func TestJWTFromTokenRemote(t *testing.T) {
+
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+
+	var issuer string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/.well-known/openid-configuration":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"issuer":   issuer,
+				"jwks_uri": issuer + "/keys",
+			})
+		case "/keys":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"keys": []map[string]string{
+					{
+						"kty": "RSA",
+						"alg": "RS256",
+						"kid": "kid",
+						"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+						"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+					},
+				},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	issuer = ts.URL
+
+	makeToken := func(issuer string, audience string) string {
+		t := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"iss":        issuer,
+			"aud":        audience,
+			"exp":        time.Now().Add(time.Hour).Unix(),
+			"sub":        "repo:org/repo:ref:refs/heads/main",
+			"repository": "org/repo",
+			"ref":        "refs/heads/main",
+		})
+		t.Header["kid"] = "kid"
+		s, _ := t.SignedString(key)
+		return s
+	}
+
+	Convey("Given a JWT source using remote keys", t, func() {
+
+		src := api.NewJWTSource()
+		src.Namespace = "/ns"
+		src.Name = "jwt"
+		src.Issuer = issuer
+		src.KeysURL = issuer + "/keys"
+		src.Audience = "a3s"
+
+		Convey("Calling fromToken with an invalid token should fail", func() {
+			iss := newJWTIssuer(src)
+			err := iss.fromToken(context.Background(), "not a token")
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Calling fromToken with a valid token should work", func() {
+			iss := newJWTIssuer(src)
+			err := iss.fromToken(context.Background(), makeToken(issuer, "a3s"))
+			So(err, ShouldBeNil)
+			So(iss.Issue().Identity, ShouldContain, "repository=org/repo")
+			So(iss.Issue().Identity, ShouldContain, "ref=refs/heads/main")
+			So(iss.Issue().Identity, ShouldContain, "sub=repo:org/repo:ref:refs/heads/main")
+		})
+
+		Convey("Calling fromToken with the keys url discovered from the issuer should work", func() {
+			src.KeysURL = ""
+			iss := newJWTIssuer(src)
+			err := iss.fromToken(context.Background(), makeToken(issuer, "a3s"))
+			So(err, ShouldBeNil)
+			So(iss.Issue().Identity, ShouldContain, "repository=org/repo")
+		})
+
+		Convey("Calling fromToken with an invalid CA should fail", func() {
+			src.CA = "not a certificate"
+			iss := newJWTIssuer(src)
+			err := iss.fromToken(context.Background(), makeToken(issuer, "a3s"))
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "jwt error: unable to create key set http client: unable to append given ca to ca pool")
+		})
+
+		Convey("Calling fromToken with an invalid CA and the keys url discovered from the issuer should fail", func() {
+			src.KeysURL = ""
+			src.CA = "not a certificate"
+			iss := newJWTIssuer(src)
+			err := iss.fromToken(context.Background(), makeToken(issuer, "a3s"))
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "jwt error: unable to create discovery http client: unable to append given ca to ca pool")
+		})
+
+		Convey("Calling fromToken with a token from another issuer should fail", func() {
+			iss := newJWTIssuer(src)
+			err := iss.fromToken(context.Background(), makeToken("https://other", "a3s"))
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Calling fromToken with the wrong audience should fail", func() {
+			iss := newJWTIssuer(src)
+			err := iss.fromToken(context.Background(), makeToken(issuer, "other"))
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Calling fromToken with the required claims should work", func() {
+			src.RequiredClaims = []string{"repository=org/repo", "ref=refs/heads/main"}
+			iss := newJWTIssuer(src)
+			err := iss.fromToken(context.Background(), makeToken(issuer, "a3s"))
+			So(err, ShouldBeNil)
+		})
+
+		Convey("Calling fromToken with a missing required claim should fail", func() {
+			src.RequiredClaims = []string{"repository=org/other"}
+			iss := newJWTIssuer(src)
+			err := iss.fromToken(context.Background(), makeToken(issuer, "a3s"))
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "jwt error: missing required claim 'repository=org/other'")
+		})
+
+		Convey("Calling fromToken with included and ignored keys should filter the claims", func() {
+			src.IncludedKeys = []string{"Repository", "ref"}
+			src.IgnoredKeys = []string{"ref"}
+			iss := newJWTIssuer(src)
+			err := iss.fromToken(context.Background(), makeToken(issuer, "a3s"))
+			So(err, ShouldBeNil)
+			So(iss.Issue().Identity, ShouldResemble, []string{"repository=org/repo"})
+		})
+	})
+}
+
+func TestJWTFromTokenStatic(t *testing.T) {
+
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	jwks, _ := json.Marshal(map[string]any{
+		"keys": []map[string]string{
+			{
+				"kty": "EC",
+				"alg": "ES256",
+				"crv": "P-256",
+				"kid": "kid",
+				"x":   base64.RawURLEncoding.EncodeToString(key.X.Bytes()),
+				"y":   base64.RawURLEncoding.EncodeToString(key.Y.Bytes()),
+			},
+		},
+	})
+
+	makeToken := func(key *ecdsa.PrivateKey) string {
+		t := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+			"iss": "https://issuer",
+			"aud": "a3s",
+			"exp": time.Now().Add(time.Hour).Unix(),
+			"sub": "user",
+		})
+		t.Header["kid"] = "kid"
+		s, _ := t.SignedString(key)
+		return s
+	}
+
+	Convey("Given a JWT source using a static JWKS", t, func() {
+
+		src := api.NewJWTSource()
+		src.Issuer = "https://issuer"
+		src.Audience = "a3s"
+		src.JWKS = string(jwks)
+
+		Convey("Calling fromToken with a valid token should work", func() {
+			iss := newJWTIssuer(src)
+			err := iss.fromToken(context.Background(), makeToken(key))
+			So(err, ShouldBeNil)
+			So(iss.Issue().Identity, ShouldContain, "sub=user")
+		})
+
+		Convey("Calling fromToken with a token signed by another key should fail", func() {
+			otherKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			iss := newJWTIssuer(src)
+			err := iss.fromToken(context.Background(), makeToken(otherKey))
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Calling fromToken with an invalid JWKS should fail", func() {
+			src.JWKS = "not json"
+			iss := newJWTIssuer(src)
+			err := iss.fromToken(context.Background(), makeToken(key))
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,18 @@
+package jwtissuer
+
+import "fmt"
+
+// ErrJWT represents an error that happened
+// during operations related to JWT.
+type ErrJWT struct {
+	Err error
+}
+
+func (e ErrJWT) Error() string {
+	return fmt.Sprintf("jwt error: %s", e.Err)
+}
+
+// Unwrap returns the warped error.
+func (e ErrJWT) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,263 @@
+package kubernetesissuer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"go.aporeto.io/a3s/internal/identitymodifier"
+	"go.aporeto.io/a3s/internal/keyset"
+	"go.aporeto.io/a3s/internal/oidcceremony"
+	"go.aporeto.io/a3s/pkgs/api"
+	"go.aporeto.io/a3s/pkgs/token"
+)
+
+const (
+	serviceAccountPrefix = "system:serviceaccount:"
+	podNameExtra         = "authentication.kubernetes.io/pod-name"
+	podUIDExtra          = "authentication.kubernetes.io/pod-uid"
+)
+
+// keySets caches the Kubernetes key sets across requests.
+var keySets = keyset.NewCache(time.Hour)
+
+// supportedSigningAlgs lists the algorithms accepted for the
+// service account tokens, as clusters can be configured
+// to sign them with other keys than RSA ones.
+var supportedSigningAlgs = []string{
+	oidc.RS256, oidc.RS384, oidc.RS512,
+	oidc.ES256, oidc.ES384, oidc.ES512,
+	oidc.PS256, oidc.PS384, oidc.PS512,
+	oidc.EdDSA,
+}
+
+// New returns a new Kubernetes issuer.
+// The token is verified according to the mode of the given source.
+func New(ctx context.Context, source *api.KubernetesSource, tokenString string) (token.Issuer, error) {
+
+	c := newKubernetesIssuer(source)
+	if err := c.fromToken(ctx, tokenString); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+type kubernetesIssuer struct {
+	token  *token.IdentityToken
+	source *api.KubernetesSource
+}
+
+func newKubernetesIssuer(source *api.KubernetesSource) *kubernetesIssuer {
+	return &kubernetesIssuer{
+		source: source,
+		token: token.NewIdentityToken(token.Source{
+			Type:      "kubernetes",
+			Namespace: source.Namespace,
+			Name:      source.Name,
+		}),
+	}
+}
+
+// Issue returns the IdentityToken.
+func (c *kubernetesIssuer) Issue() *token.IdentityToken {
+
+	return c.token
+}
+
+func (c *kubernetesIssuer) fromToken(ctx context.Context, tokenString string) (err error) {
+
+	// Without audience, any service account token of the
+	// cluster would be accepted, including the ones meant
+	// for the Kubernetes API server.
+	if c.source.Audience == "" {
+		return ErrKubernetes{Err: fmt.Errorf("source has no audience")}
+	}
+
+	var info serviceAccountInfo
+
+	switch c.source.Mode {
+	case api.KubernetesSourceModeTokenReview:
+		info, err = c.review(ctx, tokenString)
+	default:
+		info, err = c.verify(ctx, tokenString)
+	}
+
+	if err != nil {
+		return ErrKubernetes{Err: err}
+	}
+
+	c.token.Identity = computeKubernetesClaims(info)
+
+	if srcmod := c.source.Modifier; srcmod != nil {
+
+		m, err := identitymodifier.NewRemote(srcmod, c.token.Source)
+		if err != nil {
+			return fmt.Errorf("unable to prepare source modifier: %w", err)
+		}
+
+		if c.token.Identity, err = m.Modify(ctx, c.token.Identity); err != nil {
+			return fmt.Errorf("unable to call modifier: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// verify verifies the token using the keys published by the cluster.
+func (c *kubernetesIssuer) verify(ctx context.Context, tokenString string) (serviceAccountInfo, error) {
+
+	ks, err := keySets.Get(c.source.KeysURL, c.source.CA)
+	if err != nil {
+		return serviceAccountInfo{}, err
+	}
+
+	verifier := oidc.NewVerifier(
+		c.source.Issuer,
+		ks,
+		&oidc.Config{
+			ClientID:             c.source.Audience,
+			SupportedSigningAlgs: supportedSigningAlgs,
+		},
+	)
+
+	idt, err := verifier.Verify(ctx, tokenString)
+	if err != nil {
+		return serviceAccountInfo{}, fmt.Errorf("unable to verify token: %w", err)
+	}
+
+	ktoken := kubernetesJWT{}
+	if err := idt.Claims(&ktoken); err != nil {
+		return serviceAccountInfo{}, fmt.Errorf("unable to decode token claims: %w", err)
+	}
+
+	if ktoken.Kubernetes.Namespace == "" || ktoken.Kubernetes.ServiceAccount.Name == "" {
+		return serviceAccountInfo{}, fmt.Errorf("token is not a projected service account token")
+	}
+
+	return serviceAccountInfo{
+		namespace:      ktoken.Kubernetes.Namespace,
+		serviceAccount: ktoken.Kubernetes.ServiceAccount.Name,
+		uid:            ktoken.Kubernetes.ServiceAccount.UID,
+		pod:            ktoken.Kubernetes.Pod.Name,
+		podUID:         ktoken.Kubernetes.Pod.UID,
+	}, nil
+}
+
+// review asks the Kubernetes API server to verify the token.
+func (c *kubernetesIssuer) review(ctx context.Context, tokenString string) (serviceAccountInfo, error) {
+
+	review := tokenReview{
+		APIVersion: "authentication.k8s.io/v1",
+		Kind:       "TokenReview",
+		Spec: tokenReviewSpec{
+			Token:     tokenString,
+			Audiences: []string{c.source.Audience},
+		},
+	}
+
+	data, err := json.Marshal(review)
+	if err != nil {
+		return serviceAccountInfo{}, fmt.Errorf("unable to encode token review: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		strings.TrimRight(c.source.Endpoint, "/")+"/apis/authentication.k8s.io/v1/tokenreviews",
+		bytes.NewBuffer(data),
+	)
+	if err != nil {
+		return serviceAccountInfo{}, fmt.Errorf("unable to build token review request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.source.ReviewerToken)
+
+	client, err := oidcceremony.MakeOIDCProviderClient(c.source.CA)
+	if err != nil {
+		return serviceAccountInfo{}, fmt.Errorf("unable to create token review http client: %w", err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return serviceAccountInfo{}, fmt.Errorf("unable to send token review: %w", err)
+	}
+	defer resp.Body.Close() // nolint: errcheck
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return serviceAccountInfo{}, fmt.Errorf("invalid token review status code: %d", resp.StatusCode)
+	}
+
+	out := tokenReview{}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return serviceAccountInfo{}, fmt.Errorf("unable to decode token review: %w", err)
+	}
+
+	if !out.Status.Authenticated {
+		return serviceAccountInfo{}, fmt.Errorf("token rejected by token review: %s", out.Status.Error)
+	}
+
+	if !hasAudience(out.Status.Audiences, c.source.Audience) {
+		return serviceAccountInfo{}, fmt.Errorf("invalid audience '%s' want '%s'", out.Status.Audiences, c.source.Audience)
+	}
+
+	parts := strings.Split(strings.TrimPrefix(out.Status.User.Username, serviceAccountPrefix), ":")
+	if !strings.HasPrefix(out.Status.User.Username, serviceAccountPrefix) || len(parts) != 2 {
+		return serviceAccountInfo{}, fmt.Errorf("'%s' is not a service account", out.Status.User.Username)
+	}
+
+	info := serviceAccountInfo{
+		namespace:      parts[0],
+		serviceAccount: parts[1],
+		uid:            out.Status.User.UID,
+	}
+
+	if v := out.Status.User.Extra[podNameExtra]; len(v) > 0 {
+		info.pod = v[0]
+	}
+
+	if v := out.Status.User.Extra[podUIDExtra]; len(v) > 0 {
+		info.podUID = v[0]
+	}
+
+	return info, nil
+}
+
+func hasAudience(audiences []string, audience string) bool {
+
+	for _, aud := range audiences {
+		if aud == audience {
+			return true
+		}
+	}
+
+	return false
+}
+
+func computeKubernetesClaims(info serviceAccountInfo) []string {
+
+	out := []string{
+		fmt.Sprintf("namespace=%s", info.namespace),
+		fmt.Sprintf("serviceaccount=%s", info.serviceAccount),
+	}
+
+	if info.uid != "" {
+		out = append(out, fmt.Sprintf("uid=%s", info.uid))
+	}
+
+	if info.pod != "" {
+		out = append(out, fmt.Sprintf("pod=%s", info.pod))
+	}
+
+	if info.podUID != "" {
+		out = append(out, fmt.Sprintf("poduid=%s", info.podUID))
+	}
+
+	return out
+}
@@ -0,0 +1,334 @@
+package kubernetesissuer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.aporeto.io/a3s/pkgs/api"
+)
+
+func TestErrKubernetes(t *testing.T) {
+	Convey("ErrKubernetes should behave correctly ", t, func() {
+		e := fmt.Errorf("boom")
+		err := ErrKubernetes{Err: e}
+		So(err.Error(), ShouldEqual, "kubernetes error: boom")
+		So(err.Unwrap(), ShouldEqual, e)
+	})
+}
+
+func TestNewKubernetesIssuer(t *testing.T) {
+	Convey("NewKubernetesIssuer should work", t, func() {
+		src := &api.KubernetesSource{Namespace: "/ns", Name: "kube"}
+		iss := newKubernetesIssuer(src)
+		So(iss.Issue().Source.Type, ShouldEqual, "kubernetes")
+		So(iss.Issue().Source.Namespace, ShouldEqual, "/ns")
+		So(iss.Issue().Source.Name, ShouldEqual, "kube")
+		So(iss.source, ShouldEqual, src)
+	})
+}
+
+func TestKubernetesFromTokenJWKS(t *testing.T) {
+
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+	eckey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"keys": []map[string]string{
+				{
+					"kty": "RSA",
+					"alg": "RS256",
+					"kid": "kid",
+					"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+					"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+				},
+				{
+					"kty": "EC",
+					"alg": "ES256",
+					"kid": "eckid",
+					"crv": "P-256",
+					"x":   base64.RawURLEncoding.EncodeToString(eckey.X.FillBytes(make([]byte, 32))),
+					"y":   base64.RawURLEncoding.EncodeToString(eckey.Y.FillBytes(make([]byte, 32))),
+				},
+			},
+		})
+	}))
+	defer ts.Close()
+
+	claims := func(issuer string, audience string, kubernetes map[string]any) jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":           issuer,
+			"aud":           []string{audience},
+			"exp":           time.Now().Add(time.Hour).Unix(),
+			"sub":           "system:serviceaccount:default:app",
+			"kubernetes.io": kubernetes,
+		}
+	}
+
+	makeToken := func(issuer string, audience string, kubernetes map[string]any) string {
+		t := jwt.NewWithClaims(jwt.SigningMethodRS256, claims(issuer, audience, kubernetes))
+		t.Header["kid"] = "kid"
+		s, _ := t.SignedString(key)
+		return s
+	}
+
+	makeECToken := func(issuer string, audience string, kubernetes map[string]any) string {
+		t := jwt.NewWithClaims(jwt.SigningMethodES256, claims(issuer, audience, kubernetes))
+		t.Header["kid"] = "eckid"
+		s, _ := t.SignedString(eckey)
+		return s
+	}
+
+	kubernetes := map[string]any{
+		"namespace": "default",
+		"serviceaccount": map[string]string{
+			"name": "app",
+			"uid":  "sauid",
+		},
+		"pod": map[string]string{
+			"name": "app-1234",
+			"uid":  "poduid",
+		},
+	}
+
+	Convey("Given a Kubernetes source using JWKS", t, func() {
+
+		src := api.NewKubernetesSource()
+		src.Namespace = "/ns"
+		src.Name = "kube"
+		src.Mode = api.KubernetesSourceModeJWKS
+		src.Issuer = "https://kubernetes.default.svc.cluster.local"
+		src.KeysURL = ts.URL
+		src.Audience = "a3s"
+
+		Convey("Calling fromToken with an invalid token should fail", func() {
+			iss := newKubernetesIssuer(src)
+			err := iss.fromToken(context.Background(), "not a token")
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Calling fromToken with a valid token should work", func() {
+			iss := newKubernetesIssuer(src)
+			err := iss.fromToken(context.Background(), makeToken(src.Issuer, "a3s", kubernetes))
+			So(err, ShouldBeNil)
+			So(iss.Issue().Identity, ShouldResemble, []string{
+				"namespace=default",
+				"serviceaccount=app",
+				"uid=sauid",
+				"pod=app-1234",
+				"poduid=poduid",
+			})
+		})
+
+		Convey("Calling fromToken with a token from another issuer should fail", func() {
+			iss := newKubernetesIssuer(src)
+			err := iss.fromToken(context.Background(), makeToken("https://other", "a3s", kubernetes))
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Calling fromToken with the wrong audience should fail", func() {
+			iss := newKubernetesIssuer(src)
+			err := iss.fromToken(context.Background(), makeToken(src.Issuer, "other", kubernetes))
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Calling fromToken with a valid ES256 token should work", func() {
+			iss := newKubernetesIssuer(src)
+			err := iss.fromToken(context.Background(), makeECToken(src.Issuer, "a3s", kubernetes))
+			So(err, ShouldBeNil)
+			So(iss.Issue().Identity, ShouldContain, "serviceaccount=app")
+		})
+
+		Convey("Calling fromToken on a source without audience should fail", func() {
+			src.Audience = ""
+			iss := newKubernetesIssuer(src)
+			err := iss.fromToken(context.Background(), makeToken(src.Issuer, "other", kubernetes))
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "kubernetes error: source has no audience")
+		})
+
+		Convey("Calling fromToken with a token that is not a service account token should fail", func() {
+			iss := newKubernetesIssuer(src)
+			err := iss.fromToken(context.Background(), makeToken(src.Issuer, "a3s", map[string]any{}))
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "kubernetes error: token is not a projected service account token")
+		})
+	})
+}
+
+func TestKubernetesFromTokenTokenReview(t *testing.T) {
+
+	var review tokenReview
+	var authorization string
+	var status tokenReviewStatus
+	var code int
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/apis/authentication.k8s.io/v1/tokenreviews" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		authorization = r.Header.Get("Authorization")
+		_ = json.NewDecoder(r.Body).Decode(&review)
+		review.Status = status
+		w.WriteHeader(code)
+		_ = json.NewEncoder(w).Encode(review)
+	}))
+	defer ts.Close()
+
+	Convey("Given a Kubernetes source using TokenReview", t, func() {
+
+		src := api.NewKubernetesSource()
+		src.Namespace = "/ns"
+		src.Name = "kube"
+		src.Mode = api.KubernetesSourceModeTokenReview
+		src.Endpoint = ts.URL
+		src.ReviewerToken = "reviewer"
+		src.Audience = "a3s"
+
+		code = http.StatusCreated
+		status = tokenReviewStatus{
+			Authenticated: true,
+			Audiences:     []string{"a3s"},
+			User: tokenReviewUser{
+				Username: "system:serviceaccount:default:app",
+				UID:      "sauid",
+				Extra: map[string][]string{
+					podNameExtra: {"app-1234"},
+					podUIDExtra:  {"poduid"},
+				},
+			},
+		}
+
+		Convey("Calling fromToken with an authenticated token should work", func() {
+			iss := newKubernetesIssuer(src)
+			err := iss.fromToken(context.Background(), "token")
+			So(err, ShouldBeNil)
+			So(authorization, ShouldEqual, "Bearer reviewer")
+			So(review.Spec.Token, ShouldEqual, "token")
+			So(review.Spec.Audiences, ShouldResemble, []string{"a3s"})
+			So(iss.Issue().Identity, ShouldResemble, []string{
+				"namespace=default",
+				"serviceaccount=app",
+				"uid=sauid",
+				"pod=app-1234",
+				"poduid=poduid",
+			})
+		})
+
+		Convey("Calling fromToken with a rejected token should fail", func() {
+			status = tokenReviewStatus{Error: "token expired"}
+			iss := newKubernetesIssuer(src)
+			err := iss.fromToken(context.Background(), "token")
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "kubernetes error: token rejected by token review: token expired")
+		})
+
+		Convey("Calling fromToken with the wrong audience should fail", func() {
+			status.Audiences = []string{"other"}
+			iss := newKubernetesIssuer(src)
+			err := iss.fromToken(context.Background(), "token")
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "kubernetes error: invalid audience '[other]' want 'a3s'")
+		})
+
+		Convey("Calling fromToken with a user that is not a service account should fail", func() {
+			status.User.Username = "admin"
+			iss := newKubernetesIssuer(src)
+			err := iss.fromToken(context.Background(), "token")
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "kubernetes error: 'admin' is not a service account")
+		})
+
+		Convey("Calling fromToken when the reviewer is not allowed should fail", func() {
+			code = http.StatusForbidden
+			iss := newKubernetesIssuer(src)
+			err := iss.fromToken(context.Background(), "token")
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "kubernetes error: invalid token review status code: 403")
+		})
+
+		Convey("Calling fromToken with an invalid CA should fail", func() {
+			src.CA = "not a certificate"
+			iss := newKubernetesIssuer(src)
+			err := iss.fromToken(context.Background(), "token")
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "kubernetes error: unable to create token review http client: unable to append given ca to ca pool")
+		})
+	})
+}
+
+func Test_computeKubernetesClaims(t *testing.T) {
+	type args struct {
+		info serviceAccountInfo
+	}
+	tests := []struct {
+		name string
+		args func(t *testing.T) args
+
+		want1 []string
+	}{
+		{
+			"standard",
+			func(*testing.T) args {
+				return args{
+					serviceAccountInfo{
+						namespace:      "default",
+						serviceAccount: "app",
+						uid:            "sauid",
+						pod:            "app-1234",
+						podUID:         "poduid",
+					},
+				}
+			},
+			[]string{
+				"namespace=default",
+				"serviceaccount=app",
+				"uid=sauid",
+				"pod=app-1234",
+				"poduid=poduid",
+			},
+		},
+		{
+			"no pod",
+			func(*testing.T) args {
+				return args{
+					serviceAccountInfo{
+						namespace:      "default",
+						serviceAccount: "app",
+					},
+				}
+			},
+			[]string{
+				"namespace=default",
+				"serviceaccount=app",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tArgs := tt.args(t)
+
+			got1 := computeKubernetesClaims(tArgs.info)
+
+			if !reflect.DeepEqual(got1, tt.want1) {
+				t.Errorf("computeKubernetesClaims got1 = %v, want1: %v", got1, tt.want1)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package kubernetesissuer
+
+import "fmt"
+
+// ErrKubernetes represents an error that happened
+// during operations related to Kubernetes.
+type ErrKubernetes struct {
+	Err error
+}
+
+func (e ErrKubernetes) Error() string {
+	return fmt.Sprintf("kubernetes error: %s", e.Err)
+}
+
+// Unwrap returns the warped error.
+func (e ErrKubernetes) Unwrap() error {
+	return e.Err
+}
+
+// serviceAccountInfo contains the information
+// about the service account of a token.
+type serviceAccountInfo struct {
+	namespace      string
+	serviceAccount string
+	uid            string
+	pod            string
+	podUID         string
+}
+
+type kubernetesJWT struct {
+	Kubernetes struct {
+		Namespace      string `json:"namespace"`
+		ServiceAccount struct {
+			Name string `json:"name"`
+			UID  string `json:"uid"`
+		} `json:"serviceaccount"`
+		Pod struct {
+			Name string `json:"name"`
+			UID  string `json:"uid"`
+		} `json:"pod"`
+	} `json:"kubernetes.io"`
+}
+
+type tokenReviewSpec struct {
+	Token     string   `json:"token"`
+	Audiences []string `json:"audiences,omitempty"`
+}
+
+type tokenReviewUser struct {
+	Username string              `json:"username"`
+	UID      string              `json:"uid"`
+	Extra    map[string][]string `json:"extra"`
+}
+
+type tokenReviewStatus struct {
+	Authenticated bool            `json:"authenticated"`
+	User          tokenReviewUser `json:"user"`
+	Audiences     []string        `json:"audiences"`
+	Error         string          `json:"error"`
+}
+
+type tokenReview struct {
+	APIVersion string            `json:"apiVersion"`
+	Kind       string            `json:"kind"`
+	Spec       tokenReviewSpec   `json:"spec"`
+	Status     tokenReviewStatus `json:"status,omitempty"`
+}
@@ -2,13 +2,12 @@ package ldapissuer
 
 import (
 	"context"
-	"crypto/tls"
-	"crypto/x509"
 	"fmt"
 	"strings"
 
 	"github.com/go-ldap/ldap/v3"
 	"go.aporeto.io/a3s/internal/identitymodifier"
+	"go.aporeto.io/a3s/internal/issuer/oidcissuer"
 	"go.aporeto.io/a3s/pkgs/api"
 	"go.aporeto.io/a3s/pkgs/token"
 )
@@ -48,15 +47,19 @@ func (c *ldapIssuer) Issue() *token.IdentityToken {
 
 func (c *ldapIssuer) fromCredentials(ctx context.Context, username string, password string) (err error) {
 
-	entry, dn, err := c.retrieveEntry(username, password)
+	entry, dn, groups, err := c.retrieveEntry(username, password)
 	if err != nil {
 		return err
 	}
 
-	inc, exc := computeLDPInclusion(c.source)
+	inc, exc := oidcissuer.ComputeInclusion(c.source.IncludedKeys, c.source.IgnoredKeys)
 
 	c.token.Identity = computeLDAPClaims(entry, dn, inc, exc)
 
+	for _, group := range groups {
+		c.token.Identity = append(c.token.Identity, fmt.Sprintf("group=%s", group))
+	}
+
 	if srcmod := c.source.Modifier; srcmod != nil {
 
 		m, err := identitymodifier.NewRemote(srcmod, c.token.Source)
@@ -72,50 +75,19 @@ func (c *ldapIssuer) fromCredentials(ctx context.Context, username string, passw
 	return nil
 }
 
-func (c *ldapIssuer) retrieveEntry(username string, password string) (*ldap.Entry, *ldap.DN, error) {
-
-	var err error
-
-	var caPool *x509.CertPool
-	if ca := c.source.CA; ca != "" {
-		caPool = x509.NewCertPool()
-		caPool.AppendCertsFromPEM([]byte(ca))
-	} else {
-		caPool, err = x509.SystemCertPool()
-		if err != nil {
-			return nil, nil, err
-		}
-	}
-
-	tlsConfig := &tls.Config{
-		ServerName: strings.Split(c.source.Address, ":")[0],
-		RootCAs:    caPool,
-	}
+func (c *ldapIssuer) retrieveEntry(username string, password string) (entry *ldap.Entry, dn *ldap.DN, groups []string, err error) {
 
-	var conn *ldap.Conn
-	if c.source.SecurityProtocol == api.LDAPSourceSecurityProtocolTLS {
-		conn, err = ldap.DialTLS("tcp", c.source.Address, tlsConfig)
-		if err != nil {
-			return nil, nil, ErrLDAP{Err: fmt.Errorf("cannot dial tls: %w", err)}
-		}
-	} else {
-		conn, err = ldap.Dial("tcp", c.source.Address)
-		if err != nil {
-			return nil, nil, ErrLDAP{Err: fmt.Errorf("cannot dial: %w", err)}
-		}
-	}
+	pool := poolFor(c.source)
 
-	if c.source.SecurityProtocol == api.LDAPSourceSecurityProtocolInbandTLS {
-		if err = conn.StartTLS(tlsConfig); err != nil {
-			return nil, nil, ErrLDAP{Err: fmt.Errorf("cannot start tls: %w", err)}
-		}
+	conn, err := pool.get()
+	if err != nil {
+		return nil, nil, nil, err
 	}
 
-	defer conn.Close()
-
-	if err = conn.Bind(c.source.BindDN, c.source.BindPassword); err != nil {
-		return nil, nil, ErrLDAP{Err: fmt.Errorf("unable to bind: %w", err)}
-	}
+	// The connection is given back to the pool
+	// only if it is still bound with the bind DN.
+	reusable := false
+	defer func() { pool.put(conn, reusable) }()
 
 	req := ldap.NewSearchRequest(
 		c.source.BaseDN,
@@ -124,32 +96,119 @@ func (c *ldapIssuer) retrieveEntry(username string, password string) (*ldap.Entr
 		0,
 		0,
 		false,
-		fmt.Sprintf("(&(%s))", strings.Replace(c.source.BindSearchFilter, "{USERNAME}", username, -1)),
+		makeBindSearchFilter(c.source.BindSearchFilter, username),
 		nil,
 		nil,
 	)
 
 	sr, err := conn.Search(req)
 	if err != nil {
-		return nil, nil, ErrLDAP{Err: fmt.Errorf("unable to search: %w", err)}
+		return nil, nil, nil, ErrLDAP{Err: fmt.Errorf("unable to search: %w", err)}
 	}
 
-	if len(sr.Entries) != 1 {
-		return nil, nil, ErrLDAP{Err: fmt.Errorf("invalid credentials")}
+	// An empty password would be accepted
+	// by the server as an unauthenticated bind.
+	if len(sr.Entries) != 1 || password == "" {
+		reusable = true
+		return nil, nil, nil, ErrLDAP{Err: fmt.Errorf("invalid credentials")}
 	}
 
-	entry := sr.Entries[0]
+	entry = sr.Entries[0]
+
+	bindErr := conn.Bind(entry.DN, password)
 
-	if err = conn.Bind(entry.DN, password); err != nil {
-		return nil, nil, ErrLDAP{Err: fmt.Errorf("invalid credentials")}
+	if err = conn.Bind(c.source.BindDN, c.source.BindPassword); err != nil {
+		return nil, nil, nil, ErrLDAP{Err: fmt.Errorf("unable to bind: %w", err)}
+	}
+	reusable = true
+
+	if bindErr != nil {
+		return nil, nil, nil, ErrLDAP{Err: fmt.Errorf("invalid credentials")}
 	}
 
-	dn, err := ldap.ParseDN(entry.DN)
+	dn, err = ldap.ParseDN(entry.DN)
 	if err != nil {
-		return nil, nil, ErrLDAP{Err: fmt.Errorf("unable to parse entry DN: %w", err)}
+		return nil, nil, nil, ErrLDAP{Err: fmt.Errorf("unable to parse entry DN: %w", err)}
 	}
 
-	return entry, dn, nil
+	if c.source.GroupBaseDN == "" {
+		return entry, dn, nil, nil
+	}
+
+	groups, err = retrieveGroups(conn, c.source, entry.DN)
+	if err != nil {
+		reusable = false
+		return nil, nil, nil, err
+	}
+
+	return entry, dn, groups, nil
+}
+
+// makeBindSearchFilter returns the filter to use to locate the entry of
+// the given username. The username is escaped, so it cannot alter the filter.
+func makeBindSearchFilter(template string, username string) string {
+
+	return fmt.Sprintf("(&(%s))", strings.ReplaceAll(template, "{USERNAME}", ldap.EscapeFilter(username)))
+}
+
+// A searcher can search an LDAP directory.
+type searcher interface {
+	Search(*ldap.SearchRequest) (*ldap.SearchResult, error)
+}
+
+// retrieveGroups returns the common names of the groups the entry
+// with the given DN is a member of. If the source allows it, the groups
+// of the groups are resolved too, up to its nesting depth.
+func retrieveGroups(conn searcher, src *api.LDAPSource, dn string) ([]string, error) {
+
+	var groups []string
+
+	seen := map[string]struct{}{}
+	members := []string{dn}
+
+	for depth := 0; depth <= src.GroupNestingDepth && len(members) > 0; depth++ {
+
+		memberFilters := make([]string, len(members))
+		for i, member := range members {
+			memberFilters[i] = fmt.Sprintf("(%s=%s)", src.GroupMemberAttribute, ldap.EscapeFilter(member))
+		}
+
+		req := ldap.NewSearchRequest(
+			src.GroupBaseDN,
+			ldap.ScopeWholeSubtree,
+			ldap.NeverDerefAliases,
+			0,
+			0,
+			false,
+			fmt.Sprintf("(&(%s)(|%s))", src.GroupSearchFilter, strings.Join(memberFilters, "")),
+			[]string{"cn"},
+			nil,
+		)
+
+		sr, err := conn.Search(req)
+		if err != nil {
+			return nil, ErrLDAP{Err: fmt.Errorf("unable to search groups: %w", err)}
+		}
+
+		members = members[:0]
+
+		for _, entry := range sr.Entries {
+
+			key := strings.ToLower(entry.DN)
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+
+			if cn := entry.GetAttributeValue("cn"); cn != "" {
+				groups = append(groups, cn)
+			}
+
+			members = append(members, entry.DN)
+		}
+	}
+
+	return groups, nil
 }
 
 func computeLDAPClaims(entry *ldap.Entry, dn *ldap.DN, inc map[string]struct{}, exc map[string]struct{}) (claims []string) {
@@ -198,18 +257,3 @@ func computeLDAPClaims(entry *ldap.Entry, dn *ldap.DN, inc map[string]struct{},
 
 	return claims
 }
-
-func computeLDPInclusion(src *api.LDAPSource) (inc map[string]struct{}, exc map[string]struct{}) {
-
-	inc = make(map[string]struct{}, len(src.IncludedKeys))
-	for _, key := range src.IncludedKeys {
-		inc[strings.ToLower(key)] = struct{}{}
-	}
-
-	exc = make(map[string]struct{}, len(src.IgnoredKeys))
-	for _, key := range src.IgnoredKeys {
-		exc[strings.ToLower(key)] = struct{}{}
-	}
-
-	return inc, exc
-}
@@ -231,55 +231,114 @@ func Test_computeLDAPClaims(t *testing.T) {
 	}
 }
 
-func Test_computeLDAPInclusion(t *testing.T) {
-	type args struct {
-		src *api.LDAPSource
+type fakeSearcher struct {
+	results  map[string][]*ldap.Entry
+	err      error
+	requests []*ldap.SearchRequest
+}
+
+func (s *fakeSearcher) Search(req *ldap.SearchRequest) (*ldap.SearchResult, error) {
+
+	s.requests = append(s.requests, req)
+
+	if s.err != nil {
+		return nil, s.err
 	}
-	tests := []struct {
-		name string
-		args func(t *testing.T) args
 
-		want1 map[string]struct{}
-		want2 map[string]struct{}
-	}{
-		{
-			"simple test",
-			func(*testing.T) args {
-				return args{
-					&api.LDAPSource{
-						IncludedKeys: []string{"a", "b"},
-						IgnoredKeys:  []string{"b", "c"},
-					},
-				}
-			},
-			map[string]struct{}{"a": {}, "b": {}},
-			map[string]struct{}{"b": {}, "c": {}},
-		},
-		{
-			"nil keys",
-			func(*testing.T) args {
-				return args{
-					&api.LDAPSource{},
-				}
-			},
-			map[string]struct{}{},
-			map[string]struct{}{},
-		},
+	return &ldap.SearchResult{Entries: s.results[req.Filter]}, nil
+}
+
+func TestRetrieveGroups(t *testing.T) {
+
+	const (
+		userDN    = "uid=okenobi,ou=users,dc=universe,dc=io"
+		jediDN    = "cn=jedi,ou=groups,dc=universe,dc=io"
+		readersDN = "cn=readers,ou=groups,dc=universe,dc=io"
+		forceDN   = "cn=force,ou=groups,dc=universe,dc=io"
+	)
+
+	makeGroup := func(dn string, cn string) *ldap.Entry {
+		return ldap.NewEntry(dn, map[string][]string{"cn": {cn}})
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			tArgs := tt.args(t)
+	Convey("Given a source and a directory with nested groups", t, func() {
 
-			got1, got2 := computeLDPInclusion(tArgs.src)
+		src := api.NewLDAPSource()
+		src.GroupBaseDN = "ou=groups,dc=universe,dc=io"
 
-			if !reflect.DeepEqual(got1, tt.want1) {
-				t.Errorf("computeInclusion got1 = %v, want1: %v", got1, tt.want1)
-			}
+		s := &fakeSearcher{
+			results: map[string][]*ldap.Entry{
+				"(&(objectClass=groupOfNames)(|(member=" + userDN + ")))": {
+					makeGroup(jediDN, "jedi"),
+					makeGroup(readersDN, "readers"),
+				},
+				"(&(objectClass=groupOfNames)(|(member=" + jediDN + ")(member=" + readersDN + ")))": {
+					makeGroup(forceDN, "force"),
+				},
+				"(&(objectClass=groupOfNames)(|(member=" + forceDN + ")))": {
+					makeGroup(jediDN, "jedi"),
+				},
+			},
+		}
 
-			if !reflect.DeepEqual(got2, tt.want2) {
-				t.Errorf("computeInclusion got2 = %v, want2: %v", got2, tt.want2)
-			}
+		Convey("When I retrieve the groups with no nesting", func() {
+
+			groups, err := retrieveGroups(s, src, userDN)
+
+			So(err, ShouldBeNil)
+			So(groups, ShouldResemble, []string{"jedi", "readers"})
+			So(len(s.requests), ShouldEqual, 1)
+			So(s.requests[0].BaseDN, ShouldEqual, "ou=groups,dc=universe,dc=io")
+			So(s.requests[0].Attributes, ShouldResemble, []string{"cn"})
 		})
-	}
+
+		Convey("When I retrieve the groups with nesting", func() {
+
+			src.GroupNestingDepth = 1
+			groups, err := retrieveGroups(s, src, userDN)
+
+			So(err, ShouldBeNil)
+			So(groups, ShouldResemble, []string{"jedi", "readers", "force"})
+			So(len(s.requests), ShouldEqual, 2)
+		})
+
+		Convey("When I retrieve the groups with cyclic nesting", func() {
+
+			src.GroupNestingDepth = 10
+			groups, err := retrieveGroups(s, src, userDN)
+
+			So(err, ShouldBeNil)
+			So(groups, ShouldResemble, []string{"jedi", "readers", "force"})
+			So(len(s.requests), ShouldEqual, 3)
+		})
+
+		Convey("When I retrieve the groups with another member attribute", func() {
+
+			src.GroupMemberAttribute = "uniqueMember"
+			src.GroupSearchFilter = "objectClass=groupOfUniqueNames"
+			groups, err := retrieveGroups(s, src, userDN)
+
+			So(err, ShouldBeNil)
+			So(groups, ShouldBeNil)
+			So(s.requests[0].Filter, ShouldEqual, "(&(objectClass=groupOfUniqueNames)(|(uniqueMember="+userDN+")))")
+		})
+
+		Convey("When I retrieve the groups of a DN with special characters", func() {
+
+			_, err := retrieveGroups(s, src, "uid=a(*),dc=io")
+
+			So(err, ShouldBeNil)
+			So(s.requests[0].Filter, ShouldEqual, `(&(objectClass=groupOfNames)(|(member=uid=a\28\2a\29,dc=io)))`)
+		})
+
+		Convey("When the search fails", func() {
+
+			s.err = fmt.Errorf("boom")
+			groups, err := retrieveGroups(s, src, userDN)
+
+			So(groups, ShouldBeNil)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "ldap error: unable to search groups: boom")
+		})
+	})
 }
@@ -0,0 +1,271 @@
+package ldapissuer
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+	"go.aporeto.io/a3s/pkgs/api"
+)
+
+const (
+	dialTimeout         = 5 * time.Second
+	requestTimeout      = 10 * time.Second
+	maxIdleConns        = 8
+	maxIdleTime         = 5 * time.Minute
+	healthCheckInterval = 30 * time.Second
+)
+
+// pools holds the connection pool of each source.
+var pools sync.Map
+
+// An ldapClient is a connection to an LDAP server.
+type ldapClient interface {
+	searcher
+	Bind(username string, password string) error
+	IsClosing() bool
+	Close()
+}
+
+type idleClient struct {
+	client ldapClient
+	since  time.Time
+}
+
+// A connPool keeps connections bound with the bind DN of
+// a source, so they can be reused by subsequent logins.
+type connPool struct {
+	config       string
+	addresses    []string
+	bindDN       string
+	bindPassword string
+	dial         func(address string) (ldapClient, error)
+
+	idle   []idleClient
+	closed bool
+
+	sync.Mutex
+}
+
+func newConnPool(config string, addresses []string, bindDN string, bindPassword string, dial func(string) (ldapClient, error)) *connPool {
+	return &connPool{
+		config:       config,
+		addresses:    addresses,
+		bindDN:       bindDN,
+		bindPassword: bindPassword,
+		dial:         dial,
+	}
+}
+
+// poolFor returns the connection pool of the given source.
+// If the source has been modified since the pool has been
+// created, the old pool is closed and replaced.
+func poolFor(src *api.LDAPSource) *connPool {
+
+	key := src.Namespace + "/" + src.Name
+	config := strings.Join(
+		append(
+			[]string{
+				src.Address,
+				src.BindDN,
+				src.BindPassword,
+				src.CA,
+				string(src.SecurityProtocol),
+			},
+			src.FailoverAddresses...,
+		),
+		"\x00",
+	)
+
+	if v, ok := pools.Load(key); ok && v.(*connPool).config == config {
+		return v.(*connPool)
+	}
+
+	p := newConnPool(
+		config,
+		append([]string{src.Address}, src.FailoverAddresses...),
+		src.BindDN,
+		src.BindPassword,
+		func(address string) (ldapClient, error) { return dial(src, address) },
+	)
+
+	if old, loaded := pools.Swap(key, p); loaded {
+		old.(*connPool).close()
+	}
+
+	return p
+}
+
+// get returns a healthy connection bound with the bind DN. Idle connections
+// are reused first. Otherwise, the addresses are tried in order until one
+// of them accepts a new connection.
+func (p *connPool) get() (ldapClient, error) {
+
+	for {
+		ic, ok := p.pop()
+		if !ok {
+			break
+		}
+
+		if isHealthy(ic) {
+			return ic.client, nil
+		}
+
+		ic.client.Close()
+	}
+
+	var err error
+	for _, address := range p.addresses {
+
+		var client ldapClient
+		if client, err = p.dial(address); err != nil {
+			continue
+		}
+
+		if err = client.Bind(p.bindDN, p.bindPassword); err != nil {
+			client.Close()
+			err = ErrLDAP{Err: fmt.Errorf("unable to bind: %w", err)}
+			continue
+		}
+
+		return client, nil
+	}
+
+	return nil, err
+}
+
+// put gives back the given connection to the pool. If the connection
+// cannot be reused, or if the pool is full, it is closed.
+func (p *connPool) put(client ldapClient, reusable bool) {
+
+	p.Lock()
+	defer p.Unlock()
+
+	if !reusable || p.closed || len(p.idle) >= maxIdleConns || client.IsClosing() {
+		client.Close()
+		return
+	}
+
+	p.idle = append(p.idle, idleClient{client: client, since: time.Now()})
+}
+
+func (p *connPool) pop() (idleClient, bool) {
+
+	p.Lock()
+	defer p.Unlock()
+
+	if len(p.idle) == 0 {
+		return idleClient{}, false
+	}
+
+	// We use the most recently used connection,
+	// so the others can expire when the load drops.
+	ic := p.idle[len(p.idle)-1]
+	p.idle = p.idle[:len(p.idle)-1]
+
+	return ic, true
+}
+
+func (p *connPool) close() {
+
+	p.Lock()
+	defer p.Unlock()
+
+	for _, ic := range p.idle {
+		ic.client.Close()
+	}
+
+	p.idle = nil
+	p.closed = true
+}
+
+// isHealthy checks if the given idle connection can be reused.
+// Connections that have been idle for some time are checked
+// by reading the root DSE of the server.
+func isHealthy(ic idleClient) bool {
+
+	if ic.client.IsClosing() {
+		return false
+	}
+
+	idle := time.Since(ic.since)
+
+	if idle > maxIdleTime {
+		return false
+	}
+
+	if idle < healthCheckInterval {
+		return true
+	}
+
+	_, err := ic.client.Search(ldap.NewSearchRequest(
+		"",
+		ldap.ScopeBaseObject,
+		ldap.NeverDerefAliases,
+		0,
+		0,
+		false,
+		"(objectClass=*)",
+		[]string{"supportedLDAPVersion"},
+		nil,
+	))
+
+	return err == nil
+}
+
+// dial connects to the LDAP server at the given address
+// using the security protocol of the given source.
+func dial(src *api.LDAPSource, address string) (ldapClient, error) {
+
+	var err error
+
+	var caPool *x509.CertPool
+	if ca := src.CA; ca != "" {
+		caPool = x509.NewCertPool()
+		caPool.AppendCertsFromPEM([]byte(ca))
+	} else {
+		caPool, err = x509.SystemCertPool()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tlsConfig := &tls.Config{
+		ServerName: strings.Split(address, ":")[0],
+		RootCAs:    caPool,
+	}
+
+	dialer := &net.Dialer{Timeout: dialTimeout}
+
+	var conn *ldap.Conn
+	if src.SecurityProtocol == api.LDAPSourceSecurityProtocolTLS {
+		c, err := tls.DialWithDialer(dialer, "tcp", address, tlsConfig)
+		if err != nil {
+			return nil, ErrLDAP{Err: fmt.Errorf("cannot dial tls: %w", ldap.NewError(ldap.ErrorNetwork, err))}
+		}
+		conn = ldap.NewConn(c, true)
+	} else {
+		c, err := dialer.Dial("tcp", address)
+		if err != nil {
+			return nil, ErrLDAP{Err: fmt.Errorf("cannot dial: %w", ldap.NewError(ldap.ErrorNetwork, err))}
+		}
+		conn = ldap.NewConn(c, false)
+	}
+
+	conn.Start()
+	conn.SetTimeout(requestTimeout)
+
+	if src.SecurityProtocol == api.LDAPSourceSecurityProtocolInbandTLS {
+		if err = conn.StartTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, ErrLDAP{Err: fmt.Errorf("cannot start tls: %w", err)}
+		}
+	}
+
+	return conn, nil
+}
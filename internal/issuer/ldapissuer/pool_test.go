@@ -0,0 +1,236 @@
+package ldapissuer
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.aporeto.io/a3s/pkgs/api"
+)
+
+type fakeClient struct {
+	address   string
+	bindErr   error
+	searchErr error
+	closing   bool
+	closed    bool
+	binds     []string
+	searches  int
+}
+
+func (c *fakeClient) Bind(username string, password string) error {
+	c.binds = append(c.binds, username)
+	return c.bindErr
+}
+
+func (c *fakeClient) Search(*ldap.SearchRequest) (*ldap.SearchResult, error) {
+	c.searches++
+	return &ldap.SearchResult{}, c.searchErr
+}
+
+func (c *fakeClient) IsClosing() bool { return c.closing || c.closed }
+func (c *fakeClient) Close()          { c.closed = true }
+
+func TestConnPool(t *testing.T) {
+
+	Convey("Given a connection pool with several addresses", t, func() {
+
+		var dialed []string
+		clients := map[string]*fakeClient{}
+		dialErrs := map[string]error{}
+
+		p := newConnPool("config", []string{"a", "b", "c"}, "cn=admin", "pass", func(address string) (ldapClient, error) {
+			dialed = append(dialed, address)
+			if err := dialErrs[address]; err != nil {
+				return nil, err
+			}
+			c := clients[address]
+			if c == nil {
+				c = &fakeClient{address: address}
+				clients[address] = c
+			}
+			return c, nil
+		})
+
+		Convey("When I get a connection", func() {
+
+			c, err := p.get()
+
+			So(err, ShouldBeNil)
+			So(c.(*fakeClient).address, ShouldEqual, "a")
+			So(c.(*fakeClient).binds, ShouldResemble, []string{"cn=admin"})
+			So(dialed, ShouldResemble, []string{"a"})
+		})
+
+		Convey("When the first servers cannot be reached", func() {
+
+			dialErrs["a"] = fmt.Errorf("boom a")
+			clients["b"] = &fakeClient{address: "b", bindErr: fmt.Errorf("boom b")}
+
+			c, err := p.get()
+
+			So(err, ShouldBeNil)
+			So(c.(*fakeClient).address, ShouldEqual, "c")
+			So(dialed, ShouldResemble, []string{"a", "b", "c"})
+			So(clients["b"].closed, ShouldBeTrue)
+		})
+
+		Convey("When no server can be reached", func() {
+
+			dialErrs["a"] = fmt.Errorf("boom a")
+			dialErrs["b"] = fmt.Errorf("boom b")
+			clients["c"] = &fakeClient{address: "c", bindErr: fmt.Errorf("boom c")}
+
+			c, err := p.get()
+
+			So(c, ShouldBeNil)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "ldap error: unable to bind: boom c")
+		})
+
+		Convey("When I put back a reusable connection", func() {
+
+			c, _ := p.get()
+			p.put(c, true)
+
+			Convey("Then it should be reused", func() {
+
+				c2, err := p.get()
+
+				So(err, ShouldBeNil)
+				So(c2, ShouldEqual, c)
+				So(len(dialed), ShouldEqual, 1)
+				So(c.(*fakeClient).searches, ShouldEqual, 0)
+			})
+
+			Convey("Then it should be checked if it has been idle for a while", func() {
+
+				p.idle[0].since = time.Now().Add(-2 * healthCheckInterval)
+
+				c2, err := p.get()
+
+				So(err, ShouldBeNil)
+				So(c2, ShouldEqual, c)
+				So(c.(*fakeClient).searches, ShouldEqual, 1)
+			})
+
+			Convey("Then it should be replaced if it fails the health check", func() {
+
+				p.idle[0].since = time.Now().Add(-2 * healthCheckInterval)
+				c.(*fakeClient).searchErr = fmt.Errorf("boom")
+				clients["a"] = nil
+
+				c2, err := p.get()
+
+				So(err, ShouldBeNil)
+				So(c2, ShouldNotEqual, c)
+				So(c.(*fakeClient).closed, ShouldBeTrue)
+				So(len(dialed), ShouldEqual, 2)
+			})
+
+			Convey("Then it should be replaced if it has been idle for too long", func() {
+
+				p.idle[0].since = time.Now().Add(-2 * maxIdleTime)
+				clients["a"] = nil
+
+				c2, err := p.get()
+
+				So(err, ShouldBeNil)
+				So(c2, ShouldNotEqual, c)
+				So(c.(*fakeClient).closed, ShouldBeTrue)
+			})
+
+			Convey("Then it should be replaced if it is closing", func() {
+
+				c.(*fakeClient).closing = true
+				clients["a"] = nil
+
+				c2, err := p.get()
+
+				So(err, ShouldBeNil)
+				So(c2, ShouldNotEqual, c)
+			})
+		})
+
+		Convey("When I put back a connection that is not reusable", func() {
+
+			c, _ := p.get()
+			p.put(c, false)
+
+			So(c.(*fakeClient).closed, ShouldBeTrue)
+			So(len(p.idle), ShouldEqual, 0)
+		})
+
+		Convey("When I put back more connections than allowed", func() {
+
+			var cs []*fakeClient
+			for i := 0; i <= maxIdleConns; i++ {
+				c := &fakeClient{}
+				cs = append(cs, c)
+				p.put(c, true)
+			}
+
+			So(len(p.idle), ShouldEqual, maxIdleConns)
+			So(cs[maxIdleConns].closed, ShouldBeTrue)
+		})
+
+		Convey("When I close the pool", func() {
+
+			c1, _ := p.get()
+			c2 := &fakeClient{}
+			p.put(c2, true)
+			p.close()
+
+			So(c2.closed, ShouldBeTrue)
+
+			p.put(c1, true)
+			So(c1.(*fakeClient).closed, ShouldBeTrue)
+			So(len(p.idle), ShouldEqual, 0)
+		})
+	})
+}
+
+func TestPoolFor(t *testing.T) {
+
+	Convey("Given a source", t, func() {
+
+		src := api.NewLDAPSource()
+		src.Namespace = "/pool"
+		src.Name = "src"
+		src.Address = "a:389"
+		src.FailoverAddresses = []string{"b:389"}
+
+		p := poolFor(src)
+
+		So(p.addresses, ShouldResemble, []string{"a:389", "b:389"})
+
+		Convey("Then the same pool should be returned for the same source", func() {
+			So(poolFor(src), ShouldEqual, p)
+		})
+
+		Convey("Then a new pool should be returned when the source is modified", func() {
+
+			idle := &fakeClient{}
+			p.put(idle, true)
+
+			src.BindPassword = "new"
+			p2 := poolFor(src)
+
+			So(p2, ShouldNotEqual, p)
+			So(p.closed, ShouldBeTrue)
+			So(idle.closed, ShouldBeTrue)
+		})
+	})
+}
+
+func TestMakeBindSearchFilter(t *testing.T) {
+
+	Convey("Calling makeBindSearchFilter should escape the username", t, func() {
+		So(makeBindSearchFilter("uid={USERNAME}", "bob"), ShouldEqual, "(&(uid=bob))")
+		So(makeBindSearchFilter("uid={USERNAME}", "*"), ShouldEqual, `(&(uid=\2a))`)
+		So(makeBindSearchFilter("uid={USERNAME}", "bob)(uid=*"), ShouldEqual, `(&(uid=bob\29\28uid=\2a))`)
+		So(makeBindSearchFilter("|(uid={USERNAME})(mail={USERNAME})", "a\\b"), ShouldEqual, `(&(|(uid=a\5cb)(mail=a\5cb)))`)
+	})
+}
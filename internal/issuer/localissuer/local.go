@@ -0,0 +1,84 @@
+package localissuer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.aporeto.io/a3s/internal/identitymodifier"
+	"go.aporeto.io/a3s/internal/localpassword"
+	"go.aporeto.io/a3s/pkgs/api"
+	"go.aporeto.io/a3s/pkgs/token"
+)
+
+// ErrInvalidCredentials is returned when the username
+// or the password of the user is not correct.
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
+// New returns a new local issuer. The user can be nil if it
+// does not exist, in which case the password is verified against a
+// dummy hash, so the failure takes as long as for an existing user.
+func New(ctx context.Context, source *api.LocalSource, user *api.LocalUser, password string) (token.Issuer, error) {
+
+	c := newLocalIssuer(source)
+	if err := c.fromCredentials(ctx, user, password); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+type localIssuer struct {
+	token  *token.IdentityToken
+	source *api.LocalSource
+}
+
+func newLocalIssuer(source *api.LocalSource) *localIssuer {
+	return &localIssuer{
+		source: source,
+		token: token.NewIdentityToken(token.Source{
+			Type:      "local",
+			Namespace: source.Namespace,
+			Name:      source.Name,
+		}),
+	}
+}
+
+// Issue returns the IdentityToken.
+func (c *localIssuer) Issue() *token.IdentityToken {
+
+	return c.token
+}
+
+func (c *localIssuer) fromCredentials(ctx context.Context, user *api.LocalUser, password string) error {
+
+	var hash string
+	if user != nil {
+		hash = user.PasswordHash
+	}
+
+	ok, err := localpassword.Verify(hash, password)
+	if err != nil {
+		return ErrLocal{Err: err}
+	}
+
+	if !ok {
+		return ErrLocal{Err: ErrInvalidCredentials}
+	}
+
+	c.token.Identity = append([]string{fmt.Sprintf("username=%s", user.Username)}, user.Claims...)
+
+	if srcmod := c.source.Modifier; srcmod != nil {
+
+		m, err := identitymodifier.NewRemote(srcmod, c.token.Source)
+		if err != nil {
+			return fmt.Errorf("unable to prepare source modifier: %w", err)
+		}
+
+		if c.token.Identity, err = m.Modify(ctx, c.token.Identity); err != nil {
+			return fmt.Errorf("unable to call modifier: %w", err)
+		}
+	}
+
+	return nil
+}
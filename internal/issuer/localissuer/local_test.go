@@ -0,0 +1,86 @@
+package localissuer
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.aporeto.io/a3s/internal/localpassword"
+	"go.aporeto.io/a3s/pkgs/api"
+)
+
+func TestErrLocal(t *testing.T) {
+	Convey("ErrLocal should work", t, func() {
+		e := fmt.Errorf("boom")
+		err := ErrLocal{Err: e}
+		So(err.Error(), ShouldEqual, "local error: boom")
+		So(err.Unwrap(), ShouldEqual, e)
+	})
+}
+
+func TestNewLocalIssuer(t *testing.T) {
+	Convey("Calling newLocalIssuer should work", t, func() {
+		src := api.NewLocalSource()
+		src.Namespace = "/my/ns"
+		src.Name = "my-src"
+		iss := newLocalIssuer(src)
+		So(iss.source, ShouldEqual, src)
+		So(iss.token.Source.Type, ShouldEqual, "local")
+		So(iss.token.Source.Namespace, ShouldEqual, "/my/ns")
+		So(iss.token.Source.Name, ShouldEqual, "my-src")
+		So(iss.Issue(), ShouldEqual, iss.token)
+	})
+}
+
+func TestNew(t *testing.T) {
+
+	Convey("Given a local source and a user", t, func() {
+
+		src := api.NewLocalSource()
+		src.Namespace = "/my/ns"
+		src.Name = "my-src"
+
+		hash, err := localpassword.Hash("s3cr3t", api.LocalSourceHashAlgorithmArgon2id)
+		So(err, ShouldBeNil)
+
+		user := api.NewLocalUser()
+		user.Namespace = "/my/ns"
+		user.SourceName = "my-src"
+		user.Username = "joe"
+		user.PasswordHash = hash
+		user.Claims = []string{"team=blue", "email=joe@example.com"}
+
+		Convey("Calling New with the right password should work", func() {
+			iss, err := New(context.Background(), src, user, "s3cr3t")
+			So(err, ShouldBeNil)
+			So(iss.Issue().Identity, ShouldResemble, []string{
+				"username=joe",
+				"team=blue",
+				"email=joe@example.com",
+			})
+		})
+
+		Convey("Calling New with the wrong password should fail", func() {
+			iss, err := New(context.Background(), src, user, "not-s3cr3t")
+			So(iss, ShouldBeNil)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "local error: invalid credentials")
+		})
+
+		Convey("Calling New without user should fail", func() {
+			iss, err := New(context.Background(), src, nil, "s3cr3t")
+			So(iss, ShouldBeNil)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "local error: invalid credentials")
+		})
+
+		Convey("Calling New with a user with an invalid hash should fail", func() {
+			user.PasswordHash = "not-a-hash"
+			iss, err := New(context.Background(), src, user, "s3cr3t")
+			So(iss, ShouldBeNil)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "local error: unsupported password hash")
+		})
+	})
+}
@@ -0,0 +1,18 @@
+package localissuer
+
+import "fmt"
+
+// ErrLocal represents an error that happened
+// during operations related to local users.
+type ErrLocal struct {
+	Err error
+}
+
+func (e ErrLocal) Error() string {
+	return fmt.Sprintf("local error: %s", e.Err)
+}
+
+// Unwrap returns the warped error.
+func (e ErrLocal) Unwrap() error {
+	return e.Err
+}
@@ -4,13 +4,21 @@ import (
 	"context"
 	"crypto/x509"
 	"fmt"
+	"net/http"
+	"net/url"
 	"strings"
+	"time"
 
 	"go.aporeto.io/a3s/internal/identitymodifier"
 	"go.aporeto.io/a3s/pkgs/api"
+	"go.aporeto.io/a3s/pkgs/certrevocation"
 	"go.aporeto.io/a3s/pkgs/token"
 )
 
+// revocationChecker is shared by all the issuers
+// so the retrieved CRLs are cached between logins.
+var revocationChecker = certrevocation.NewChecker(&http.Client{Timeout: 10 * time.Second})
+
 // New returns a new MTLS issuer.
 func New(ctx context.Context, source *api.MTLSSource, cert *x509.Certificate) (token.Issuer, error) {
 
@@ -58,6 +66,31 @@ func (c *mtlsIssuer) fromCertificate(ctx context.Context, cert *x509.Certificate
 		return fmt.Errorf("unable to verify certificate: %w", err)
 	}
 
+	// The chain contains at least the certificate
+	// and the root, unless they are the same.
+	issuer := chains[0][0]
+	if len(chains[0]) > 1 {
+		issuer = chains[0][1]
+	}
+
+	if err := revocationChecker.Check(ctx, c.source, cert, issuer); err != nil {
+		return fmt.Errorf("unable to verify certificate revocation: %w", err)
+	}
+
+	spiffeID, err := spiffeIDFromCertificate(cert)
+	if err != nil {
+		return err
+	}
+
+	if td := c.source.TrustDomain; td != "" {
+		if spiffeID == nil {
+			return fmt.Errorf("certificate does not contain any spiffe id")
+		}
+		if !strings.EqualFold(spiffeID.Host, td) {
+			return fmt.Errorf("spiffe id '%s' is not part of the trust domain '%s'", spiffeID, td)
+		}
+	}
+
 	var fingerprints []string
 	for _, chain := range chains {
 		for _, cert := range chain {
@@ -130,6 +163,25 @@ func (c *mtlsIssuer) fromCertificate(ctx context.Context, cert *x509.Certificate
 		}
 	}
 
+	if vs := cert.IPAddresses; len(vs) != 0 {
+		for _, v := range vs {
+			c.token.Identity = append(c.token.Identity, fmt.Sprintf("ipaddress=%s", v))
+		}
+	}
+
+	if vs := cert.URIs; len(vs) != 0 {
+		for _, v := range vs {
+			c.token.Identity = append(c.token.Identity, fmt.Sprintf("uri=%s", v))
+		}
+	}
+
+	if spiffeID != nil {
+		c.token.Identity = append(c.token.Identity, fmt.Sprintf("spiffe:trustdomain=%s", spiffeID.Host))
+		if spiffeID.Path != "" {
+			c.token.Identity = append(c.token.Identity, fmt.Sprintf("spiffe:path=%s", spiffeID.Path))
+		}
+	}
+
 	if len(fingerprints) > 0 {
 		// if > 0 it is guaranteed to have at least 2 items.
 		c.token.Identity = append(c.token.Identity, fmt.Sprintf("fingerprint=%s", fingerprints[0]))
@@ -155,6 +207,33 @@ func (c *mtlsIssuer) fromCertificate(ctx context.Context, cert *x509.Certificate
 	return nil
 }
 
+// spiffeIDFromCertificate returns the SPIFFE ID contained in the URI SANs of the
+// given certificate, or nil if there is none. As an X.509-SVID can only hold
+// a single SPIFFE ID, an error is returned if there are several of them.
+func spiffeIDFromCertificate(cert *x509.Certificate) (*url.URL, error) {
+
+	var id *url.URL
+
+	for _, u := range cert.URIs {
+
+		if !strings.EqualFold(u.Scheme, "spiffe") {
+			continue
+		}
+
+		if id != nil {
+			return nil, fmt.Errorf("certificate contains more than one spiffe id")
+		}
+
+		if u.Host == "" || u.User != nil || u.RawQuery != "" || u.Fragment != "" {
+			return nil, fmt.Errorf("certificate contains an invalid spiffe id '%s'", u)
+		}
+
+		id = u
+	}
+
+	return id, nil
+}
+
 // Issue issues the token.IdentityToken derived from the the user certificate.
 func (c *mtlsIssuer) Issue() *token.IdentityToken {
 
@@ -3,15 +3,21 @@ package mtlsissuer
 import (
 	"context"
 	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/json"
 	"encoding/pem"
 	"fmt"
 	"math/big"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
+	"time"
 
 	. "github.com/smartystreets/goconvey/convey"
 	"go.aporeto.io/a3s/pkgs/api"
@@ -59,6 +65,68 @@ func getECCert(subject pkix.Name, opts ...tglib.IssueOption) (*x509.Certificate,
 	return cert, key
 }
 
+func getSANCert(ca *x509.Certificate, cakey crypto.PrivateKey, ips []net.IP, uris ...string) *x509.Certificate {
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(43),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		IPAddresses:  ips,
+	}
+
+	for _, u := range uris {
+		pu, err := url.Parse(u)
+		if err != nil {
+			panic(err)
+		}
+		template.URIs = append(template.URIs, pu)
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, key.Public(), cakey)
+	if err != nil {
+		panic(err)
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		panic(err)
+	}
+
+	return cert
+}
+
+func getCRL(ca *x509.Certificate, key crypto.PrivateKey, serials ...int64) string {
+
+	var entries []x509.RevocationListEntry
+	for _, s := range serials {
+		entries = append(entries, x509.RevocationListEntry{SerialNumber: big.NewInt(s), RevocationTime: time.Now()})
+	}
+
+	der, err := x509.CreateRevocationList(
+		rand.Reader,
+		&x509.RevocationList{
+			Number:                    big.NewInt(1),
+			ThisUpdate:                time.Now(),
+			NextUpdate:                time.Now().Add(time.Hour),
+			RevokedCertificateEntries: entries,
+		},
+		ca,
+		key.(crypto.Signer),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: der}))
+}
+
 func TestMTLSIssuer(t *testing.T) {
 
 	Convey("Given I have some certificates", t, func() {
@@ -131,6 +199,52 @@ func TestMTLSIssuer(t *testing.T) {
 				So(idt.Identity, ShouldContain, fmt.Sprintf("issuerchain=%s", token.Fingerprint(cacert1)))
 			})
 
+			Convey("Calling FromCertificate with a user cert containing IP and URI SANs should work", func() {
+
+				cert := getSANCert(
+					cacert1,
+					cakey1,
+					[]net.IP{net.ParseIP("10.0.0.1"), net.ParseIP("::1")},
+					"https://example.com/me",
+					"spiffe://example.org/ns/default/sa/api",
+				)
+
+				iss, err := New(context.Background(), src, cert)
+				So(err, ShouldBeNil)
+
+				idt := iss.Issue()
+				So(idt.Identity, ShouldContain, "ipaddress=10.0.0.1")
+				So(idt.Identity, ShouldContain, "ipaddress=::1")
+				So(idt.Identity, ShouldContain, "uri=https://example.com/me")
+				So(idt.Identity, ShouldContain, "uri=spiffe://example.org/ns/default/sa/api")
+				So(idt.Identity, ShouldContain, "spiffe:trustdomain=example.org")
+				So(idt.Identity, ShouldContain, "spiffe:path=/ns/default/sa/api")
+			})
+
+			Convey("Calling FromCertificate with a source requiring a trust domain", func() {
+
+				src.TrustDomain = "example.org"
+
+				Convey("Then a user cert from the trust domain should work", func() {
+					cert := getSANCert(cacert1, cakey1, nil, "spiffe://example.org/api")
+					_, err := New(context.Background(), src, cert)
+					So(err, ShouldBeNil)
+				})
+
+				Convey("Then a user cert from another trust domain should fail", func() {
+					cert := getSANCert(cacert1, cakey1, nil, "spiffe://other.org/api")
+					_, err := New(context.Background(), src, cert)
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldEqual, "spiffe id 'spiffe://other.org/api' is not part of the trust domain 'example.org'")
+				})
+
+				Convey("Then a user cert without spiffe id should fail", func() {
+					_, err := New(context.Background(), src, usercert1)
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldEqual, "certificate does not contain any spiffe id")
+				})
+			})
+
 			Convey("Calling FromCertificate with a valid modifier should work", func() {
 
 				ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
@@ -198,6 +312,23 @@ func TestMTLSIssuer(t *testing.T) {
 				So(err.Error(), ShouldEqual, `unable to call modifier: service returned an error: 403 Forbidden`)
 			})
 
+			Convey("Calling FromCertificate with a user cert that has not been revoked should work", func() {
+
+				src.CRL = getCRL(cacert1, cakey1, 43)
+
+				err := iss.fromCertificate(context.Background(), usercert1)
+				So(err, ShouldBeNil)
+			})
+
+			Convey("Calling FromCertificate with a revoked user cert should fail", func() {
+
+				src.CRL = getCRL(cacert1, cakey1, 43, 42)
+
+				err := iss.fromCertificate(context.Background(), usercert1)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "unable to verify certificate revocation: certificate has been revoked")
+			})
+
 			Convey("Calling FromCertificate with a invalid user cert should work", func() {
 
 				err := iss.fromCertificate(context.Background(), usercert2)
@@ -207,3 +338,93 @@ func TestMTLSIssuer(t *testing.T) {
 		})
 	})
 }
+
+func Test_spiffeIDFromCertificate(t *testing.T) {
+
+	parse := func(uris ...string) []*url.URL {
+		var out []*url.URL
+		for _, u := range uris {
+			pu, _ := url.Parse(u)
+			out = append(out, pu)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name    string
+		uris    []*url.URL
+		want    string
+		wantErr string
+	}{
+		{
+			"no uri",
+			nil,
+			"",
+			"",
+		},
+		{
+			"no spiffe uri",
+			parse("https://example.com"),
+			"",
+			"",
+		},
+		{
+			"spiffe uri",
+			parse("https://example.com", "spiffe://example.org/api"),
+			"spiffe://example.org/api",
+			"",
+		},
+		{
+			"spiffe uri without path",
+			parse("spiffe://example.org"),
+			"spiffe://example.org",
+			"",
+		},
+		{
+			"multiple spiffe uris",
+			parse("spiffe://example.org/a", "spiffe://example.org/b"),
+			"",
+			"certificate contains more than one spiffe id",
+		},
+		{
+			"spiffe uri without trust domain",
+			parse("spiffe:///api"),
+			"",
+			"certificate contains an invalid spiffe id 'spiffe:///api'",
+		},
+		{
+			"spiffe uri with query",
+			parse("spiffe://example.org/api?a=b"),
+			"",
+			"certificate contains an invalid spiffe id 'spiffe://example.org/api?a=b'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			id, err := spiffeIDFromCertificate(&x509.Certificate{URIs: tt.uris})
+
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Errorf("spiffeIDFromCertificate() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("spiffeIDFromCertificate() unexpected error = %v", err)
+				return
+			}
+
+			var got string
+			if id != nil {
+				got = id.String()
+			}
+
+			if got != tt.want {
+				t.Errorf("spiffeIDFromCertificate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
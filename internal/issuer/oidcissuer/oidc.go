@@ -45,7 +45,12 @@ func (c *oidcIssuer) Issue() *token.IdentityToken {
 
 func (c *oidcIssuer) fromClaims(ctx context.Context, claims map[string]any) (err error) {
 
-	c.token.Identity = computeOIDClaims(claims)
+	inc, exc := ComputeInclusion(c.source.IncludedKeys, c.source.IgnoredKeys)
+
+	c.token.Identity = renameClaims(
+		FilterClaims(ComputeClaims(claims), inc, exc),
+		c.source.ClaimMapping,
+	)
 
 	if srcmod := c.source.Modifier; srcmod != nil {
 
@@ -62,45 +67,137 @@ func (c *oidcIssuer) fromClaims(ctx context.Context, claims map[string]any) (err
 	return nil
 }
 
-func computeOIDClaims(claims map[string]any) []string {
+// ComputeClaims flattens the given claims into a sorted list of key=value.
+// Lists produce one entry per item, nested objects produce dotted keys,
+// and leading @ are removed from the keys.
+func ComputeClaims(claims map[string]any) []string {
 
 	out := []string{}
 
 	for k, v := range claims {
-		k = strings.TrimLeft(k, "@")
-		switch claim := v.(type) {
-		case string:
-			out = append(out, fmt.Sprintf("%s=%s", k, claim))
-		case []string:
-			for _, item := range claim {
-				out = append(out, fmt.Sprintf("%s=%s", k, item))
-			}
-		case int:
-			out = append(out, fmt.Sprintf("%s=%d", k, claim))
-		case []int:
-			for _, item := range claim {
-				out = append(out, fmt.Sprintf("%s=%d", k, item))
-			}
-		case float64:
-			out = append(out, fmt.Sprintf("%s=%f", k, claim))
-		case []float64:
-			for _, item := range claim {
-				out = append(out, fmt.Sprintf("%s=%f", k, item))
+		out = appendClaim(out, strings.TrimLeft(k, "@"), v)
+	}
+
+	sort.Strings(out)
+
+	return out
+}
+
+func appendClaim(out []string, k string, v any) []string {
+
+	switch claim := v.(type) {
+	case nil:
+	case string:
+		out = append(out, fmt.Sprintf("%s=%s", k, claim))
+	case []string:
+		for _, item := range claim {
+			out = append(out, fmt.Sprintf("%s=%s", k, item))
+		}
+	case int:
+		out = append(out, fmt.Sprintf("%s=%d", k, claim))
+	case []int:
+		for _, item := range claim {
+			out = append(out, fmt.Sprintf("%s=%d", k, item))
+		}
+	case float64:
+		out = append(out, fmt.Sprintf("%s=%f", k, claim))
+	case []float64:
+		for _, item := range claim {
+			out = append(out, fmt.Sprintf("%s=%f", k, item))
+		}
+	case bool:
+		out = append(out, fmt.Sprintf("%s=%t", k, claim))
+	case []any:
+		for _, item := range claim {
+			switch item.(type) {
+			case string, map[string]any:
+				out = appendClaim(out, k, item)
 			}
-		case bool:
-			out = append(out, fmt.Sprintf("%s=%t", k, claim))
-		case []any:
-			for _, item := range claim {
-				if claimValue, ok := item.(string); ok {
-					out = append(out, fmt.Sprintf("%s=%s", strings.TrimLeft(k, "@"), claimValue))
-				}
+		}
+	case map[string]any:
+		for sk, sv := range claim {
+			out = appendClaim(out, fmt.Sprintf("%s.%s", k, strings.TrimLeft(sk, "@")), sv)
+		}
+	default:
+		out = append(out, fmt.Sprintf("%s=%v", k, v))
+	}
+
+	return out
+}
+
+// FilterClaims returns the given claims whose keys are not in exc and, if inc
+// is not empty, are in inc. Keys are matched case insensitively, so inc and exc
+// must hold lower cased keys, as returned by ComputeInclusion.
+func FilterClaims(claims []string, inc map[string]struct{}, exc map[string]struct{}) []string {
+
+	out := make([]string, 0, len(claims))
+
+	for _, claim := range claims {
+
+		key, _, _ := strings.Cut(claim, "=")
+		key = strings.ToLower(key)
+
+		if _, ok := exc[key]; ok {
+			continue
+		}
+
+		if len(inc) > 0 {
+			if _, ok := inc[key]; !ok {
+				continue
 			}
-		default:
-			out = append(out, fmt.Sprintf("%s=%s", k, v))
 		}
+
+		out = append(out, claim)
+	}
+
+	return out
+}
+
+// renameClaims renames the keys of the given claims according to the given
+// mapping. Like in FilterClaims, keys are matched case insensitively. Like in
+// ComputeClaims, the leading @ of the new keys are removed, as they are reserved.
+func renameClaims(claims []string, mapping map[string]string) []string {
+
+	if len(mapping) == 0 {
+		return claims
+	}
+
+	names := make(map[string]string, len(mapping))
+	for key, name := range mapping {
+		names[strings.ToLower(key)] = name
+	}
+
+	out := make([]string, 0, len(claims))
+
+	for _, claim := range claims {
+
+		key, value, _ := strings.Cut(claim, "=")
+
+		if name, ok := names[strings.ToLower(key)]; ok {
+			claim = fmt.Sprintf("%s=%s", strings.TrimLeft(name, "@"), value)
+		}
+
+		out = append(out, claim)
 	}
 
 	sort.Strings(out)
 
 	return out
 }
+
+// ComputeInclusion returns the sets of keys to include and
+// to ignore, to be used with FilterClaims, from the given lists.
+func ComputeInclusion(includedKeys []string, ignoredKeys []string) (inc map[string]struct{}, exc map[string]struct{}) {
+
+	inc = make(map[string]struct{}, len(includedKeys))
+	for _, key := range includedKeys {
+		inc[strings.ToLower(key)] = struct{}{}
+	}
+
+	exc = make(map[string]struct{}, len(ignoredKeys))
+	for _, key := range ignoredKeys {
+		exc[strings.ToLower(key)] = struct{}{}
+	}
+
+	return inc, exc
+}
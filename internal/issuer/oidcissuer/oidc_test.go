@@ -51,6 +51,26 @@ func TestNew(t *testing.T) {
 		So(iss.Issue().Source.Namespace, ShouldEqual, "/ns")
 	})
 
+	Convey("Calling New with a source with filters and a claim mapping should work", t, func() {
+		src := api.NewOIDCSource()
+		src.IncludedKeys = []string{"Email", "groups", "realm_access.roles"}
+		src.IgnoredKeys = []string{"email"}
+		src.ClaimMapping = map[string]string{
+			"groups":             "group",
+			"realm_access.roles": "group",
+		}
+		iss, err := New(context.Background(), src, map[string]any{
+			"email":  "alice@example.com",
+			"sub":    "alice",
+			"groups": []any{"devs"},
+			"realm_access": map[string]any{
+				"roles": []any{"admins"},
+			},
+		})
+		So(err, ShouldBeNil)
+		So(iss.Issue().Identity, ShouldResemble, []string{"group=admins", "group=devs"})
+	})
+
 	Convey("Calling New with a source and a modifier should work", t, func() {
 
 		src := api.NewOIDCSource()
@@ -120,7 +140,7 @@ func TestNew(t *testing.T) {
 	})
 }
 
-func Test_computeOIDClaims(t *testing.T) {
+func TestComputeClaims(t *testing.T) {
 	type args struct {
 		claims map[string]any
 	}
@@ -159,22 +179,253 @@ func Test_computeOIDClaims(t *testing.T) {
 				"int=42",
 				"ints=1",
 				"ints=2",
-				"map=map[]",
 				"string=value",
 				"strings=v1",
 				"strings=v2",
 			},
 		},
+		{
+			"nested",
+			func(*testing.T) args {
+				return args{
+					map[string]any{
+						"sub": "alice",
+						"realm_access": map[string]any{
+							"roles": []any{"admin", "user"},
+						},
+						"address": map[string]any{
+							"country": "fr",
+							"geo": map[string]any{
+								"@zone": "eu",
+							},
+						},
+						"orgs": []any{
+							map[string]any{"name": "a"},
+							map[string]any{"name": "b"},
+							42.0,
+						},
+						"empty": nil,
+					},
+				}
+			},
+			[]string{
+				"address.country=fr",
+				"address.geo.zone=eu",
+				"orgs.name=a",
+				"orgs.name=b",
+				"realm_access.roles=admin",
+				"realm_access.roles=user",
+				"sub=alice",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tArgs := tt.args(t)
+
+			got1 := ComputeClaims(tArgs.claims)
+
+			if !reflect.DeepEqual(got1, tt.want1) {
+				t.Errorf("ComputeClaims got1 = %v, want1: %v", got1, tt.want1)
+			}
+		})
+	}
+}
+
+func Test_renameClaims(t *testing.T) {
+	type args struct {
+		claims  []string
+		mapping map[string]string
+	}
+	tests := []struct {
+		name string
+		args func(t *testing.T) args
+
+		want1 []string
+	}{
+		{
+			"no mapping",
+			func(*testing.T) args {
+				return args{
+					[]string{"b=1", "a=2"},
+					nil,
+				}
+			},
+			[]string{"b=1", "a=2"},
+		},
+		{
+			"mapping",
+			func(*testing.T) args {
+				return args{
+					[]string{"groups=a", "sub=alice", "roles=b=c"},
+					map[string]string{"groups": "group", "roles": "group"},
+				}
+			},
+			[]string{"group=a", "group=b=c", "sub=alice"},
+		},
+		{
+			"mapping with different case",
+			func(*testing.T) args {
+				return args{
+					[]string{"Groups=a", "realm_access.Roles=b"},
+					map[string]string{"groups": "group", "Realm_Access.roles": "role"},
+				}
+			},
+			[]string{"group=a", "role=b"},
+		},
+		{
+			"mapping to reserved keys",
+			func(*testing.T) args {
+				return args{
+					[]string{"groups=a", "sub=alice"},
+					map[string]string{"groups": "@source:type", "sub": "@@sub"},
+				}
+			},
+			[]string{"source:type=a", "sub=alice"},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tArgs := tt.args(t)
 
-			got1 := computeOIDClaims(tArgs.claims)
+			got1 := renameClaims(tArgs.claims, tArgs.mapping)
 
 			if !reflect.DeepEqual(got1, tt.want1) {
-				t.Errorf("computeOIDClaims got1 = %v, want1: %v", got1, tt.want1)
+				t.Errorf("renameClaims got1 = %v, want1: %v", got1, tt.want1)
+			}
+		})
+	}
+}
+
+func TestFilterClaims(t *testing.T) {
+	type args struct {
+		claims []string
+		inc    map[string]struct{}
+		exc    map[string]struct{}
+	}
+	tests := []struct {
+		name string
+		args func(t *testing.T) args
+
+		want1 []string
+	}{
+		{
+			"no filter",
+			func(*testing.T) args {
+				return args{
+					[]string{"a=1", "b=2"},
+					map[string]struct{}{},
+					map[string]struct{}{},
+				}
+			},
+			[]string{"a=1", "b=2"},
+		},
+		{
+			"included",
+			func(*testing.T) args {
+				return args{
+					[]string{"a=1", "b=2", "b=3"},
+					map[string]struct{}{"b": {}},
+					map[string]struct{}{},
+				}
+			},
+			[]string{"b=2", "b=3"},
+		},
+		{
+			"ignored",
+			func(*testing.T) args {
+				return args{
+					[]string{"a=1", "b=2"},
+					map[string]struct{}{},
+					map[string]struct{}{"b": {}},
+				}
+			},
+			[]string{"a=1"},
+		},
+		{
+			"case insensitive keys",
+			func(*testing.T) args {
+				return args{
+					[]string{"A=1", "B=2", "C=3"},
+					map[string]struct{}{"a": {}, "b": {}},
+					map[string]struct{}{"b": {}},
+				}
+			},
+			[]string{"A=1"},
+		},
+		{
+			"value containing =",
+			func(*testing.T) args {
+				return args{
+					[]string{"a=b=c", "b=2"},
+					map[string]struct{}{"a": {}},
+					map[string]struct{}{},
+				}
+			},
+			[]string{"a=b=c"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tArgs := tt.args(t)
+
+			got1 := FilterClaims(tArgs.claims, tArgs.inc, tArgs.exc)
+
+			if !reflect.DeepEqual(got1, tt.want1) {
+				t.Errorf("FilterClaims got1 = %v, want1: %v", got1, tt.want1)
+			}
+		})
+	}
+}
+
+func TestComputeInclusion(t *testing.T) {
+	type args struct {
+		includedKeys []string
+		ignoredKeys  []string
+	}
+	tests := []struct {
+		name string
+		args func(t *testing.T) args
+
+		want1 map[string]struct{}
+		want2 map[string]struct{}
+	}{
+		{
+			"simple test",
+			func(*testing.T) args {
+				return args{
+					[]string{"a", "B"},
+					[]string{"b", "C"},
+				}
+			},
+			map[string]struct{}{"a": {}, "b": {}},
+			map[string]struct{}{"b": {}, "c": {}},
+		},
+		{
+			"nil keys",
+			func(*testing.T) args {
+				return args{}
+			},
+			map[string]struct{}{},
+			map[string]struct{}{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tArgs := tt.args(t)
+
+			got1, got2 := ComputeInclusion(tArgs.includedKeys, tArgs.ignoredKeys)
+
+			if !reflect.DeepEqual(got1, tt.want1) {
+				t.Errorf("ComputeInclusion got1 = %v, want1: %v", got1, tt.want1)
+			}
+
+			if !reflect.DeepEqual(got2, tt.want2) {
+				t.Errorf("ComputeInclusion got2 = %v, want2: %v", got2, tt.want2)
 			}
 		})
 	}
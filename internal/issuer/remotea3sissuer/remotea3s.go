@@ -13,8 +13,6 @@ import (
 	"go.aporeto.io/a3s/pkgs/token"
 )
 
-const wellKnownSuffix = ".well-known/jwks.json"
-
 // New retrurns new Remote A3S issuer.
 func New(
 	ctx context.Context,
@@ -52,9 +50,6 @@ func (c *remoteA3SIssuer) fromToken(ctx context.Context, tokenString string) err
 	if endpoint == "" {
 		endpoint = c.source.Issuer
 	}
-	if !strings.HasSuffix(endpoint, wellKnownSuffix) {
-		endpoint = fmt.Sprintf("%s/%s", strings.TrimRight(endpoint, "/"), wellKnownSuffix)
-	}
 
 	root := x509.NewCertPool()
 	root.AppendCertsFromPEM([]byte(c.source.CA))
@@ -66,7 +61,12 @@ func (c *remoteA3SIssuer) fromToken(ctx context.Context, tokenString string) err
 		},
 	}
 
-	jwks, err := token.NewRemoteJWKS(ctx, client, endpoint)
+	jwksURL, err := token.DiscoverJWKSURL(ctx, client, endpoint, c.source.Issuer)
+	if err != nil {
+		return ErrRemoteA3S{Err: fmt.Errorf("unable to discover remote jwks: %w", err)}
+	}
+
+	jwks, err := token.NewRemoteJWKS(ctx, client, jwksURL)
 	if err != nil {
 		return ErrRemoteA3S{Err: fmt.Errorf("unable to retrieve remote jwks: %w", err)}
 	}
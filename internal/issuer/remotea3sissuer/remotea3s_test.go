@@ -71,6 +71,10 @@ func TestNew(t *testing.T) {
 
 		ts := httptest.NewServer(
 			http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				if req.URL.Path == "/"+token.WellKnownDiscoveryPath {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
 				j := token.NewJWKS()
 				_ = j.Append(remoteCert)
 				d, _ := json.Marshal(j)
@@ -203,6 +207,57 @@ func TestNew(t *testing.T) {
 		})
 	})
 
+	Convey("Given an http server serving a discovery document and a A3SSource", t, func() {
+
+		remoteCert, remoteKey := getECCert(pkix.Name{CommonName: "local"})
+		kid := token.Fingerprint(remoteCert)
+
+		var url string
+		ts := httptest.NewServer(
+			http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				switch req.URL.Path {
+				case "/.well-known/openid-configuration":
+					d, _ := json.Marshal(&token.Discovery{Issuer: url, JWKSURI: url + "/keys"})
+					w.Write(d) // nolint
+				case "/keys":
+					j := token.NewJWKS()
+					_ = j.Append(remoteCert)
+					d, _ := json.Marshal(j)
+					w.Write(d) // nolint
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}),
+		)
+		defer ts.Close()
+		url = ts.URL
+
+		rtok := &token.IdentityToken{
+			Source:   token.Source{Type: "mtls"},
+			Identity: []string{"remote=claim"},
+		}
+
+		rtokString, _ := rtok.JWT(
+			remoteKey,
+			kid,
+			ts.URL,
+			[]string{"local"},
+			time.Now().Add(time.Minute),
+			nil,
+		)
+
+		source := &api.A3SSource{
+			Name:      "name",
+			Namespace: "/ns",
+			Issuer:    ts.URL,
+			Audience:  "local",
+		}
+
+		iss, err := New(context.Background(), source, rtokString)
+		So(err, ShouldBeNil)
+		So(iss.Issue().Identity, ShouldResemble, []string{"remote=claim"})
+	})
+
 	Convey("Given an http server and a A3SSource but token signature is unknown", t, func() {
 
 		remoteCert, remoteKey := getECCert(pkix.Name{CommonName: "local"})
@@ -259,6 +314,6 @@ func TestNew(t *testing.T) {
 		iss, err := New(context.Background(), source, "rtokString")
 		So(iss, ShouldBeNil)
 		So(err, ShouldNotBeNil)
-		So(err.Error(), ShouldContainSubstring, `remote a3s error: unable to retrieve remote jwks: remote jwks error: unable to send request: Get "toto:`)
+		So(err.Error(), ShouldContainSubstring, `remote a3s error: unable to discover remote jwks: remote discovery error: unable to send request: Get "toto:`)
 	})
 }
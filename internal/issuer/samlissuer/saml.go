@@ -0,0 +1,129 @@
+package samlissuer
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/crewjam/saml"
+	"go.aporeto.io/a3s/internal/identitymodifier"
+	"go.aporeto.io/a3s/pkgs/api"
+	"go.aporeto.io/a3s/pkgs/token"
+)
+
+// New returns a new SAML issuer.
+// The response is verified by the given service provider,
+// and must have been issued for the given request ID.
+func New(ctx context.Context, source *api.SAMLSource, sp *saml.ServiceProvider, response string, requestID string) (token.Issuer, error) {
+
+	c := newSAMLIssuer(source)
+	if err := c.fromResponse(ctx, sp, response, requestID); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+type samlIssuer struct {
+	token  *token.IdentityToken
+	source *api.SAMLSource
+}
+
+func newSAMLIssuer(source *api.SAMLSource) *samlIssuer {
+	return &samlIssuer{
+		source: source,
+		token: token.NewIdentityToken(token.Source{
+			Type:      "saml",
+			Namespace: source.Namespace,
+			Name:      source.Name,
+		}),
+	}
+}
+
+// Issue returns the IdentityToken.
+func (c *samlIssuer) Issue() *token.IdentityToken {
+
+	return c.token
+}
+
+func (c *samlIssuer) fromResponse(ctx context.Context, sp *saml.ServiceProvider, response string, requestID string) (err error) {
+
+	data, err := base64.StdEncoding.DecodeString(response)
+	if err != nil {
+		return ErrSAML{Err: fmt.Errorf("unable to decode response: %w", err)}
+	}
+
+	// The response has been received by the assertion
+	// consumer service of the service provider.
+	assertion, err := sp.ParseXMLResponse(data, []string{requestID}, sp.AcsURL)
+	if err != nil {
+		// The error returned by the service provider hides
+		// the reason of the failure behind a generic message.
+		var ierr *saml.InvalidResponseError
+		if errors.As(err, &ierr) && ierr.PrivateErr != nil {
+			err = ierr.PrivateErr
+		}
+		return ErrSAML{Err: err}
+	}
+
+	c.token.Identity = computeSAMLClaims(assertion, c.source.AttributeMapping)
+
+	if srcmod := c.source.Modifier; srcmod != nil {
+
+		m, err := identitymodifier.NewRemote(srcmod, c.token.Source)
+		if err != nil {
+			return fmt.Errorf("unable to prepare source modifier: %w", err)
+		}
+
+		if c.token.Identity, err = m.Modify(ctx, c.token.Identity); err != nil {
+			return fmt.Errorf("unable to call modifier: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func computeSAMLClaims(assertion *saml.Assertion, mapping map[string]string) []string {
+
+	out := []string{}
+
+	if assertion.Subject != nil && assertion.Subject.NameID != nil {
+		out = append(out, fmt.Sprintf("nameid=%s", assertion.Subject.NameID.Value))
+	}
+
+	for _, statement := range assertion.AttributeStatements {
+
+		for _, attr := range statement.Attributes {
+
+			key, ok := mapping[attr.Name]
+			if !ok {
+				key, ok = mapping[attr.FriendlyName]
+			}
+			if !ok {
+				key = attr.FriendlyName
+			}
+			if key == "" {
+				key = attr.Name
+			}
+
+			// Keys starting with @ are reserved to a3s.
+			key = strings.TrimLeft(key, "@")
+			if key == "" {
+				continue
+			}
+
+			for _, v := range attr.Values {
+				if v.Value != "" {
+					out = append(out, fmt.Sprintf("%s=%s", key, v.Value))
+				}
+			}
+		}
+	}
+
+	sort.Strings(out)
+
+	return out
+}
@@ -0,0 +1,318 @@
+package samlissuer
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"math/big"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/beevik/etree"
+	"github.com/crewjam/saml"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.aporeto.io/a3s/pkgs/api"
+)
+
+func makeIDP(name string) *saml.IdentityProvider {
+
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+
+	der, _ := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	cert, _ := x509.ParseCertificate(der)
+
+	return &saml.IdentityProvider{
+		Key:         key,
+		Certificate: cert,
+		MetadataURL: url.URL{Scheme: "https", Host: name, Path: "/metadata"},
+		SSOURL:      url.URL{Scheme: "https", Host: name, Path: "/sso"},
+	}
+}
+
+func makeIDPMetadata(idp *saml.IdentityProvider) string {
+
+	data, _ := xml.Marshal(idp.Metadata())
+	return string(data)
+}
+
+func makeSP(metadata string, entityID string) *saml.ServiceProvider {
+
+	md := &saml.EntityDescriptor{}
+	if err := xml.Unmarshal([]byte(metadata), md); err != nil {
+		panic(err)
+	}
+
+	return &saml.ServiceProvider{
+		EntityID:    entityID,
+		MetadataURL: url.URL{Scheme: "https", Host: "a3s", Path: "/saml/metadata"},
+		AcsURL:      url.URL{Scheme: "https", Host: "a3s", Path: "/saml/acs"},
+		IDPMetadata: md,
+	}
+}
+
+func makeResponse(idp *saml.IdentityProvider, sp *saml.ServiceProvider, requestID string) string {
+
+	now := saml.TimeNow()
+	spMetadata := sp.Metadata()
+
+	req := &saml.IdpAuthnRequest{
+		IDP:         idp,
+		HTTPRequest: httptest.NewRequest("POST", "/sso", nil),
+		Request: saml.AuthnRequest{
+			ID:           requestID,
+			IssueInstant: now,
+		},
+		ServiceProviderMetadata: spMetadata,
+		SPSSODescriptor:         &spMetadata.SPSSODescriptors[0],
+		ACSEndpoint: &saml.IndexedEndpoint{
+			Binding:  saml.HTTPPostBinding,
+			Location: sp.AcsURL.String(),
+		},
+		Now: now,
+	}
+
+	session := &saml.Session{
+		ID:         "session",
+		CreateTime: now,
+		ExpireTime: now.Add(time.Hour),
+		NameID:     "alice@example.com",
+		UserName:   "alice",
+		UserEmail:  "alice@example.com",
+		Groups:     []string{"admins", "devs"},
+	}
+
+	if err := (saml.DefaultAssertionMaker{}).MakeAssertion(req, session); err != nil {
+		panic(err)
+	}
+
+	if err := req.MakeResponse(); err != nil {
+		panic(err)
+	}
+
+	doc := etree.NewDocument()
+	doc.SetRoot(req.ResponseEl)
+	data, err := doc.WriteToBytes()
+	if err != nil {
+		panic(err)
+	}
+
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+func TestErrSAML(t *testing.T) {
+	Convey("ErrSAML should behave correctly ", t, func() {
+		e := fmt.Errorf("boom")
+		err := ErrSAML{Err: e}
+		So(err.Error(), ShouldEqual, "saml error: boom")
+		So(err.Unwrap(), ShouldEqual, e)
+	})
+}
+
+func TestNewSAMLIssuer(t *testing.T) {
+	Convey("NewSAMLIssuer should work", t, func() {
+		src := &api.SAMLSource{Namespace: "/ns", Name: "saml"}
+		iss := newSAMLIssuer(src)
+		So(iss.Issue().Source.Type, ShouldEqual, "saml")
+		So(iss.Issue().Source.Namespace, ShouldEqual, "/ns")
+		So(iss.Issue().Source.Name, ShouldEqual, "saml")
+		So(iss.source, ShouldEqual, src)
+	})
+}
+
+func TestSAMLFromResponse(t *testing.T) {
+
+	idp := makeIDP("idp.example.com")
+	otherIDP := makeIDP("idp.example.com")
+
+	Convey("Given a SAML source and its service provider", t, func() {
+
+		src := api.NewSAMLSource()
+		src.Namespace = "/ns"
+		src.Name = "saml"
+		src.IDPMetadata = makeIDPMetadata(idp)
+		src.EntityID = "https://a3s/saml"
+		src.AttributeMapping = map[string]string{
+			"urn:oid:1.3.6.1.4.1.5923.1.1.1.6": "email",
+			"eduPersonAffiliation":             "group",
+		}
+
+		sp := makeSP(src.IDPMetadata, src.EntityID)
+
+		Convey("Calling fromResponse with a valid response should work", func() {
+			iss := newSAMLIssuer(src)
+			err := iss.fromResponse(context.Background(), sp, makeResponse(idp, sp, "id-1"), "id-1")
+			So(err, ShouldBeNil)
+			So(iss.Issue().Identity, ShouldResemble, []string{
+				"email=alice@example.com",
+				"group=admins",
+				"group=devs",
+				"mail=alice@example.com",
+				"nameid=alice@example.com",
+				"uid=alice",
+			})
+		})
+
+		Convey("Calling fromResponse with a response for another request should fail", func() {
+			iss := newSAMLIssuer(src)
+			err := iss.fromResponse(context.Background(), sp, makeResponse(idp, sp, "id-1"), "id-2")
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "InResponseTo")
+		})
+
+		Convey("Calling fromResponse with a response for another audience should fail", func() {
+			iss := newSAMLIssuer(src)
+			err := iss.fromResponse(context.Background(), sp, makeResponse(idp, makeSP(src.IDPMetadata, "https://other"), "id-1"), "id-1")
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "AudienceRestriction")
+		})
+
+		Convey("Calling fromResponse with a response signed by another key should fail", func() {
+			iss := newSAMLIssuer(src)
+			err := iss.fromResponse(context.Background(), sp, makeResponse(otherIDP, sp, "id-1"), "id-1")
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "cannot validate signature")
+		})
+
+		Convey("Calling fromResponse with a tampered response should fail", func() {
+			data, _ := base64.StdEncoding.DecodeString(makeResponse(idp, sp, "id-1"))
+			tampered := base64.StdEncoding.EncodeToString([]byte(strings.ReplaceAll(string(data), "admins", "owners")))
+			iss := newSAMLIssuer(src)
+			err := iss.fromResponse(context.Background(), sp, tampered, "id-1")
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Calling fromResponse with an invalid response should fail", func() {
+			iss := newSAMLIssuer(src)
+			err := iss.fromResponse(context.Background(), sp, "not base64!", "id-1")
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldStartWith, "saml error: unable to decode response: ")
+		})
+	})
+}
+
+func Test_computeSAMLClaims(t *testing.T) {
+	type args struct {
+		assertion *saml.Assertion
+		mapping   map[string]string
+	}
+	tests := []struct {
+		name string
+		args func(t *testing.T) args
+
+		want1 []string
+	}{
+		{
+			"standard",
+			func(*testing.T) args {
+				return args{
+					&saml.Assertion{
+						Subject: &saml.Subject{NameID: &saml.NameID{Value: "alice"}},
+						AttributeStatements: []saml.AttributeStatement{
+							{
+								Attributes: []saml.Attribute{
+									{Name: "urn:oid:2.5.4.3", FriendlyName: "cn", Values: []saml.AttributeValue{{Value: "Alice"}}},
+									{Name: "groups", Values: []saml.AttributeValue{{Value: "a"}, {Value: "b"}, {Value: ""}}},
+									{Name: "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress", Values: []saml.AttributeValue{{Value: "alice@example.com"}}},
+								},
+							},
+						},
+					},
+					map[string]string{
+						"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress": "email",
+					},
+				}
+			},
+			[]string{
+				"cn=Alice",
+				"email=alice@example.com",
+				"groups=a",
+				"groups=b",
+				"nameid=alice",
+			},
+		},
+		{
+			"mapped friendly name",
+			func(*testing.T) args {
+				return args{
+					&saml.Assertion{
+						AttributeStatements: []saml.AttributeStatement{
+							{
+								Attributes: []saml.Attribute{
+									{Name: "urn:oid:2.5.4.3", FriendlyName: "cn", Values: []saml.AttributeValue{{Value: "Alice"}}},
+								},
+							},
+						},
+					},
+					map[string]string{
+						"cn": "name",
+					},
+				}
+			},
+			[]string{
+				"name=Alice",
+			},
+		},
+		{
+			"reserved keys",
+			func(*testing.T) args {
+				return args{
+					&saml.Assertion{
+						AttributeStatements: []saml.AttributeStatement{
+							{
+								Attributes: []saml.Attribute{
+									{Name: "@source:type", Values: []saml.AttributeValue{{Value: "a3s"}}},
+									{Name: "urn:oid:2.5.4.3", FriendlyName: "@@cn", Values: []saml.AttributeValue{{Value: "Alice"}}},
+									{Name: "@", Values: []saml.AttributeValue{{Value: "nothing"}}},
+								},
+							},
+						},
+					},
+					nil,
+				}
+			},
+			[]string{
+				"cn=Alice",
+				"source:type=a3s",
+			},
+		},
+		{
+			"empty",
+			func(*testing.T) args {
+				return args{
+					&saml.Assertion{},
+					nil,
+				}
+			},
+			[]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tArgs := tt.args(t)
+
+			got1 := computeSAMLClaims(tArgs.assertion, tArgs.mapping)
+
+			if !reflect.DeepEqual(got1, tt.want1) {
+				t.Errorf("computeSAMLClaims got1 = %v, want1: %v", got1, tt.want1)
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package samlissuer
+
+import "fmt"
+
+// ErrSAML represents an error that happened
+// during operations related to SAML.
+type ErrSAML struct {
+	Err error
+}
+
+func (e ErrSAML) Error() string {
+	return fmt.Sprintf("saml error: %s", e.Err)
+}
+
+// Unwrap returns the warped error.
+func (e ErrSAML) Unwrap() error {
+	return e.Err
+}
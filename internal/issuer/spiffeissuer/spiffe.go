@@ -0,0 +1,150 @@
+package spiffeissuer
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"go.aporeto.io/a3s/internal/identitymodifier"
+	"go.aporeto.io/a3s/internal/spiffebundle"
+	"go.aporeto.io/a3s/pkgs/api"
+	"go.aporeto.io/a3s/pkgs/token"
+)
+
+// bundles caches the bundles retrieved from
+// the bundle endpoints across requests.
+var bundles = spiffebundle.NewCache(5 * time.Minute)
+
+// supportedSigningAlgs lists the algorithms
+// allowed by the JWT-SVID specification.
+var supportedSigningAlgs = []string{
+	oidc.RS256, oidc.RS384, oidc.RS512,
+	oidc.ES256, oidc.ES384, oidc.ES512,
+	oidc.PS256, oidc.PS384, oidc.PS512,
+}
+
+// New returns a new SPIFFE issuer.
+// The JWT-SVID must be verified by the trust bundle of the given source.
+func New(ctx context.Context, source *api.SPIFFESource, svid string) (token.Issuer, error) {
+
+	c := newSPIFFEIssuer(source)
+	if err := c.fromSVID(ctx, svid); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+type spiffeIssuer struct {
+	token  *token.IdentityToken
+	source *api.SPIFFESource
+}
+
+func newSPIFFEIssuer(source *api.SPIFFESource) *spiffeIssuer {
+	return &spiffeIssuer{
+		source: source,
+		token: token.NewIdentityToken(token.Source{
+			Type:      "spiffe",
+			Namespace: source.Namespace,
+			Name:      source.Name,
+		}),
+	}
+}
+
+// Issue returns the IdentityToken.
+func (c *spiffeIssuer) Issue() *token.IdentityToken {
+
+	return c.token
+}
+
+func (c *spiffeIssuer) fromSVID(ctx context.Context, svid string) error {
+
+	bundle, err := c.bundle(ctx)
+	if err != nil {
+		return ErrSPIFFE{Err: err}
+	}
+
+	// JWT-SVIDs have no issuer. They are only
+	// bound to the trust domain by their subject.
+	verifier := oidc.NewVerifier(
+		"",
+		&oidc.StaticKeySet{PublicKeys: bundle.JWTAuthorities},
+		&oidc.Config{
+			ClientID:             c.source.Audience,
+			SkipIssuerCheck:      true,
+			SupportedSigningAlgs: supportedSigningAlgs,
+		},
+	)
+
+	idt, err := verifier.Verify(ctx, svid)
+	if err != nil {
+		return ErrSPIFFE{Err: err}
+	}
+
+	id, err := parseSPIFFEID(idt.Subject)
+	if err != nil {
+		return ErrSPIFFE{Err: err}
+	}
+
+	if !strings.EqualFold(id.Host, c.source.TrustDomain) {
+		return ErrSPIFFE{Err: fmt.Errorf("spiffe id '%s' is not part of the trust domain '%s'", id, c.source.TrustDomain)}
+	}
+
+	c.token.Identity = computeSPIFFEClaims(id)
+
+	if srcmod := c.source.Modifier; srcmod != nil {
+
+		m, err := identitymodifier.NewRemote(srcmod, c.token.Source)
+		if err != nil {
+			return fmt.Errorf("unable to prepare source modifier: %w", err)
+		}
+
+		if c.token.Identity, err = m.Modify(ctx, c.token.Identity); err != nil {
+			return fmt.Errorf("unable to call modifier: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// bundle returns the trust bundle to use to verify the JWT-SVIDs.
+func (c *spiffeIssuer) bundle(ctx context.Context) (*spiffebundle.Bundle, error) {
+
+	if c.source.Bundle != "" {
+		return spiffebundle.Parse([]byte(c.source.Bundle))
+	}
+
+	return bundles.Get(ctx, c.source.BundleEndpointURL, c.source.CA)
+}
+
+// parseSPIFFEID parses the given SPIFFE ID.
+func parseSPIFFEID(s string) (*url.URL, error) {
+
+	id, err := url.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid spiffe id '%s': %w", s, err)
+	}
+
+	if !strings.EqualFold(id.Scheme, "spiffe") || id.Host == "" || id.User != nil || id.RawQuery != "" || id.Fragment != "" {
+		return nil, fmt.Errorf("invalid spiffe id '%s'", s)
+	}
+
+	return id, nil
+}
+
+func computeSPIFFEClaims(id *url.URL) []string {
+
+	claims := []string{
+		fmt.Sprintf("spiffe:id=%s", id),
+		fmt.Sprintf("spiffe:trustdomain=%s", id.Host),
+	}
+
+	if id.Path != "" {
+		claims = append(claims, fmt.Sprintf("spiffe:path=%s", id.Path))
+	}
+
+	return claims
+}
@@ -0,0 +1,233 @@
+package spiffeissuer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.aporeto.io/a3s/pkgs/api"
+)
+
+func makeBundle(key *ecdsa.PrivateKey) string {
+
+	data, _ := json.Marshal(map[string]any{
+		"keys": []map[string]string{
+			{
+				"kty": "EC",
+				"use": "jwt-svid",
+				"kid": "kid",
+				"crv": "P-256",
+				"x":   base64.RawURLEncoding.EncodeToString(key.X.Bytes()),
+				"y":   base64.RawURLEncoding.EncodeToString(key.Y.Bytes()),
+			},
+		},
+		"spiffe_refresh_hint": 300,
+	})
+
+	return string(data)
+}
+
+func makeSVID(key *ecdsa.PrivateKey, sub string, aud string, exp time.Time) string {
+
+	t := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"sub": sub,
+		"aud": aud,
+		"exp": exp.Unix(),
+	})
+	t.Header["kid"] = "kid"
+
+	s, err := t.SignedString(key)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+func TestErrSPIFFE(t *testing.T) {
+	Convey("ErrSPIFFE should behave correctly ", t, func() {
+		e := fmt.Errorf("boom")
+		err := ErrSPIFFE{Err: e}
+		So(err.Error(), ShouldEqual, "spiffe error: boom")
+		So(err.Unwrap(), ShouldEqual, e)
+	})
+}
+
+func TestNewSPIFFEIssuer(t *testing.T) {
+	Convey("NewSPIFFEIssuer should work", t, func() {
+		src := &api.SPIFFESource{Namespace: "/ns", Name: "spiffe"}
+		iss := newSPIFFEIssuer(src)
+		So(iss.Issue().Source.Type, ShouldEqual, "spiffe")
+		So(iss.Issue().Source.Namespace, ShouldEqual, "/ns")
+		So(iss.Issue().Source.Name, ShouldEqual, "spiffe")
+		So(iss.source, ShouldEqual, src)
+	})
+}
+
+func TestSPIFFEFromSVID(t *testing.T) {
+
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	otherKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	Convey("Given a SPIFFE source with a static bundle", t, func() {
+
+		src := api.NewSPIFFESource()
+		src.Namespace = "/ns"
+		src.Name = "spiffe"
+		src.TrustDomain = "example.org"
+		src.Audience = "a3s"
+		src.Bundle = makeBundle(key)
+
+		Convey("Calling New with a valid JWT-SVID should work", func() {
+			iss, err := New(context.Background(), src, makeSVID(key, "spiffe://example.org/ns/default/sa/api", "a3s", time.Now().Add(time.Minute)))
+			So(err, ShouldBeNil)
+			So(iss.Issue().Identity, ShouldResemble, []string{
+				"spiffe:id=spiffe://example.org/ns/default/sa/api",
+				"spiffe:trustdomain=example.org",
+				"spiffe:path=/ns/default/sa/api",
+			})
+		})
+
+		Convey("Calling New with a JWT-SVID signed by another key should fail", func() {
+			iss, err := New(context.Background(), src, makeSVID(otherKey, "spiffe://example.org/api", "a3s", time.Now().Add(time.Minute)))
+			So(iss, ShouldBeNil)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldStartWith, "spiffe error: failed to verify signature")
+		})
+
+		Convey("Calling New with a JWT-SVID for another audience should fail", func() {
+			iss, err := New(context.Background(), src, makeSVID(key, "spiffe://example.org/api", "other", time.Now().Add(time.Minute)))
+			So(iss, ShouldBeNil)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldStartWith, "spiffe error: oidc: expected audience")
+		})
+
+		Convey("Calling New with an expired JWT-SVID should fail", func() {
+			iss, err := New(context.Background(), src, makeSVID(key, "spiffe://example.org/api", "a3s", time.Now().Add(-time.Minute)))
+			So(iss, ShouldBeNil)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldStartWith, "spiffe error: oidc: token is expired")
+		})
+
+		Convey("Calling New with a JWT-SVID from another trust domain should fail", func() {
+			iss, err := New(context.Background(), src, makeSVID(key, "spiffe://other.org/api", "a3s", time.Now().Add(time.Minute)))
+			So(iss, ShouldBeNil)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "spiffe error: spiffe id 'spiffe://other.org/api' is not part of the trust domain 'example.org'")
+		})
+
+		Convey("Calling New with a JWT-SVID without spiffe id should fail", func() {
+			iss, err := New(context.Background(), src, makeSVID(key, "api", "a3s", time.Now().Add(time.Minute)))
+			So(iss, ShouldBeNil)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "spiffe error: invalid spiffe id 'api'")
+		})
+
+		Convey("Calling New with an invalid bundle should fail", func() {
+			src.Bundle = `{"keys":[]}`
+			iss, err := New(context.Background(), src, makeSVID(key, "spiffe://example.org/api", "a3s", time.Now().Add(time.Minute)))
+			So(iss, ShouldBeNil)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "spiffe error: bundle does not contain any jwt-svid key")
+		})
+	})
+
+	Convey("Given a SPIFFE source with a bundle endpoint", t, func() {
+
+		ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(makeBundle(key)))
+		}))
+		defer ts.Close()
+
+		src := api.NewSPIFFESource()
+		src.Namespace = "/ns"
+		src.Name = "spiffe"
+		src.TrustDomain = "example.org"
+		src.Audience = "a3s"
+		src.BundleEndpointURL = ts.URL
+		src.CA = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw}))
+
+		Convey("Calling New with a valid JWT-SVID should work", func() {
+			iss, err := New(context.Background(), src, makeSVID(key, "spiffe://example.org/api", "a3s", time.Now().Add(time.Minute)))
+			So(err, ShouldBeNil)
+			So(iss.Issue().Identity, ShouldContain, "spiffe:id=spiffe://example.org/api")
+		})
+	})
+}
+
+func Test_parseSPIFFEID(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		id      string
+		want    []string
+		wantErr bool
+	}{
+		{
+			"valid",
+			"spiffe://example.org/ns/default",
+			[]string{"spiffe:id=spiffe://example.org/ns/default", "spiffe:trustdomain=example.org", "spiffe:path=/ns/default"},
+			false,
+		},
+		{
+			"valid without path",
+			"spiffe://example.org",
+			[]string{"spiffe:id=spiffe://example.org", "spiffe:trustdomain=example.org"},
+			false,
+		},
+		{
+			"wrong scheme",
+			"https://example.org/ns/default",
+			nil,
+			true,
+		},
+		{
+			"missing trust domain",
+			"spiffe:///ns/default",
+			nil,
+			true,
+		},
+		{
+			"query",
+			"spiffe://example.org/ns/default?a=b",
+			nil,
+			true,
+		},
+		{
+			"user info",
+			"spiffe://user@example.org/ns/default",
+			nil,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			id, err := parseSPIFFEID(tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseSPIFFEID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got := computeSPIFFEClaims(id); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("computeSPIFFEClaims() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
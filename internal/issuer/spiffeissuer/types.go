@@ -0,0 +1,18 @@
+package spiffeissuer
+
+import "fmt"
+
+// ErrSPIFFE represents an error that happened
+// during operations related to SPIFFE.
+type ErrSPIFFE struct {
+	Err error
+}
+
+func (e ErrSPIFFE) Error() string {
+	return fmt.Sprintf("spiffe error: %s", e.Err)
+}
+
+// Unwrap returns the warped error.
+func (e ErrSPIFFE) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,68 @@
+package keyset
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"go.aporeto.io/a3s/internal/oidcceremony"
+)
+
+// A Cache holds remote key sets by URL so they are
+// shared across requests. Each key set fetches the keys
+// when first used, and fetches them again when a token
+// is signed by an unknown key. A key set older than the
+// max age is dropped, so keys removed from the remote set
+// stop being trusted.
+type Cache struct {
+	maxAge time.Duration
+	sets   map[string]cacheEntry
+
+	sync.Mutex
+}
+
+type cacheEntry struct {
+	keySet  *oidc.RemoteKeySet
+	created time.Time
+}
+
+// NewCache returns a new Cache keeping the key sets
+// for the given max age.
+func NewCache(maxAge time.Duration) *Cache {
+	return &Cache{
+		maxAge: maxAge,
+		sets:   map[string]cacheEntry{},
+	}
+}
+
+// Get returns the key set for the given URL. If ca is
+// not empty, it is used to verify the server certificate
+// instead of the system trust store. It returns an error
+// if ca does not contain any valid certificate.
+func (c *Cache) Get(url string, ca string) (oidc.KeySet, error) {
+
+	c.Lock()
+	defer c.Unlock()
+
+	now := time.Now()
+	key := url + "\n" + ca
+
+	if entry, ok := c.sets[key]; ok && now.Sub(entry.created) < c.maxAge {
+		return entry.keySet, nil
+	}
+
+	client, err := oidcceremony.MakeOIDCProviderClient(ca)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create key set http client: %w", err)
+	}
+
+	ks := oidc.NewRemoteKeySet(oidc.ClientContext(context.Background(), client), url)
+	c.sets[key] = cacheEntry{
+		keySet:  ks,
+		created: now,
+	}
+
+	return ks, nil
+}
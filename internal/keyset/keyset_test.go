@@ -0,0 +1,135 @@
+package keyset
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/golang-jwt/jwt/v4"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func makeServer(key *rsa.PrivateKey, kid string, hits *int32) *httptest.Server {
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(hits, 1)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"keys": []map[string]string{
+				{
+					"kty": "RSA",
+					"alg": "RS256",
+					"use": "sig",
+					"kid": kid,
+					"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+					"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+				},
+			},
+		})
+	}))
+}
+
+func makeToken(key *rsa.PrivateKey, kid string) string {
+
+	t := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.RegisteredClaims{
+		Subject:   "sub",
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	})
+	t.Header["kid"] = kid
+
+	s, err := t.SignedString(key)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+func mustGet(c *Cache, url string, ca string) oidc.KeySet {
+
+	ks, err := c.Get(url, ca)
+	if err != nil {
+		panic(err)
+	}
+
+	return ks
+}
+
+func TestCache(t *testing.T) {
+
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+	otherKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+
+	Convey("Given a cache and a remote key set", t, func() {
+
+		var hits int32
+		ts := makeServer(key, "kid1", &hits)
+		defer ts.Close()
+
+		c := NewCache(time.Hour)
+
+		Convey("Then the key set should be shared and fetched once", func() {
+
+			_, err := mustGet(c, ts.URL, "").VerifySignature(context.Background(), makeToken(key, "kid1"))
+			So(err, ShouldBeNil)
+
+			_, err = mustGet(c, ts.URL, "").VerifySignature(context.Background(), makeToken(key, "kid1"))
+			So(err, ShouldBeNil)
+
+			So(mustGet(c, ts.URL, ""), ShouldEqual, mustGet(c, ts.URL, ""))
+			So(atomic.LoadInt32(&hits), ShouldEqual, 1)
+		})
+
+		Convey("Then a token signed by an unknown key should refresh the key set", func() {
+
+			_, err := mustGet(c, ts.URL, "").VerifySignature(context.Background(), makeToken(key, "kid1"))
+			So(err, ShouldBeNil)
+
+			_, err = mustGet(c, ts.URL, "").VerifySignature(context.Background(), makeToken(otherKey, "kid2"))
+			So(err, ShouldNotBeNil)
+			So(atomic.LoadInt32(&hits), ShouldEqual, 2)
+		})
+
+		Convey("Then a key set served over TLS should be verified using the given CA", func() {
+
+			tlsts := httptest.NewTLSServer(ts.Config.Handler)
+			defer tlsts.Close()
+
+			ca := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: tlsts.Certificate().Raw}))
+
+			_, err := mustGet(c, tlsts.URL, "").VerifySignature(context.Background(), makeToken(key, "kid1"))
+			So(err, ShouldNotBeNil)
+
+			_, err = mustGet(c, tlsts.URL, ca).VerifySignature(context.Background(), makeToken(key, "kid1"))
+			So(err, ShouldBeNil)
+		})
+
+		Convey("Then an invalid CA should be rejected", func() {
+
+			ks, err := c.Get(ts.URL, "not a certificate")
+			So(ks, ShouldBeNil)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "unable to create key set http client: unable to append given ca to ca pool")
+		})
+
+		Convey("When the key set is older than the max age", func() {
+
+			c.maxAge = time.Millisecond
+			first := mustGet(c, ts.URL, "")
+			time.Sleep(2 * time.Millisecond)
+
+			Convey("Then a new key set should be returned", func() {
+				So(mustGet(c, ts.URL, ""), ShouldNotEqual, first)
+			})
+		})
+	})
+}
@@ -0,0 +1,222 @@
+package localpassword
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"go.aporeto.io/a3s/pkgs/api"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Parameters used to hash new passwords.
+// The parameters are encoded in the hashes, so
+// changing them does not affect existing passwords.
+const (
+	argon2Time    = 2
+	argon2Memory  = 19 * 1024
+	argon2Threads = 1
+	argon2KeyLen  = 32
+	argon2SaltLen = 16
+	bcryptCost    = 12
+
+	// bcrypt ignores everything after 72 bytes.
+	bcryptMaxLength = 72
+
+	// maxLength caps the length of the passwords
+	// so hashing them stays cheap.
+	maxLength = 1024
+)
+
+// ErrPolicy is returned when a password does
+// not comply with the policy of a local source.
+type ErrPolicy struct {
+	Err error
+}
+
+func (e ErrPolicy) Error() string {
+	return fmt.Sprintf("password policy error: %s", e.Err)
+}
+
+// Unwrap returns the warped error.
+func (e ErrPolicy) Unwrap() error {
+	return e.Err
+}
+
+// dummyHash is verified against when there is no user to
+// authenticate, so the time it takes to fail does not reveal
+// whether the user exists.
+var dummyHash = func() string {
+	h, err := Hash("dummy-password", api.LocalSourceHashAlgorithmArgon2id)
+	if err != nil {
+		panic(err)
+	}
+	return h
+}()
+
+// Hash hashes the given password using the given algorithm.
+func Hash(password string, algorithm api.LocalSourceHashAlgorithmValue) (string, error) {
+
+	switch algorithm {
+
+	case api.LocalSourceHashAlgorithmBcrypt:
+
+		h, err := bcrypt.GenerateFromPassword([]byte(password), bcryptCost)
+		if err != nil {
+			return "", fmt.Errorf("unable to hash password: %w", err)
+		}
+
+		return string(h), nil
+
+	case api.LocalSourceHashAlgorithmArgon2id, "":
+
+		salt := make([]byte, argon2SaltLen)
+		if _, err := rand.Read(salt); err != nil {
+			return "", fmt.Errorf("unable to generate salt: %w", err)
+		}
+
+		key := argon2.IDKey([]byte(password), salt, argon2Time, argon2Memory, argon2Threads, argon2KeyLen)
+
+		return fmt.Sprintf(
+			"$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+			argon2.Version,
+			argon2Memory,
+			argon2Time,
+			argon2Threads,
+			base64.RawStdEncoding.EncodeToString(salt),
+			base64.RawStdEncoding.EncodeToString(key),
+		), nil
+
+	default:
+		return "", fmt.Errorf("unsupported hash algorithm '%s'", algorithm)
+	}
+}
+
+// Verify returns true if the given password matches the given hash.
+// The comparison is done in constant time. If the hash is empty,
+// the password is verified against a dummy hash, and Verify
+// always returns false.
+func Verify(hash string, password string) (bool, error) {
+
+	if hash == "" {
+		_, _ = verifyArgon2id(dummyHash, password)
+		return false, nil
+	}
+
+	switch {
+
+	case strings.HasPrefix(hash, "$argon2id$"):
+		return verifyArgon2id(hash, password)
+
+	case strings.HasPrefix(hash, "$2a$"), strings.HasPrefix(hash, "$2b$"), strings.HasPrefix(hash, "$2y$"):
+
+		err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, nil
+		}
+		if err != nil {
+			return false, fmt.Errorf("unable to verify bcrypt hash: %w", err)
+		}
+
+		return true, nil
+
+	default:
+		return false, fmt.Errorf("unsupported password hash")
+	}
+}
+
+func verifyArgon2id(hash string, password string) (bool, error) {
+
+	// $argon2id$v=19$m=19456,t=2,p=1$<salt>$<key>
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 {
+		return false, fmt.Errorf("invalid argon2id hash")
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return false, fmt.Errorf("invalid argon2id hash version: %w", err)
+	}
+	if version != argon2.Version {
+		return false, fmt.Errorf("unsupported argon2id version %d", version)
+	}
+
+	var memory, iterations uint32
+	var threads uint8
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &memory, &iterations, &threads); err != nil {
+		return false, fmt.Errorf("invalid argon2id hash parameters: %w", err)
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return false, fmt.Errorf("invalid argon2id hash salt: %w", err)
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return false, fmt.Errorf("invalid argon2id hash key: %w", err)
+	}
+
+	computed := argon2.IDKey([]byte(password), salt, iterations, memory, threads, uint32(len(key)))
+
+	return subtle.ConstantTimeCompare(key, computed) == 1, nil
+}
+
+// CheckPolicy verifies the given password complies
+// with the password policy of the given source.
+func CheckPolicy(source *api.LocalSource, password string) error {
+
+	if !utf8.ValidString(password) {
+		return ErrPolicy{Err: fmt.Errorf("password must be valid utf-8")}
+	}
+
+	if utf8.RuneCountInString(password) < source.PasswordMinLength {
+		return ErrPolicy{Err: fmt.Errorf("password must contain at least %d characters", source.PasswordMinLength)}
+	}
+
+	if len(password) > maxLength {
+		return ErrPolicy{Err: fmt.Errorf("password must not be longer than %d bytes", maxLength)}
+	}
+
+	if source.HashAlgorithm == api.LocalSourceHashAlgorithmBcrypt && len(password) > bcryptMaxLength {
+		return ErrPolicy{Err: fmt.Errorf("password must not be longer than %d bytes", bcryptMaxLength)}
+	}
+
+	var lower, upper, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		case !unicode.IsLetter(r):
+			symbol = true
+		}
+	}
+
+	if source.PasswordRequireLowercase && !lower {
+		return ErrPolicy{Err: fmt.Errorf("password must contain at least one lowercase letter")}
+	}
+
+	if source.PasswordRequireUppercase && !upper {
+		return ErrPolicy{Err: fmt.Errorf("password must contain at least one uppercase letter")}
+	}
+
+	if source.PasswordRequireDigits && !digit {
+		return ErrPolicy{Err: fmt.Errorf("password must contain at least one digit")}
+	}
+
+	if source.PasswordRequireSymbols && !symbol {
+		return ErrPolicy{Err: fmt.Errorf("password must contain at least one symbol")}
+	}
+
+	return nil
+}
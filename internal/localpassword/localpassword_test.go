@@ -0,0 +1,203 @@
+package localpassword
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.aporeto.io/a3s/pkgs/api"
+)
+
+func TestErrPolicy(t *testing.T) {
+	Convey("ErrPolicy should behave correctly", t, func() {
+		e := ErrPolicy{Err: errString("boom")}
+		So(e.Error(), ShouldEqual, "password policy error: boom")
+		So(e.Unwrap(), ShouldEqual, errString("boom"))
+	})
+}
+
+func TestHashAndVerify(t *testing.T) {
+
+	Convey("Given a password hashed with argon2id", t, func() {
+
+		h, err := Hash("s3cr3t", api.LocalSourceHashAlgorithmArgon2id)
+		So(err, ShouldBeNil)
+		So(h, ShouldStartWith, "$argon2id$v=19$m=19456,t=2,p=1$")
+
+		Convey("Then hashing it again should use a different salt", func() {
+			h2, err := Hash("s3cr3t", api.LocalSourceHashAlgorithmArgon2id)
+			So(err, ShouldBeNil)
+			So(h2, ShouldNotEqual, h)
+		})
+
+		Convey("Then verifying the right password should work", func() {
+			ok, err := Verify(h, "s3cr3t")
+			So(err, ShouldBeNil)
+			So(ok, ShouldBeTrue)
+		})
+
+		Convey("Then verifying the wrong password should fail", func() {
+			ok, err := Verify(h, "not-s3cr3t")
+			So(err, ShouldBeNil)
+			So(ok, ShouldBeFalse)
+		})
+
+		Convey("Then verifying a tampered hash should fail", func() {
+			ok, err := Verify(strings.Replace(h, "$v=19$", "$v=16$", 1), "s3cr3t")
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "unsupported argon2id version 16")
+			So(ok, ShouldBeFalse)
+
+			ok, err = Verify("$argon2id$v=19$m=19456,t=2,p=1$salt", "s3cr3t")
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "invalid argon2id hash")
+			So(ok, ShouldBeFalse)
+		})
+	})
+
+	Convey("Given a password hashed with bcrypt", t, func() {
+
+		h, err := Hash("s3cr3t", api.LocalSourceHashAlgorithmBcrypt)
+		So(err, ShouldBeNil)
+		So(h, ShouldStartWith, "$2a$12$")
+
+		Convey("Then verifying the right password should work", func() {
+			ok, err := Verify(h, "s3cr3t")
+			So(err, ShouldBeNil)
+			So(ok, ShouldBeTrue)
+		})
+
+		Convey("Then verifying the wrong password should fail", func() {
+			ok, err := Verify(h, "not-s3cr3t")
+			So(err, ShouldBeNil)
+			So(ok, ShouldBeFalse)
+		})
+	})
+
+	Convey("Hashing with an unsupported algorithm should fail", t, func() {
+		h, err := Hash("s3cr3t", "md5")
+		So(h, ShouldBeEmpty)
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldEqual, "unsupported hash algorithm 'md5'")
+	})
+
+	Convey("Verifying against an empty hash should fail", t, func() {
+		ok, err := Verify("", "dummy-password")
+		So(err, ShouldBeNil)
+		So(ok, ShouldBeFalse)
+	})
+
+	Convey("Verifying against an unsupported hash should fail", t, func() {
+		ok, err := Verify("5f4dcc3b5aa765d61d8327deb882cf99", "password")
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldEqual, "unsupported password hash")
+		So(ok, ShouldBeFalse)
+	})
+}
+
+func TestCheckPolicy(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		source   func(*api.LocalSource)
+		password string
+		wantErr  string
+	}{
+		{
+			"default policy",
+			func(*api.LocalSource) {},
+			"correct horse battery staple",
+			"",
+		},
+		{
+			"too short",
+			func(*api.LocalSource) {},
+			"short",
+			"password policy error: password must contain at least 12 characters",
+		},
+		{
+			"multibyte characters are counted once",
+			func(s *api.LocalSource) { s.PasswordMinLength = 4 },
+			"éééé",
+			"",
+		},
+		{
+			"too long",
+			func(*api.LocalSource) {},
+			strings.Repeat("a", 1025),
+			"password policy error: password must not be longer than 1024 bytes",
+		},
+		{
+			"too long for bcrypt",
+			func(s *api.LocalSource) { s.HashAlgorithm = api.LocalSourceHashAlgorithmBcrypt },
+			strings.Repeat("a", 73),
+			"password policy error: password must not be longer than 72 bytes",
+		},
+		{
+			"invalid utf-8",
+			func(*api.LocalSource) {},
+			"aaaaaaaaaaaa\xff",
+			"password policy error: password must be valid utf-8",
+		},
+		{
+			"missing lowercase",
+			func(s *api.LocalSource) { s.PasswordRequireLowercase = true },
+			"ABCDEFGHIJKL",
+			"password policy error: password must contain at least one lowercase letter",
+		},
+		{
+			"missing uppercase",
+			func(s *api.LocalSource) { s.PasswordRequireUppercase = true },
+			"abcdefghijkl",
+			"password policy error: password must contain at least one uppercase letter",
+		},
+		{
+			"missing digit",
+			func(s *api.LocalSource) { s.PasswordRequireDigits = true },
+			"abcdefghijkl",
+			"password policy error: password must contain at least one digit",
+		},
+		{
+			"missing symbol",
+			func(s *api.LocalSource) { s.PasswordRequireSymbols = true },
+			"abcdefghijk1",
+			"password policy error: password must contain at least one symbol",
+		},
+		{
+			"all requirements",
+			func(s *api.LocalSource) {
+				s.PasswordRequireLowercase = true
+				s.PasswordRequireUppercase = true
+				s.PasswordRequireDigits = true
+				s.PasswordRequireSymbols = true
+			},
+			"s3cr3t-Passw0rd",
+			"",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			src := api.NewLocalSource()
+			tt.source(src)
+
+			err := CheckPolicy(src, tt.password)
+
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("CheckPolicy() error = %v, want nil", err)
+				}
+				return
+			}
+
+			if err == nil || err.Error() != tt.wantErr {
+				t.Fatalf("CheckPolicy() error = %v, want %s", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+type errString string
+
+func (e errString) Error() string { return string(e) }
@@ -0,0 +1,135 @@
+package lockout
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/karlseguin/ccache/v2"
+)
+
+// ErrLocked is returned when too many attempts
+// have failed for a key.
+var ErrLocked = errors.New("too many failed attempts, try again later")
+
+// A Limiter limits the failed attempts made for a key, like
+// the credentials of a user. Once maxFailures attempts have
+// failed, the key is locked for the backoff duration, which
+// doubles on each new failure, up to maxBackoff. While the key
+// is locked, the attempts are rejected before doing any work.
+// A successful attempt resets the failures.
+type Limiter struct {
+	maxFailures int
+	backoff     time.Duration
+	maxBackoff  time.Duration
+	keys        *ccache.Cache
+
+	sync.Mutex
+}
+
+type state struct {
+	failures    int
+	inflight    int
+	lockedUntil time.Time
+}
+
+// NewLimiter returns a new Limiter locking a key after
+// maxFailures failed attempts, for the given backoff
+// doubling up to maxBackoff.
+func NewLimiter(maxFailures int, backoff time.Duration, maxBackoff time.Duration) *Limiter {
+
+	return &Limiter{
+		maxFailures: maxFailures,
+		backoff:     backoff,
+		maxBackoff:  maxBackoff,
+		keys:        ccache.New(ccache.Configure().MaxSize(100000)),
+	}
+}
+
+// Acquire starts an attempt for the given key. It returns ErrLocked
+// if the key is locked. Otherwise, the returned function must be
+// called with the outcome of the attempt once it is known.
+// Concurrent attempts are counted, so they cannot be used to make
+// more attempts than the remaining ones.
+func (l *Limiter) Acquire(key string) (func(success bool), error) {
+
+	l.Lock()
+	defer l.Unlock()
+
+	now := time.Now()
+	s := l.get(key)
+
+	allowed := l.maxFailures - s.failures
+	if allowed < 1 {
+		allowed = 1
+	}
+
+	if now.Before(s.lockedUntil) || s.inflight >= allowed {
+		return nil, ErrLocked
+	}
+
+	s.inflight++
+	l.keys.Set(key, s, l.maxBackoff)
+
+	var once sync.Once
+
+	return func(success bool) {
+		once.Do(func() { l.release(key, success) })
+	}, nil
+}
+
+func (l *Limiter) release(key string, success bool) {
+
+	l.Lock()
+	defer l.Unlock()
+
+	s := l.get(key)
+
+	if s.inflight > 0 {
+		s.inflight--
+	}
+
+	if success {
+		s.failures = 0
+		s.lockedUntil = time.Time{}
+	} else {
+		s.failures++
+		if s.failures >= l.maxFailures {
+			s.lockedUntil = time.Now().Add(l.lockDuration(s.failures - l.maxFailures))
+		}
+	}
+
+	if s.failures == 0 && s.inflight == 0 {
+		l.keys.Delete(key)
+		return
+	}
+
+	l.keys.Set(key, s, l.maxBackoff)
+}
+
+// get returns the state of the given key.
+// The caller must hold the lock.
+func (l *Limiter) get(key string) *state {
+
+	if item := l.keys.Get(key); item != nil && !item.Expired() {
+		return item.Value().(*state)
+	}
+
+	return &state{}
+}
+
+// lockDuration returns how long a key is locked after
+// the given number of failures past the maximum.
+func (l *Limiter) lockDuration(extra int) time.Duration {
+
+	d := l.backoff
+	for i := 0; i < extra && d < l.maxBackoff; i++ {
+		d *= 2
+	}
+
+	if d > l.maxBackoff {
+		return l.maxBackoff
+	}
+
+	return d
+}
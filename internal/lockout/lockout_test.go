@@ -0,0 +1,108 @@
+package lockout
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestLimiter(t *testing.T) {
+
+	Convey("Given a limiter", t, func() {
+
+		l := NewLimiter(3, 100*time.Millisecond, 400*time.Millisecond)
+
+		fail := func(key string) error {
+			done, err := l.Acquire(key)
+			if err != nil {
+				return err
+			}
+			done(false)
+			return nil
+		}
+
+		Convey("Then the key should be locked after the max failures", func() {
+			So(fail("a"), ShouldBeNil)
+			So(fail("a"), ShouldBeNil)
+			So(fail("a"), ShouldBeNil)
+			So(fail("a"), ShouldEqual, ErrLocked)
+
+			Convey("Then other keys should not be locked", func() {
+				So(fail("b"), ShouldBeNil)
+			})
+
+			Convey("Then the key should be unlocked after the backoff, and locked again on failure", func() {
+				time.Sleep(120 * time.Millisecond)
+				So(fail("a"), ShouldBeNil)
+				So(fail("a"), ShouldEqual, ErrLocked)
+
+				// the backoff has doubled.
+				time.Sleep(120 * time.Millisecond)
+				So(fail("a"), ShouldEqual, ErrLocked)
+				time.Sleep(100 * time.Millisecond)
+
+				done, err := l.Acquire("a")
+				So(err, ShouldBeNil)
+				done(true)
+
+				So(fail("a"), ShouldBeNil)
+				So(fail("a"), ShouldBeNil)
+			})
+		})
+
+		Convey("Then a success should reset the failures", func() {
+			So(fail("a"), ShouldBeNil)
+			So(fail("a"), ShouldBeNil)
+
+			done, err := l.Acquire("a")
+			So(err, ShouldBeNil)
+			done(true)
+
+			So(fail("a"), ShouldBeNil)
+			So(fail("a"), ShouldBeNil)
+			So(fail("a"), ShouldBeNil)
+			So(fail("a"), ShouldEqual, ErrLocked)
+		})
+
+		Convey("Then concurrent attempts should not exceed the remaining ones", func() {
+			So(fail("a"), ShouldBeNil)
+
+			done1, err := l.Acquire("a")
+			So(err, ShouldBeNil)
+			done2, err := l.Acquire("a")
+			So(err, ShouldBeNil)
+
+			_, err = l.Acquire("a")
+			So(err, ShouldEqual, ErrLocked)
+
+			done1(false)
+			done1(false)
+			done2(true)
+
+			So(fail("a"), ShouldBeNil)
+		})
+	})
+}
+
+func TestLimiter_lockDuration(t *testing.T) {
+
+	l := NewLimiter(5, time.Second, 10*time.Second)
+
+	tests := []struct {
+		extra int
+		want  time.Duration
+	}{
+		{0, time.Second},
+		{1, 2 * time.Second},
+		{3, 8 * time.Second},
+		{4, 10 * time.Second},
+		{100, 10 * time.Second},
+	}
+
+	for _, tt := range tests {
+		if got := l.lockDuration(tt.extra); got != tt.want {
+			t.Errorf("lockDuration(%d) = %v, want %v", tt.extra, got, tt.want)
+		}
+	}
+}
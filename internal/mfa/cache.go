@@ -0,0 +1,56 @@
+package mfa
+
+import (
+	"context"
+	"time"
+
+	"go.aporeto.io/manipulate"
+	"go.aporeto.io/manipulate/manipmongo"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// CacheCollection is the name of the collection
+// holding the pending WebAuthn ceremonies.
+const CacheCollection = "mfacache"
+
+// CacheItem represents a pending WebAuthn ceremony.
+type CacheItem struct {
+	Challenge string    `bson:"challenge"`
+	Namespace string    `bson:"namespace"`
+	Subject   []string  `bson:"subject"`
+	Name      string    `bson:"name"`
+	TokenID   string    `bson:"tokenid"`
+	Time      time.Time `bson:"time"`
+}
+
+// Set sets the given CacheItem in the database.
+func Set(m manipulate.Manipulator, item *CacheItem) error {
+
+	item.Time = time.Now()
+
+	db := manipmongo.GetDatabase(m)
+
+	collection := db.Collection(CacheCollection)
+	_, err := collection.InsertOne(context.TODO(), item)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Pop gets and deletes the item with the given challenge, so
+// a response to a ceremony can only be verified once.
+// If none is found, it will return an error.
+func Pop(m manipulate.Manipulator, challenge string) (*CacheItem, error) {
+
+	db := manipmongo.GetDatabase(m)
+
+	item := &CacheItem{}
+	collection := db.Collection(CacheCollection)
+	filter := bson.M{"challenge": challenge}
+	err := collection.FindOneAndDelete(context.TODO(), filter).Decode(item)
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
@@ -0,0 +1,63 @@
+package mfa
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.aporeto.io/a3s/pkgs/api"
+	"go.aporeto.io/manipulate"
+	"go.aporeto.io/manipulate/manipmongo"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ErrCredentialUsed is returned when a credential has been
+// used by another request since it has been retrieved.
+var ErrCredentialUsed = errors.New("mfa credential has been used concurrently")
+
+// UseTOTP records the TOTP credential with the given ID has been used
+// to validate the code of the given step. The credential is only updated
+// if its last step is still the given lastStep, so a code can only be
+// used once. Otherwise, ErrCredentialUsed is returned.
+func UseTOTP(ctx context.Context, m manipulate.Manipulator, id string, lastStep int, step int) error {
+
+	return useCredential(ctx, m, id, "totplaststep", lastStep, step)
+}
+
+// UseWebAuthn records the WebAuthn credential with the given ID has been
+// used, and its authenticator returned the given signature counter. The
+// credential is only updated if its counter is still the given signCount.
+// Otherwise, ErrCredentialUsed is returned.
+func UseWebAuthn(ctx context.Context, m manipulate.Manipulator, id string, signCount int, count int) error {
+
+	return useCredential(ctx, m, id, "webauthnsigncount", signCount, count)
+}
+
+func useCredential(ctx context.Context, m manipulate.Manipulator, id string, key string, previous int, next int) error {
+
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid mfa credential id '%s': %w", id, err)
+	}
+
+	now := time.Now().Round(time.Millisecond)
+
+	collection := manipmongo.GetDatabase(m).Collection(api.MFACredentialIdentity.Name)
+
+	res, err := collection.UpdateOne(
+		ctx,
+		bson.M{"_id": oid, key: previous},
+		bson.M{"$set": bson.M{key: next, "lastusedtime": now, "updatetime": now}},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to update mfa credential: %w", err)
+	}
+
+	if res.MatchedCount != 1 {
+		return ErrCredentialUsed
+	}
+
+	return nil
+}
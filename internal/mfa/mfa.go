@@ -0,0 +1,179 @@
+package mfa
+
+import (
+	"errors"
+	"strings"
+
+	"go.aporeto.io/a3s/pkgs/token"
+)
+
+// Claims added to the tokens upgraded using a second factor.
+const (
+	ClaimPrefix   = "@mfa="
+	ClaimTOTP     = ClaimPrefix + "totp"
+	ClaimWebAuthn = ClaimPrefix + "webauthn"
+)
+
+// Authentication method references added to the amr
+// claim of the tokens upgraded using a second factor,
+// as registered by RFC 8176.
+const (
+	AMRMFA = "mfa"
+	AMROTP = "otp"
+	AMRHWK = "hwk"
+)
+
+// userKeys maps the types of the sources supporting second
+// factors to the key of the claim identifying their users.
+var userKeys = map[string]string{
+	"local": "username",
+	"ldap":  "dn",
+	"oidc":  "sub",
+	"jwt":   "sub",
+	"saml":  "nameid",
+	"mtls":  "fingerprint",
+}
+
+// ErrNoUser is returned when the claims do not identify a single
+// user of a source supporting second factors. This is the case of
+// cloaked tokens that do not contain the claim identifying the user.
+var ErrNoUser = errors.New("the claims do not identify a single user of a source supporting second factors")
+
+// Subject returns the claims a second factor is bound to from the given
+// identity claims. They identify a single user: they are the @source
+// claims, and the claim identifying the user in the source, so two
+// users never share a subject. It returns ErrNoUser if the claims do
+// not contain exactly one of each.
+func Subject(claims []string) ([]string, error) {
+
+	values := map[string][]string{}
+	for _, c := range claims {
+		if key, value, ok := strings.Cut(c, "="); ok {
+			values[key] = append(values[key], value)
+		}
+	}
+
+	single := func(key string) (string, bool) {
+		if v := values[key]; len(v) == 1 && v[0] != "" {
+			return v[0], true
+		}
+		return "", false
+	}
+
+	typ, ok := single("@source:type")
+	if !ok {
+		return nil, ErrNoUser
+	}
+
+	userKey, ok := userKeys[typ]
+	if !ok {
+		return nil, ErrNoUser
+	}
+
+	subject := make([]string, 0, 4)
+	for _, key := range []string{"@source:name", "@source:namespace", "@source:type", userKey} {
+		value, ok := single(key)
+		if !ok {
+			return nil, ErrNoUser
+		}
+		subject = append(subject, key+"="+value)
+	}
+
+	return subject, nil
+}
+
+// TokenSubject returns the Subject of the given token, whose reserved
+// claims have been stripped. The @source claims are computed from its
+// source, like they are when the token is signed.
+func TokenSubject(idt *token.IdentityToken) ([]string, error) {
+
+	claims := append([]string{}, idt.Identity...)
+	claims = append(claims, "@source:type="+idt.Source.Type)
+
+	if idt.Source.Namespace != "" {
+		claims = append(claims, "@source:namespace="+idt.Source.Namespace)
+	}
+
+	if idt.Source.Name != "" {
+		claims = append(claims, "@source:name="+idt.Source.Name)
+	}
+
+	return Subject(claims)
+}
+
+// SameSubject returns true if both subjects
+// are equal. An empty subject matches nothing.
+func SameSubject(a []string, b []string) bool {
+
+	if len(a) == 0 || len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// HasClaim returns true if the given claims contain an @mfa claim.
+func HasClaim(claims []string) bool {
+
+	for _, c := range claims {
+		if strings.HasPrefix(c, ClaimPrefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// StripClaims returns the given claims without the @mfa claims.
+// They must be removed from the claims of tokens that have not
+// been issued by a3s, so they cannot be forged.
+func StripClaims(claims []string) []string {
+
+	if !HasClaim(claims) {
+		return claims
+	}
+
+	out := make([]string, 0, len(claims))
+	for _, c := range claims {
+		if strings.HasPrefix(c, ClaimPrefix) {
+			continue
+		}
+		out = append(out, c)
+	}
+
+	return out
+}
+
+// AddClaim adds the given @mfa claim and authentication method
+// references to the given claims and amr, if not already present.
+func AddClaim(claims []string, amr []string, claim string, methods ...string) ([]string, []string) {
+
+	if !contains(claims, claim) {
+		claims = append(claims, claim)
+	}
+
+	for _, m := range append(methods, AMRMFA) {
+		if !contains(amr, m) {
+			amr = append(amr, m)
+		}
+	}
+
+	return claims, amr
+}
+
+func contains(list []string, value string) bool {
+
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,220 @@
+package mfa
+
+import (
+	"reflect"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.aporeto.io/a3s/pkgs/token"
+)
+
+func TestSubject(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		claims  []string
+		want    []string
+		wantErr bool
+	}{
+		{
+			"local user",
+			[]string{
+				"username=joe",
+				"@source:type=local",
+				"@source:namespace=/my/ns",
+				"@source:name=my-src",
+				"@issuer=https://a3s.com",
+				"@actor:username=admin",
+				"@mfa=totp",
+				"team=blue",
+			},
+			[]string{
+				"@source:name=my-src",
+				"@source:namespace=/my/ns",
+				"@source:type=local",
+				"username=joe",
+			},
+			false,
+		},
+		{
+			"oidc user",
+			[]string{"sub=1234", "email=joe@a3s.com", "@source:type=oidc", "@source:namespace=/", "@source:name=google"},
+			[]string{"@source:name=google", "@source:namespace=/", "@source:type=oidc", "sub=1234"},
+			false,
+		},
+		{
+			"cloaked token",
+			[]string{"@source:type=local", "@source:namespace=/my/ns", "@source:name=my-src", "@issuer=https://a3s.com"},
+			nil,
+			true,
+		},
+		{
+			"missing user key",
+			[]string{"team=blue", "@source:type=ldap", "@source:namespace=/my/ns", "@source:name=my-src"},
+			nil,
+			true,
+		},
+		{
+			"empty user key",
+			[]string{"dn=", "@source:type=ldap", "@source:namespace=/my/ns", "@source:name=my-src"},
+			nil,
+			true,
+		},
+		{
+			"multiple user keys",
+			[]string{"username=joe", "username=jim", "@source:type=local", "@source:namespace=/my/ns", "@source:name=my-src"},
+			nil,
+			true,
+		},
+		{
+			"multiple source names",
+			[]string{"username=joe", "@source:type=local", "@source:namespace=/my/ns", "@source:name=a", "@source:name=b"},
+			nil,
+			true,
+		},
+		{
+			"missing source namespace",
+			[]string{"username=joe", "@source:type=local", "@source:name=my-src"},
+			nil,
+			true,
+		},
+		{
+			"unsupported source type",
+			[]string{"username=joe", "@source:type=http", "@source:namespace=/my/ns", "@source:name=my-src"},
+			nil,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Subject(tt.claims)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Subject() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err != ErrNoUser {
+				t.Errorf("Subject() error = %v, want %v", err, ErrNoUser)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Subject() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTokenSubject(t *testing.T) {
+
+	Convey("Given an identity token", t, func() {
+
+		idt := token.NewIdentityToken(token.Source{
+			Type:      "local",
+			Namespace: "/my/ns",
+			Name:      "my-src",
+		})
+
+		Convey("Calling TokenSubject should add the @source claims", func() {
+
+			idt.Identity = []string{"username=joe", "@mfa=totp"}
+
+			subject, err := TokenSubject(idt)
+			So(err, ShouldBeNil)
+			So(subject, ShouldResemble, []string{
+				"@source:name=my-src",
+				"@source:namespace=/my/ns",
+				"@source:type=local",
+				"username=joe",
+			})
+		})
+
+		Convey("Calling TokenSubject on a cloaked token should fail", func() {
+
+			idt.Identity = []string{"team=blue"}
+
+			subject, err := TokenSubject(idt)
+			So(err, ShouldEqual, ErrNoUser)
+			So(subject, ShouldBeNil)
+		})
+	})
+}
+
+func TestSameSubject(t *testing.T) {
+
+	tests := []struct {
+		name string
+		a    []string
+		b    []string
+		want bool
+	}{
+		{
+			"same subjects",
+			[]string{"a=a", "b=b"},
+			[]string{"a=a", "b=b"},
+			true,
+		},
+		{
+			"more claims",
+			[]string{"a=a", "b=b"},
+			[]string{"a=a", "b=b", "c=c"},
+			false,
+		},
+		{
+			"less claims",
+			[]string{"a=a", "b=b"},
+			[]string{"a=a"},
+			false,
+		},
+		{
+			"different claim",
+			[]string{"a=a", "b=b"},
+			[]string{"a=a", "c=c"},
+			false,
+		},
+		{
+			"empty subjects",
+			nil,
+			[]string{},
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SameSubject(tt.a, tt.b); got != tt.want {
+				t.Errorf("SameSubject() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStripClaims(t *testing.T) {
+
+	Convey("Calling StripClaims without @mfa claim should return the claims", t, func() {
+		claims := []string{"a=a", "@source:type=mtls"}
+		So(HasClaim(claims), ShouldBeFalse)
+		So(StripClaims(claims), ShouldResemble, claims)
+	})
+
+	Convey("Calling StripClaims with @mfa claims should remove them", t, func() {
+		claims := []string{"a=a", "@mfa=totp", "@mfa=webauthn", "b=b"}
+		So(HasClaim(claims), ShouldBeTrue)
+		So(StripClaims(claims), ShouldResemble, []string{"a=a", "b=b"})
+	})
+}
+
+func TestAddClaim(t *testing.T) {
+
+	Convey("Calling AddClaim should add the claim and the methods once", t, func() {
+
+		claims, amr := AddClaim([]string{"a=a"}, nil, ClaimTOTP, AMROTP)
+		So(claims, ShouldResemble, []string{"a=a", "@mfa=totp"})
+		So(amr, ShouldResemble, []string{"otp", "mfa"})
+
+		claims, amr = AddClaim(claims, amr, ClaimWebAuthn, AMRHWK)
+		So(claims, ShouldResemble, []string{"a=a", "@mfa=totp", "@mfa=webauthn"})
+		So(amr, ShouldResemble, []string{"otp", "mfa", "hwk"})
+
+		claims, amr = AddClaim(claims, amr, ClaimTOTP, AMROTP)
+		So(claims, ShouldResemble, []string{"a=a", "@mfa=totp", "@mfa=webauthn"})
+		So(amr, ShouldResemble, []string{"otp", "mfa", "hwk"})
+	})
+}
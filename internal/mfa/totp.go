@@ -0,0 +1,138 @@
+package mfa
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" // #nosec
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Parameters of the TOTP codes, as described by RFC 6238.
+// They are the defaults of all authenticator apps.
+const (
+	totpSecretLen = 20
+	totpDigits    = 6
+	totpPeriod    = 30
+
+	// totpSkew is the number of periods before and after the
+	// current one during which a code is accepted, to tolerate
+	// clock drifts and delays.
+	totpSkew = 1
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateTOTPSecret returns a new random
+// base32 encoded TOTP secret.
+func GenerateTOTPSecret() (string, error) {
+
+	secret := make([]byte, totpSecretLen)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("unable to generate totp secret: %w", err)
+	}
+
+	return totpEncoding.EncodeToString(secret), nil
+}
+
+// TOTPURI returns the otpauth URI to register the given
+// secret in an authenticator app, usually displayed as a QR code.
+func TOTPURI(issuer string, account string, secret string) string {
+
+	v := url.Values{}
+	v.Set("secret", secret)
+	v.Set("issuer", issuer)
+	v.Set("algorithm", "SHA1")
+	v.Set("digits", fmt.Sprintf("%d", totpDigits))
+	v.Set("period", fmt.Sprintf("%d", totpPeriod))
+
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + account,
+		RawQuery: v.Encode(),
+	}
+
+	return u.String()
+}
+
+// TOTPCode returns the TOTP code of the given
+// base32 encoded secret at the given time.
+func TOTPCode(secret string, t time.Time) (string, error) {
+
+	key, err := decodeTOTPSecret(secret)
+	if err != nil {
+		return "", err
+	}
+
+	return totpCode(key, t.Unix()/totpPeriod), nil
+}
+
+// ValidateTOTP verifies the given code against the given base32
+// encoded secret at the given time. Codes of the periods before
+// and after the current one are also accepted. To prevent replays,
+// codes of periods up to lastStep are refused. It returns the period
+// of the code, which must be stored as the next lastStep.
+func ValidateTOTP(secret string, code string, t time.Time, lastStep int64) (int64, bool, error) {
+
+	key, err := decodeTOTPSecret(secret)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if len(code) != totpDigits {
+		return 0, false, nil
+	}
+
+	current := t.Unix() / totpPeriod
+
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+
+		if step <= lastStep {
+			continue
+		}
+
+		if subtle.ConstantTimeCompare([]byte(totpCode(key, step)), []byte(code)) == 1 {
+			return step, true, nil
+		}
+	}
+
+	return 0, false, nil
+}
+
+func decodeTOTPSecret(secret string) ([]byte, error) {
+
+	key, err := totpEncoding.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil {
+		return nil, fmt.Errorf("invalid totp secret: %w", err)
+	}
+
+	return key, nil
+}
+
+// totpCode computes the HOTP code of the given
+// counter, as described by RFC 4226.
+func totpCode(key []byte, counter int64) string {
+
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, uint64(counter))
+
+	mac := hmac.New(sha1.New, key)
+	_, _ = mac.Write(msg)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < totpDigits; i++ {
+		mod *= 10
+	}
+
+	return fmt.Sprintf("%0*d", totpDigits, value%mod)
+}
@@ -0,0 +1,122 @@
+package mfa
+
+import (
+	"encoding/base32"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTOTPCode(t *testing.T) {
+
+	// Test vectors of RFC 6238, appendix B, truncated to 6 digits.
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+	tests := []struct {
+		time int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+
+	for _, tt := range tests {
+		code, err := TOTPCode(secret, time.Unix(tt.time, 0))
+		if err != nil {
+			t.Fatalf("TOTPCode() error = %v", err)
+		}
+		if code != tt.want {
+			t.Errorf("TOTPCode(%d) = %s, want %s", tt.time, code, tt.want)
+		}
+	}
+}
+
+func TestValidateTOTP(t *testing.T) {
+
+	Convey("Given a generated secret", t, func() {
+
+		secret, err := GenerateTOTPSecret()
+		So(err, ShouldBeNil)
+		So(len(secret), ShouldEqual, 32)
+
+		now := time.Unix(1700000000, 0)
+		code, err := TOTPCode(secret, now)
+		So(err, ShouldBeNil)
+
+		Convey("Then the current code should be valid", func() {
+			step, ok, err := ValidateTOTP(secret, code, now, 0)
+			So(err, ShouldBeNil)
+			So(ok, ShouldBeTrue)
+			So(step, ShouldEqual, now.Unix()/30)
+
+			Convey("Then it should not be valid again", func() {
+				_, ok, err := ValidateTOTP(secret, code, now, step)
+				So(err, ShouldBeNil)
+				So(ok, ShouldBeFalse)
+			})
+		})
+
+		Convey("Then the code should be valid during the next period", func() {
+			step, ok, err := ValidateTOTP(secret, code, now.Add(30*time.Second), 0)
+			So(err, ShouldBeNil)
+			So(ok, ShouldBeTrue)
+			So(step, ShouldEqual, now.Unix()/30)
+		})
+
+		Convey("Then the code should not be valid two periods later", func() {
+			_, ok, err := ValidateTOTP(secret, code, now.Add(time.Minute), 0)
+			So(err, ShouldBeNil)
+			So(ok, ShouldBeFalse)
+		})
+
+		Convey("Then a wrong code should not be valid", func() {
+			_, ok, err := ValidateTOTP(secret, "12345", now, 0)
+			So(err, ShouldBeNil)
+			So(ok, ShouldBeFalse)
+
+			other, _ := GenerateTOTPSecret()
+			otherCode, _ := TOTPCode(other, now)
+			if otherCode != code {
+				_, ok, err = ValidateTOTP(secret, otherCode, now, 0)
+				So(err, ShouldBeNil)
+				So(ok, ShouldBeFalse)
+			}
+		})
+
+		Convey("Then the secret should be accepted in lower case and padded", func() {
+			_, ok, err := ValidateTOTP(strings.ToLower(base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))), "287082", time.Unix(59, 0), 0)
+			So(err, ShouldBeNil)
+			So(ok, ShouldBeTrue)
+		})
+	})
+
+	Convey("Validating against an invalid secret should fail", t, func() {
+		_, ok, err := ValidateTOTP("not base32!", "123456", time.Now(), 0)
+		So(ok, ShouldBeFalse)
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldStartWith, "invalid totp secret: ")
+	})
+}
+
+func TestTOTPURI(t *testing.T) {
+
+	Convey("Calling TOTPURI should work", t, func() {
+
+		u, err := url.Parse(TOTPURI("a3s", "my phone", "ABCDEF"))
+		So(err, ShouldBeNil)
+		So(u.Scheme, ShouldEqual, "otpauth")
+		So(u.Host, ShouldEqual, "totp")
+		So(u.Path, ShouldEqual, "/a3s:my phone")
+		So(u.Query().Get("secret"), ShouldEqual, "ABCDEF")
+		So(u.Query().Get("issuer"), ShouldEqual, "a3s")
+		So(u.Query().Get("digits"), ShouldEqual, "6")
+		So(u.Query().Get("period"), ShouldEqual, "30")
+	})
+}
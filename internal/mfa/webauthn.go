@@ -0,0 +1,598 @@
+package mfa
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ugorji/go/codec"
+)
+
+// COSE algorithms supported for WebAuthn credentials.
+const (
+	coseAlgES256 = -7
+	coseAlgEdDSA = -8
+	coseAlgRS256 = -257
+)
+
+// Flags of the authenticator data.
+const (
+	flagUserPresent       = 0x01
+	flagAttestedCredsData = 0x40
+	flagExtensionData     = 0x80
+)
+
+const (
+	webAuthnChallengeLen = 32
+	webAuthnTimeout      = 300000
+)
+
+// A WebAuthnCredential is a public key credential
+// registered by a WebAuthn authenticator.
+type WebAuthnCredential struct {
+
+	// The ID of the credential, chosen by the authenticator.
+	ID []byte
+
+	// The public key of the credential, PKIX encoded.
+	PublicKey []byte
+
+	// The signature counter of the authenticator.
+	SignCount uint32
+}
+
+// A WebAuthnResponse is a PublicKeyCredential returned by
+// navigator.credentials.create() or navigator.credentials.get(),
+// JSON encoded using base64url for the binary fields.
+type WebAuthnResponse struct {
+	ID       string `json:"id"`
+	RawID    string `json:"rawId"`
+	Type     string `json:"type"`
+	Response struct {
+		ClientDataJSON    string `json:"clientDataJSON"`
+		AttestationObject string `json:"attestationObject,omitempty"`
+		AuthenticatorData string `json:"authenticatorData,omitempty"`
+		Signature         string `json:"signature,omitempty"`
+		UserHandle        string `json:"userHandle,omitempty"`
+	} `json:"response"`
+
+	clientData     clientData
+	clientDataJSON []byte
+	rawID          []byte
+}
+
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+// ParseWebAuthnResponse parses the given JSON encoded WebAuthnResponse.
+// The response is not verified, but its challenge can be used to retrieve
+// the ceremony it belongs to.
+func ParseWebAuthnResponse(data string) (*WebAuthnResponse, error) {
+
+	resp := &WebAuthnResponse{}
+	if err := json.Unmarshal([]byte(data), resp); err != nil {
+		return nil, fmt.Errorf("unable to decode webauthn response: %w", err)
+	}
+
+	if resp.Type != "public-key" {
+		return nil, fmt.Errorf("invalid webauthn response type '%s'", resp.Type)
+	}
+
+	var err error
+	if resp.rawID, err = decodeBase64URL(resp.RawID); err != nil || len(resp.rawID) == 0 {
+		return nil, fmt.Errorf("invalid webauthn response raw id")
+	}
+
+	if resp.clientDataJSON, err = decodeBase64URL(resp.Response.ClientDataJSON); err != nil {
+		return nil, fmt.Errorf("invalid webauthn client data: %w", err)
+	}
+
+	if err := json.Unmarshal(resp.clientDataJSON, &resp.clientData); err != nil {
+		return nil, fmt.Errorf("unable to decode webauthn client data: %w", err)
+	}
+
+	return resp, nil
+}
+
+// Challenge returns the challenge signed by the authenticator.
+func (r *WebAuthnResponse) Challenge() string {
+	return strings.TrimRight(r.clientData.Challenge, "=")
+}
+
+// CredentialID returns the ID of the credential used by the authenticator.
+func (r *WebAuthnResponse) CredentialID() []byte {
+	return r.rawID
+}
+
+// NewWebAuthnChallenge returns a new random base64url encoded challenge.
+func NewWebAuthnChallenge() (string, error) {
+
+	challenge := make([]byte, webAuthnChallengeLen)
+	if _, err := rand.Read(challenge); err != nil {
+		return "", fmt.Errorf("unable to generate webauthn challenge: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(challenge), nil
+}
+
+// A RelyingParty creates and verifies WebAuthn ceremonies.
+// Attestation statements are not verified: any authenticator
+// can be registered, and only the possession of the private key
+// of the credential is checked.
+type RelyingParty struct {
+
+	// The relying party ID, which is the effective
+	// domain the credentials are scoped to.
+	ID string
+
+	// The name of the relying party, displayed by the authenticators.
+	Name string
+
+	// The origins the ceremonies are allowed from.
+	Origins []string
+}
+
+// CreationOptions returns the JSON encoded options to pass to
+// navigator.credentials.create() to register a new credential.
+func (rp *RelyingParty) CreationOptions(challenge string, userID []byte, userName string, exclude [][]byte) (string, error) {
+
+	type rpEntity struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+
+	type userEntity struct {
+		ID          string `json:"id"`
+		Name        string `json:"name"`
+		DisplayName string `json:"displayName"`
+	}
+
+	type credParam struct {
+		Type string `json:"type"`
+		Alg  int    `json:"alg"`
+	}
+
+	type authenticatorSelection struct {
+		ResidentKey      string `json:"residentKey"`
+		UserVerification string `json:"userVerification"`
+	}
+
+	type options struct {
+		Challenge              string                 `json:"challenge"`
+		RP                     rpEntity               `json:"rp"`
+		User                   userEntity             `json:"user"`
+		PubKeyCredParams       []credParam            `json:"pubKeyCredParams"`
+		Timeout                int                    `json:"timeout"`
+		ExcludeCredentials     []credentialDescriptor `json:"excludeCredentials,omitempty"`
+		AuthenticatorSelection authenticatorSelection `json:"authenticatorSelection"`
+		Attestation            string                 `json:"attestation"`
+	}
+
+	data, err := json.Marshal(struct {
+		PublicKey options `json:"publicKey"`
+	}{
+		PublicKey: options{
+			Challenge: challenge,
+			RP:        rpEntity{ID: rp.ID, Name: rp.Name},
+			User: userEntity{
+				ID:          base64.RawURLEncoding.EncodeToString(userID),
+				Name:        userName,
+				DisplayName: userName,
+			},
+			PubKeyCredParams: []credParam{
+				{Type: "public-key", Alg: coseAlgES256},
+				{Type: "public-key", Alg: coseAlgEdDSA},
+				{Type: "public-key", Alg: coseAlgRS256},
+			},
+			Timeout:            webAuthnTimeout,
+			ExcludeCredentials: makeCredentialDescriptors(exclude),
+			AuthenticatorSelection: authenticatorSelection{
+				ResidentKey:      "discouraged",
+				UserVerification: "preferred",
+			},
+			Attestation: "none",
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("unable to encode webauthn creation options: %w", err)
+	}
+
+	return string(data), nil
+}
+
+// RequestOptions returns the JSON encoded options to pass to
+// navigator.credentials.get() to use one of the given credentials.
+func (rp *RelyingParty) RequestOptions(challenge string, allow [][]byte) (string, error) {
+
+	type options struct {
+		Challenge        string                 `json:"challenge"`
+		RPID             string                 `json:"rpId"`
+		Timeout          int                    `json:"timeout"`
+		AllowCredentials []credentialDescriptor `json:"allowCredentials"`
+		UserVerification string                 `json:"userVerification"`
+	}
+
+	data, err := json.Marshal(struct {
+		PublicKey options `json:"publicKey"`
+	}{
+		PublicKey: options{
+			Challenge:        challenge,
+			RPID:             rp.ID,
+			Timeout:          webAuthnTimeout,
+			AllowCredentials: makeCredentialDescriptors(allow),
+			UserVerification: "preferred",
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("unable to encode webauthn request options: %w", err)
+	}
+
+	return string(data), nil
+}
+
+// VerifyRegistration verifies the given response to a registration
+// ceremony using the given challenge, and returns the registered credential.
+func (rp *RelyingParty) VerifyRegistration(resp *WebAuthnResponse, challenge string) (*WebAuthnCredential, error) {
+
+	if err := rp.verifyClientData(resp, "webauthn.create", challenge); err != nil {
+		return nil, err
+	}
+
+	raw, err := decodeBase64URL(resp.Response.AttestationObject)
+	if err != nil {
+		return nil, fmt.Errorf("invalid webauthn attestation object: %w", err)
+	}
+
+	att := struct {
+		Format   string `codec:"fmt"`
+		AuthData []byte `codec:"authData"`
+	}{}
+	if err := codec.NewDecoderBytes(raw, cborHandle).Decode(&att); err != nil {
+		return nil, fmt.Errorf("unable to decode webauthn attestation object: %w", err)
+	}
+
+	ad, err := rp.parseAuthenticatorData(att.AuthData)
+	if err != nil {
+		return nil, err
+	}
+
+	if ad.flags&flagAttestedCredsData == 0 {
+		return nil, fmt.Errorf("missing attested credential data")
+	}
+
+	if !bytes.Equal(ad.credentialID, resp.rawID) {
+		return nil, fmt.Errorf("attested credential id does not match the response id")
+	}
+
+	key, err := parseCOSEKey(ad.credentialPublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	pub, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode credential public key: %w", err)
+	}
+
+	return &WebAuthnCredential{
+		ID:        ad.credentialID,
+		PublicKey: pub,
+		SignCount: ad.signCount,
+	}, nil
+}
+
+// VerifyAssertion verifies the given response to an authentication
+// ceremony using the given challenge and credential. It returns the
+// new signature counter of the credential, which must be stored.
+func (rp *RelyingParty) VerifyAssertion(resp *WebAuthnResponse, challenge string, cred *WebAuthnCredential) (uint32, error) {
+
+	if err := rp.verifyClientData(resp, "webauthn.get", challenge); err != nil {
+		return 0, err
+	}
+
+	if !bytes.Equal(resp.rawID, cred.ID) {
+		return 0, fmt.Errorf("response id does not match the credential id")
+	}
+
+	authData, err := decodeBase64URL(resp.Response.AuthenticatorData)
+	if err != nil {
+		return 0, fmt.Errorf("invalid webauthn authenticator data: %w", err)
+	}
+
+	sig, err := decodeBase64URL(resp.Response.Signature)
+	if err != nil {
+		return 0, fmt.Errorf("invalid webauthn signature: %w", err)
+	}
+
+	ad, err := rp.parseAuthenticatorData(authData)
+	if err != nil {
+		return 0, err
+	}
+
+	key, err := x509.ParsePKIXPublicKey(cred.PublicKey)
+	if err != nil {
+		return 0, fmt.Errorf("unable to parse credential public key: %w", err)
+	}
+
+	clientDataHash := sha256.Sum256(resp.clientDataJSON)
+	signed := append(append([]byte{}, authData...), clientDataHash[:]...)
+	if err := verifySignature(key, signed, sig); err != nil {
+		return 0, err
+	}
+
+	// Authenticators that do not implement a
+	// signature counter always return 0.
+	if (ad.signCount != 0 || cred.SignCount != 0) && ad.signCount <= cred.SignCount {
+		return 0, fmt.Errorf("signature counter did not increase: the authenticator may have been cloned")
+	}
+
+	return ad.signCount, nil
+}
+
+func (rp *RelyingParty) verifyClientData(resp *WebAuthnResponse, typ string, challenge string) error {
+
+	if resp.clientData.Type != typ {
+		return fmt.Errorf("invalid client data type '%s', want '%s'", resp.clientData.Type, typ)
+	}
+
+	if challenge == "" || resp.Challenge() != strings.TrimRight(challenge, "=") {
+		return fmt.Errorf("invalid challenge")
+	}
+
+	for _, o := range rp.Origins {
+		if resp.clientData.Origin == o {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("origin '%s' is not allowed", resp.clientData.Origin)
+}
+
+type authenticatorData struct {
+	flags               byte
+	signCount           uint32
+	credentialID        []byte
+	credentialPublicKey []byte
+}
+
+func (rp *RelyingParty) parseAuthenticatorData(data []byte) (*authenticatorData, error) {
+
+	// rpIdHash (32) | flags (1) | signCount (4) | attestedCredentialData | extensions
+	if len(data) < 37 {
+		return nil, fmt.Errorf("invalid authenticator data: too short")
+	}
+
+	rpIDHash := sha256.Sum256([]byte(rp.ID))
+	if !bytes.Equal(data[:32], rpIDHash[:]) {
+		return nil, fmt.Errorf("invalid authenticator data: rp id hash mismatch")
+	}
+
+	ad := &authenticatorData{
+		flags:     data[32],
+		signCount: binary.BigEndian.Uint32(data[33:37]),
+	}
+
+	if ad.flags&flagUserPresent == 0 {
+		return nil, fmt.Errorf("invalid authenticator data: user not present")
+	}
+
+	rest := data[37:]
+
+	if ad.flags&flagAttestedCredsData != 0 {
+
+		// aaguid (16) | credentialIdLength (2) | credentialId | credentialPublicKey
+		if len(rest) < 18 {
+			return nil, fmt.Errorf("invalid authenticator data: truncated attested credential data")
+		}
+
+		l := int(binary.BigEndian.Uint16(rest[16:18]))
+		rest = rest[18:]
+		if l == 0 || len(rest) <= l {
+			return nil, fmt.Errorf("invalid authenticator data: truncated credential id")
+		}
+
+		ad.credentialID = rest[:l]
+		rest = rest[l:]
+
+		var key map[int64]any
+		n, err := decodeCBORItem(rest, &key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid authenticator data: unable to decode credential public key: %w", err)
+		}
+
+		ad.credentialPublicKey = rest[:n]
+		rest = rest[n:]
+	}
+
+	// The extensions are not used, but they must
+	// be a valid map so we know where they end.
+	if ad.flags&flagExtensionData != 0 {
+
+		var extensions map[string]any
+		n, err := decodeCBORItem(rest, &extensions)
+		if err != nil {
+			return nil, fmt.Errorf("invalid authenticator data: unable to decode extensions: %w", err)
+		}
+
+		rest = rest[n:]
+	}
+
+	if len(rest) != 0 {
+		return nil, fmt.Errorf("invalid authenticator data: %d trailing bytes", len(rest))
+	}
+
+	return ad, nil
+}
+
+// parseCOSEKey parses the given COSE encoded public
+// key, as described by RFC 9053.
+func parseCOSEKey(data []byte) (crypto.PublicKey, error) {
+
+	var m map[int64]any
+	if err := codec.NewDecoderBytes(data, cborHandle).Decode(&m); err != nil {
+		return nil, fmt.Errorf("unable to decode credential public key: %w", err)
+	}
+
+	alg, _ := coseInt(m[3])
+
+	switch alg {
+
+	case coseAlgES256:
+
+		if crv, _ := coseInt(m[-1]); crv != 1 {
+			return nil, fmt.Errorf("unsupported ES256 curve %d", crv)
+		}
+
+		x, _ := m[-2].([]byte)
+		y, _ := m[-3].([]byte)
+		if len(x) != 32 || len(y) != 32 {
+			return nil, fmt.Errorf("invalid ES256 public key")
+		}
+
+		point := append(append([]byte{0x04}, x...), y...)
+		if _, err := ecdh.P256().NewPublicKey(point); err != nil {
+			return nil, fmt.Errorf("invalid ES256 public key: %w", err)
+		}
+
+		return &ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}, nil
+
+	case coseAlgEdDSA:
+
+		if crv, _ := coseInt(m[-1]); crv != 6 {
+			return nil, fmt.Errorf("unsupported EdDSA curve %d", crv)
+		}
+
+		x, _ := m[-2].([]byte)
+		if len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid EdDSA public key")
+		}
+
+		return ed25519.PublicKey(x), nil
+
+	case coseAlgRS256:
+
+		n, _ := m[-1].([]byte)
+		e, _ := m[-2].([]byte)
+		if len(e) == 0 || len(e) > 4 {
+			return nil, fmt.Errorf("invalid RS256 public key")
+		}
+
+		key := &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+
+		if key.N.BitLen() < 2048 {
+			return nil, fmt.Errorf("RS256 public key must be at least 2048 bits")
+		}
+
+		return key, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported credential algorithm %d", alg)
+	}
+}
+
+func verifySignature(key crypto.PublicKey, data []byte, sig []byte) error {
+
+	var ok bool
+
+	switch k := key.(type) {
+
+	case *ecdsa.PublicKey:
+		digest := sha256.Sum256(data)
+		ok = ecdsa.VerifyASN1(k, digest[:], sig)
+
+	case *rsa.PublicKey:
+		digest := sha256.Sum256(data)
+		ok = rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], sig) == nil
+
+	case ed25519.PublicKey:
+		ok = ed25519.Verify(k, data, sig)
+
+	default:
+		return fmt.Errorf("unsupported credential public key type %T", key)
+	}
+
+	if !ok {
+		return fmt.Errorf("invalid webauthn signature")
+	}
+
+	return nil
+}
+
+type credentialDescriptor struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+func makeCredentialDescriptors(ids [][]byte) []credentialDescriptor {
+
+	out := make([]credentialDescriptor, len(ids))
+	for i, id := range ids {
+		out[i] = credentialDescriptor{
+			Type: "public-key",
+			ID:   base64.RawURLEncoding.EncodeToString(id),
+		}
+	}
+
+	return out
+}
+
+func coseInt(v any) (int64, bool) {
+
+	switch i := v.(type) {
+	case int64:
+		return i, true
+	case uint64:
+		return int64(i), true
+	default:
+		return 0, false
+	}
+}
+
+var cborHandle = func() *codec.CborHandle {
+	h := &codec.CborHandle{}
+	h.SignedInteger = true
+	return h
+}()
+
+// decodeCBORItem decodes the first CBOR item of the given data into
+// v, and returns the number of bytes it has been decoded from.
+func decodeCBORItem(data []byte, v any) (int, error) {
+
+	if len(data) == 0 {
+		return 0, fmt.Errorf("unexpected end of data")
+	}
+
+	dec := codec.NewDecoderBytes(data, cborHandle)
+	if err := dec.Decode(v); err != nil {
+		return 0, err
+	}
+
+	return dec.NumBytesRead(), nil
+}
+
+func decodeBase64URL(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+}
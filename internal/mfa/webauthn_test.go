@@ -0,0 +1,491 @@
+package mfa
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/ugorji/go/codec"
+)
+
+// A softAuthenticator is a software WebAuthn authenticator.
+type softAuthenticator struct {
+	rpID         string
+	origin       string
+	credentialID []byte
+	key          crypto.Signer
+	alg          int
+	signCount    uint32
+}
+
+func newSoftAuthenticator(rpID string, origin string, alg int) *softAuthenticator {
+
+	a := &softAuthenticator{
+		rpID:         rpID,
+		origin:       origin,
+		credentialID: make([]byte, 16),
+		alg:          alg,
+	}
+
+	_, _ = rand.Read(a.credentialID)
+
+	switch alg {
+	case coseAlgES256:
+		a.key, _ = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case coseAlgEdDSA:
+		_, a.key, _ = ed25519.GenerateKey(rand.Reader)
+	case coseAlgRS256:
+		a.key, _ = rsa.GenerateKey(rand.Reader, 2048)
+	}
+
+	return a
+}
+
+func (a *softAuthenticator) coseKey() []byte {
+
+	var m map[int]any
+
+	switch k := a.key.Public().(type) {
+	case *ecdsa.PublicKey:
+		size := (k.Curve.Params().BitSize + 7) / 8
+		m = map[int]any{1: 2, 3: a.alg, -1: 1, -2: k.X.FillBytes(make([]byte, size)), -3: k.Y.FillBytes(make([]byte, size))}
+	case ed25519.PublicKey:
+		m = map[int]any{1: 1, 3: a.alg, -1: 6, -2: []byte(k)}
+	case *rsa.PublicKey:
+		e := make([]byte, 4)
+		binary.BigEndian.PutUint32(e, uint32(k.E))
+		m = map[int]any{1: 3, 3: a.alg, -1: k.N.Bytes(), -2: e[1:]}
+	}
+
+	return encodeCBOR(m)
+}
+
+func (a *softAuthenticator) authData(flags byte, attested bool) []byte {
+
+	rpIDHash := sha256.Sum256([]byte(a.rpID))
+
+	data := append([]byte{}, rpIDHash[:]...)
+	data = append(data, flags)
+	data = binary.BigEndian.AppendUint32(data, a.signCount)
+
+	if attested {
+		data = append(data, make([]byte, 16)...)
+		data = binary.BigEndian.AppendUint16(data, uint16(len(a.credentialID)))
+		data = append(data, a.credentialID...)
+		data = append(data, a.coseKey()...)
+	}
+
+	return data
+}
+
+func (a *softAuthenticator) clientData(typ string, challenge string) []byte {
+
+	data, _ := json.Marshal(map[string]any{
+		"type":        typ,
+		"challenge":   challenge,
+		"origin":      a.origin,
+		"crossOrigin": false,
+	})
+
+	return data
+}
+
+func (a *softAuthenticator) create(challenge string) string {
+
+	authData := a.authData(flagUserPresent|flagAttestedCredsData, true)
+
+	return a.response(map[string]string{
+		"clientDataJSON": b64(a.clientData("webauthn.create", challenge)),
+		"attestationObject": b64(encodeCBOR(map[string]any{
+			"fmt":      "none",
+			"attStmt":  map[string]any{},
+			"authData": authData,
+		})),
+	})
+}
+
+func (a *softAuthenticator) get(challenge string) string {
+
+	a.signCount++
+
+	authData := a.authData(flagUserPresent, false)
+	clientData := a.clientData("webauthn.get", challenge)
+
+	return a.response(map[string]string{
+		"clientDataJSON":    b64(clientData),
+		"authenticatorData": b64(authData),
+		"signature":         b64(a.sign(authData, clientData)),
+	})
+}
+
+func (a *softAuthenticator) sign(authData []byte, clientData []byte) []byte {
+
+	clientDataHash := sha256.Sum256(clientData)
+	data := append(append([]byte{}, authData...), clientDataHash[:]...)
+
+	if _, ok := a.key.(ed25519.PrivateKey); ok {
+		sig, _ := a.key.Sign(rand.Reader, data, crypto.Hash(0))
+		return sig
+	}
+
+	digest := sha256.Sum256(data)
+	sig, _ := a.key.Sign(rand.Reader, digest[:], crypto.SHA256)
+
+	return sig
+}
+
+func (a *softAuthenticator) response(response map[string]string) string {
+
+	data, _ := json.Marshal(map[string]any{
+		"id":       b64(a.credentialID),
+		"rawId":    b64(a.credentialID),
+		"type":     "public-key",
+		"response": response,
+	})
+
+	return string(data)
+}
+
+func encodeCBOR(v any) []byte {
+
+	var out []byte
+	if err := codec.NewEncoderBytes(&out, &codec.CborHandle{}).Encode(v); err != nil {
+		panic(err)
+	}
+
+	return out
+}
+
+func b64(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func TestParseWebAuthnResponse(t *testing.T) {
+
+	Convey("Parsing invalid responses should fail", t, func() {
+
+		_, err := ParseWebAuthnResponse("{")
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldStartWith, "unable to decode webauthn response: ")
+
+		_, err = ParseWebAuthnResponse(`{"type":"password"}`)
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldEqual, "invalid webauthn response type 'password'")
+
+		_, err = ParseWebAuthnResponse(`{"type":"public-key"}`)
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldEqual, "invalid webauthn response raw id")
+
+		_, err = ParseWebAuthnResponse(`{"type":"public-key","rawId":"AQ","response":{"clientDataJSON":"e30"}}`)
+		So(err, ShouldBeNil)
+
+		_, err = ParseWebAuthnResponse(`{"type":"public-key","rawId":"AQ","response":{"clientDataJSON":"bm9wZQ"}}`)
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldStartWith, "unable to decode webauthn client data: ")
+	})
+}
+
+func TestWebAuthn(t *testing.T) {
+
+	rp := &RelyingParty{
+		ID:      "a3s.com",
+		Name:    "a3s",
+		Origins: []string{"https://a3s.com"},
+	}
+
+	for _, alg := range []int{coseAlgES256, coseAlgEdDSA, coseAlgRS256} {
+
+		Convey("Given a software authenticator using algorithm "+algName(alg), t, func() {
+
+			a := newSoftAuthenticator("a3s.com", "https://a3s.com", alg)
+
+			challenge, err := NewWebAuthnChallenge()
+			So(err, ShouldBeNil)
+
+			opts, err := rp.CreationOptions(challenge, []byte("user"), "joe", [][]byte{[]byte("other")})
+			So(err, ShouldBeNil)
+			So(opts, ShouldContainSubstring, `"challenge":"`+challenge+`"`)
+			So(opts, ShouldContainSubstring, `"rp":{"id":"a3s.com","name":"a3s"}`)
+			So(opts, ShouldContainSubstring, `"excludeCredentials":[{"type":"public-key","id":"b3RoZXI"}]`)
+
+			resp, err := ParseWebAuthnResponse(a.create(challenge))
+			So(err, ShouldBeNil)
+			So(resp.Challenge(), ShouldEqual, challenge)
+			So(resp.CredentialID(), ShouldResemble, a.credentialID)
+
+			Convey("When I verify the registration", func() {
+
+				cred, err := rp.VerifyRegistration(resp, challenge)
+				So(err, ShouldBeNil)
+				So(cred.ID, ShouldResemble, a.credentialID)
+				So(cred.SignCount, ShouldEqual, 0)
+
+				Convey("Then verifying an assertion should work", func() {
+
+					challenge, _ := NewWebAuthnChallenge()
+
+					opts, err := rp.RequestOptions(challenge, [][]byte{cred.ID})
+					So(err, ShouldBeNil)
+					So(opts, ShouldContainSubstring, `"rpId":"a3s.com"`)
+					So(opts, ShouldContainSubstring, b64(cred.ID))
+
+					resp, err := ParseWebAuthnResponse(a.get(challenge))
+					So(err, ShouldBeNil)
+
+					count, err := rp.VerifyAssertion(resp, challenge, cred)
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, 1)
+				})
+
+				Convey("Then verifying an assertion with a different challenge should fail", func() {
+
+					challenge, _ := NewWebAuthnChallenge()
+					other, _ := NewWebAuthnChallenge()
+
+					resp, err := ParseWebAuthnResponse(a.get(other))
+					So(err, ShouldBeNil)
+
+					_, err = rp.VerifyAssertion(resp, challenge, cred)
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldEqual, "invalid challenge")
+				})
+
+				Convey("Then verifying an assertion with a tampered signature should fail", func() {
+
+					challenge, _ := NewWebAuthnChallenge()
+
+					resp, err := ParseWebAuthnResponse(a.get(challenge))
+					So(err, ShouldBeNil)
+					resp.Response.Signature = b64([]byte("nope"))
+
+					_, err = rp.VerifyAssertion(resp, challenge, cred)
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldEqual, "invalid webauthn signature")
+				})
+
+				Convey("Then verifying an assertion from a cloned authenticator should fail", func() {
+
+					challenge, _ := NewWebAuthnChallenge()
+
+					resp, err := ParseWebAuthnResponse(a.get(challenge))
+					So(err, ShouldBeNil)
+					cred.SignCount = 1
+
+					_, err = rp.VerifyAssertion(resp, challenge, cred)
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldEqual, "signature counter did not increase: the authenticator may have been cloned")
+				})
+
+				Convey("Then verifying an assertion of another credential should fail", func() {
+
+					challenge, _ := NewWebAuthnChallenge()
+					other := newSoftAuthenticator("a3s.com", "https://a3s.com", alg)
+
+					resp, err := ParseWebAuthnResponse(other.get(challenge))
+					So(err, ShouldBeNil)
+
+					_, err = rp.VerifyAssertion(resp, challenge, cred)
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldEqual, "response id does not match the credential id")
+				})
+			})
+
+			Convey("When I verify the registration with the wrong challenge", func() {
+				other, _ := NewWebAuthnChallenge()
+				_, err := rp.VerifyRegistration(resp, other)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "invalid challenge")
+			})
+
+			Convey("When I verify the registration as an assertion", func() {
+				_, err := rp.VerifyAssertion(resp, challenge, &WebAuthnCredential{ID: a.credentialID})
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "invalid client data type 'webauthn.create', want 'webauthn.get'")
+			})
+		})
+	}
+
+	Convey("Given a software authenticator on another origin", t, func() {
+
+		a := newSoftAuthenticator("a3s.com", "https://evil.com", coseAlgES256)
+		challenge, _ := NewWebAuthnChallenge()
+
+		resp, err := ParseWebAuthnResponse(a.create(challenge))
+		So(err, ShouldBeNil)
+
+		_, err = rp.VerifyRegistration(resp, challenge)
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldEqual, "origin 'https://evil.com' is not allowed")
+	})
+
+	Convey("Given a software authenticator for another relying party", t, func() {
+
+		a := newSoftAuthenticator("evil.com", "https://a3s.com", coseAlgES256)
+		challenge, _ := NewWebAuthnChallenge()
+
+		resp, err := ParseWebAuthnResponse(a.create(challenge))
+		So(err, ShouldBeNil)
+
+		_, err = rp.VerifyRegistration(resp, challenge)
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldEqual, "invalid authenticator data: rp id hash mismatch")
+	})
+
+	Convey("Given a software authenticator without user presence", t, func() {
+
+		a := newSoftAuthenticator("a3s.com", "https://a3s.com", coseAlgES256)
+		challenge, _ := NewWebAuthnChallenge()
+
+		resp, err := ParseWebAuthnResponse(a.create(challenge))
+		So(err, ShouldBeNil)
+		resp.Response.AttestationObject = b64(encodeCBOR(map[string]any{
+			"fmt":      "none",
+			"attStmt":  map[string]any{},
+			"authData": a.authData(flagAttestedCredsData, true),
+		}))
+
+		_, err = rp.VerifyRegistration(resp, challenge)
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldEqual, "invalid authenticator data: user not present")
+	})
+
+	Convey("Given a software authenticator using an unsupported algorithm", t, func() {
+
+		a := newSoftAuthenticator("a3s.com", "https://a3s.com", -35)
+		a.key, _ = ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+		challenge, _ := NewWebAuthnChallenge()
+
+		resp, err := ParseWebAuthnResponse(a.create(challenge))
+		So(err, ShouldBeNil)
+
+		_, err = rp.VerifyRegistration(resp, challenge)
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldEqual, "unsupported credential algorithm -35")
+	})
+}
+
+func algName(alg int) string {
+	switch alg {
+	case coseAlgES256:
+		return "ES256"
+	case coseAlgEdDSA:
+		return "EdDSA"
+	default:
+		return "RS256"
+	}
+}
+
+func TestParseAuthenticatorData(t *testing.T) {
+
+	rp := &RelyingParty{ID: "a3s.com"}
+	a := newSoftAuthenticator("a3s.com", "https://a3s.com", coseAlgES256)
+
+	extensions := encodeCBOR(map[string]any{"credProtect": 2})
+	attested := a.authData(flagUserPresent|flagAttestedCredsData, true)
+	asserted := a.authData(flagUserPresent, false)
+
+	tests := []struct {
+		name    string
+		data    []byte
+		wantKey bool
+		wantErr string
+	}{
+		{
+			"attested credential data",
+			attested,
+			true,
+			"",
+		},
+		{
+			"attested credential data with extensions",
+			append(a.authData(flagUserPresent|flagAttestedCredsData|flagExtensionData, true), extensions...),
+			true,
+			"",
+		},
+		{
+			"assertion with extensions",
+			append(a.authData(flagUserPresent|flagExtensionData, false), extensions...),
+			false,
+			"",
+		},
+		{
+			"trailing bytes after the credential public key",
+			append(append([]byte{}, attested...), extensions...),
+			false,
+			fmt.Sprintf("invalid authenticator data: %d trailing bytes", len(extensions)),
+		},
+		{
+			"trailing bytes after the extensions",
+			append(append(a.authData(flagUserPresent|flagExtensionData, false), extensions...), 0x00),
+			false,
+			"invalid authenticator data: 1 trailing bytes",
+		},
+		{
+			"trailing bytes in an assertion",
+			append(append([]byte{}, asserted...), 0x00),
+			false,
+			"invalid authenticator data: 1 trailing bytes",
+		},
+		{
+			"missing extensions",
+			a.authData(flagUserPresent|flagExtensionData, false),
+			false,
+			"invalid authenticator data: unable to decode extensions: unexpected end of data",
+		},
+		{
+			"extensions not being a map",
+			append(a.authData(flagUserPresent|flagExtensionData, false), encodeCBOR("ext")...),
+			false,
+			"invalid authenticator data: unable to decode extensions: ",
+		},
+		{
+			"truncated credential public key",
+			attested[:len(attested)-1],
+			false,
+			"invalid authenticator data: unable to decode credential public key: ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			ad, err := rp.parseAuthenticatorData(tt.data)
+
+			if tt.wantErr != "" {
+				if err == nil || !strings.HasPrefix(err.Error(), tt.wantErr) {
+					t.Fatalf("parseAuthenticatorData() error = %v, wantErr %s", err, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("parseAuthenticatorData() unexpected error = %v", err)
+			}
+
+			if tt.wantKey != (ad.credentialPublicKey != nil) {
+				t.Fatalf("parseAuthenticatorData() credentialPublicKey = %v, wantKey %v", ad.credentialPublicKey, tt.wantKey)
+			}
+
+			if tt.wantKey {
+				key, err := parseCOSEKey(ad.credentialPublicKey)
+				if err != nil {
+					t.Fatalf("parseCOSEKey() unexpected error = %v", err)
+				}
+				if !a.key.Public().(*ecdsa.PublicKey).Equal(key) {
+					t.Errorf("parseAuthenticatorData() credentialPublicKey = %x, want the key of the authenticator", ad.credentialPublicKey)
+				}
+			}
+		})
+	}
+}
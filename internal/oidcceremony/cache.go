@@ -14,9 +14,16 @@ import (
 type CacheItem struct {
 	State            string        `bson:"state"`
 	ClientID         string        `bson:"clientid"`
+	SourceNamespace  string        `bson:"sourcenamespace"`
+	SourceName       string        `bson:"sourcename"`
 	CA               string        `bson:"ca"`
 	OAuth2Config     oauth2.Config `bson:"oauth2config"`
 	ProviderEndpoint string        `bson:"providerEndpoint"`
+	CodeVerifier     string        `bson:"codeverifier"`
+	Nonce            string        `bson:"nonce"`
+	DeviceCode       string        `bson:"devicecode"`
+	DeviceExpiry     time.Time     `bson:"deviceexpiry"`
+	Interval         int           `bson:"interval"`
 	Time             time.Time     `bson:"time"`
 }
 
@@ -52,6 +59,20 @@ func Get(m manipulate.Manipulator, state string) (*CacheItem, error) {
 	return item, nil
 }
 
+// SetInterval updates the polling interval of the item with the given state.
+func SetInterval(m manipulate.Manipulator, state string, interval int) error {
+
+	db := manipmongo.GetDatabase(m)
+
+	collection := db.Collection("oidcCacheCollection")
+	filter := bson.M{"state": state}
+	_, err := collection.UpdateOne(context.TODO(), filter, bson.M{"$set": bson.M{"interval": interval}})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 // Delete deletes the items with the given state.
 func Delete(m manipulate.Manipulator, state string) error {
 
@@ -0,0 +1,107 @@
+package oidcceremony
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// Errors returned by RetrieveDeviceToken while the
+// device authorization request is not yet approved.
+var (
+	ErrAuthorizationPending = errors.New("authorization pending")
+	ErrSlowDown             = errors.New("slow down")
+)
+
+// RetrieveDeviceToken sends a single device access token request to the
+// token endpoint of the given config, using the given device code.
+// Unlike oauth2.Config.DeviceAccessToken, it does not poll, and returns
+// ErrAuthorizationPending or ErrSlowDown if the user has not yet approved
+// the request, so the polling can be driven by the client.
+func RetrieveDeviceToken(ctx context.Context, client *http.Client, cfg *oauth2.Config, deviceCode string) (*oauth2.Token, error) {
+
+	v := url.Values{
+		"client_id":   {cfg.ClientID},
+		"grant_type":  {"urn:ietf:params:oauth:grant-type:device_code"},
+		"device_code": {deviceCode},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, cfg.Endpoint.TokenURL, strings.NewReader(v.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("unable to prepare token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	if cfg.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(cfg.ClientID), url.QueryEscape(cfg.ClientSecret))
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to send token request: %w", err)
+	}
+	defer resp.Body.Close() // nolint
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read token response: %w", err)
+	}
+
+	data := struct {
+		AccessToken      string `json:"access_token"`
+		TokenType        string `json:"token_type"`
+		RefreshToken     string `json:"refresh_token"`
+		ExpiresIn        int64  `json:"expires_in"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}{}
+
+	if err := json.Unmarshal(body, &data); err != nil {
+		return nil, fmt.Errorf("unable to decode token response (status %d): %w", resp.StatusCode, err)
+	}
+
+	switch data.Error {
+	case "":
+	case "authorization_pending":
+		return nil, ErrAuthorizationPending
+	case "slow_down":
+		return nil, ErrSlowDown
+	default:
+		if data.ErrorDescription != "" {
+			return nil, fmt.Errorf("unable to retrieve device token: %s: %s", data.Error, data.ErrorDescription)
+		}
+		return nil, fmt.Errorf("unable to retrieve device token: %s", data.Error)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("unable to retrieve device token: unexpected status %d", resp.StatusCode)
+	}
+
+	if data.AccessToken == "" {
+		return nil, fmt.Errorf("unable to retrieve device token: missing access token")
+	}
+
+	extra := map[string]any{}
+	_ = json.Unmarshal(body, &extra) // already decoded above
+
+	tok := &oauth2.Token{
+		AccessToken:  data.AccessToken,
+		TokenType:    data.TokenType,
+		RefreshToken: data.RefreshToken,
+	}
+
+	if data.ExpiresIn > 0 {
+		tok.Expiry = time.Now().Add(time.Duration(data.ExpiresIn) * time.Second)
+	}
+
+	return tok.WithExtra(extra), nil
+}
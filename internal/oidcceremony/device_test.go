@@ -0,0 +1,121 @@
+package oidcceremony
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/oauth2"
+)
+
+func TestRetrieveDeviceToken(t *testing.T) {
+
+	Convey("Given a token endpoint", t, func() {
+
+		var status int
+		var body string
+		var form map[string][]string
+		var user, pass string
+		var hasBasic bool
+
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_ = r.ParseForm()
+			form = r.PostForm
+			user, pass, hasBasic = r.BasicAuth()
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(body))
+		}))
+		defer ts.Close()
+
+		cfg := &oauth2.Config{
+			ClientID: "client",
+			Endpoint: oauth2.Endpoint{TokenURL: ts.URL},
+		}
+
+		Convey("When the request is approved", func() {
+
+			status = http.StatusOK
+			body = `{"access_token":"at","token_type":"Bearer","expires_in":60,"id_token":"idt"}`
+
+			tok, err := RetrieveDeviceToken(context.Background(), ts.Client(), cfg, "dc")
+			So(err, ShouldBeNil)
+			So(tok.AccessToken, ShouldEqual, "at")
+			So(tok.TokenType, ShouldEqual, "Bearer")
+			So(tok.Expiry.IsZero(), ShouldBeFalse)
+			So(tok.Extra("id_token"), ShouldEqual, "idt")
+			So(form["grant_type"], ShouldResemble, []string{"urn:ietf:params:oauth:grant-type:device_code"})
+			So(form["device_code"], ShouldResemble, []string{"dc"})
+			So(form["client_id"], ShouldResemble, []string{"client"})
+			So(hasBasic, ShouldBeFalse)
+		})
+
+		Convey("When the client has a secret", func() {
+
+			status = http.StatusOK
+			body = `{"access_token":"at"}`
+			cfg.ClientSecret = "s&cret"
+
+			_, err := RetrieveDeviceToken(context.Background(), ts.Client(), cfg, "dc")
+			So(err, ShouldBeNil)
+			So(hasBasic, ShouldBeTrue)
+			So(user, ShouldEqual, "client")
+			So(pass, ShouldEqual, "s%26cret")
+		})
+
+		Convey("When the request is pending", func() {
+
+			status = http.StatusBadRequest
+			body = `{"error":"authorization_pending"}`
+
+			tok, err := RetrieveDeviceToken(context.Background(), ts.Client(), cfg, "dc")
+			So(tok, ShouldBeNil)
+			So(err, ShouldEqual, ErrAuthorizationPending)
+		})
+
+		Convey("When the client must slow down", func() {
+
+			status = http.StatusBadRequest
+			body = `{"error":"slow_down"}`
+
+			tok, err := RetrieveDeviceToken(context.Background(), ts.Client(), cfg, "dc")
+			So(tok, ShouldBeNil)
+			So(err, ShouldEqual, ErrSlowDown)
+		})
+
+		Convey("When the request is denied", func() {
+
+			status = http.StatusBadRequest
+			body = `{"error":"access_denied","error_description":"nope"}`
+
+			tok, err := RetrieveDeviceToken(context.Background(), ts.Client(), cfg, "dc")
+			So(tok, ShouldBeNil)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "unable to retrieve device token: access_denied: nope")
+		})
+
+		Convey("When the response has no access token", func() {
+
+			status = http.StatusOK
+			body = `{}`
+
+			tok, err := RetrieveDeviceToken(context.Background(), ts.Client(), cfg, "dc")
+			So(tok, ShouldBeNil)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "unable to retrieve device token: missing access token")
+		})
+
+		Convey("When the response is not json", func() {
+
+			status = http.StatusBadGateway
+			body = `<html>`
+
+			tok, err := RetrieveDeviceToken(context.Background(), ts.Client(), cfg, "dc")
+			So(tok, ShouldBeNil)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldStartWith, "unable to decode token response (status 502): ")
+		})
+	})
+}
@@ -1,10 +1,12 @@
 package processors
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"sort"
 
+	"go.aporeto.io/a3s/internal/reference"
 	"go.aporeto.io/a3s/pkgs/api"
 	"go.aporeto.io/a3s/pkgs/crud"
 	"go.aporeto.io/a3s/pkgs/notification"
@@ -19,6 +21,7 @@ import (
 // A AuthorizationsProcessor is a bahamut processor for Authorizations.
 type AuthorizationsProcessor struct {
 	manipulator manipulate.Manipulator
+	references  token.ReferenceResolver
 	retriever   permissions.Retriever
 	pubsub      bahamut.PubSubClient
 	localIssuer string
@@ -28,6 +31,7 @@ type AuthorizationsProcessor struct {
 func NewAuthorizationProcessor(manipulator manipulate.Manipulator, pubsub bahamut.PubSubClient, retriever permissions.Retriever, localIssuer string) *AuthorizationsProcessor {
 	return &AuthorizationsProcessor{
 		manipulator: manipulator,
+		references:  reference.NewResolver(manipulator),
 		pubsub:      pubsub,
 		retriever:   retriever,
 		localIssuer: localIssuer,
@@ -100,9 +104,8 @@ func (p *AuthorizationsProcessor) makePreHook(ctx bahamut.Context) crud.PreWrite
 		}
 
 		req := ctx.Request()
-		token := token.FromRequest(req)
 
-		restrictions, err := permissions.GetRestrictions(token)
+		restrictions, err := getRestrictions(ctx.Context(), p.references, token.FromRequest(req))
 		if err != nil {
 			return fmt.Errorf("unable to retrieve restrictions: %s", err)
 		}
@@ -184,3 +187,18 @@ func flattenTags(term [][]string) (out []string) {
 
 	return out
 }
+
+// getRestrictions returns the restrictions of the given token,
+// resolving it first using the given resolver if it is a reference token.
+func getRestrictions(ctx context.Context, references token.ReferenceResolver, tkn string) (permissions.Restrictions, error) {
+
+	if token.IsReference(tkn) {
+		resolved, err := references.Resolve(ctx, tkn)
+		if err != nil {
+			return permissions.Restrictions{}, fmt.Errorf("unable to resolve reference token: %w", err)
+		}
+		tkn = resolved
+	}
+
+	return permissions.GetRestrictions(tkn)
+}
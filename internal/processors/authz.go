@@ -6,6 +6,7 @@ import (
 	"go.aporeto.io/a3s/pkgs/api"
 	"go.aporeto.io/a3s/pkgs/authorizer"
 	"go.aporeto.io/a3s/pkgs/permissions"
+	"go.aporeto.io/a3s/pkgs/revocation"
 	"go.aporeto.io/a3s/pkgs/token"
 	"go.aporeto.io/bahamut"
 	"go.aporeto.io/elemental"
@@ -13,28 +14,40 @@ import (
 
 // A AuthzProcessor is a bahamut processor for Authzs.
 type AuthzProcessor struct {
-	authorizer authorizer.Authorizer
-	jwks       *token.JWKS
-	issuer     string
-	audience   string
+	authorizer  authorizer.Authorizer
+	jwks        *token.JWKS
+	revocations *revocation.Cache
+	references  token.ReferenceResolver
+	issuer      string
+	audience    string
 }
 
 // NewAuthzProcessor returns a new AuthzProcessor.
-func NewAuthzProcessor(authorizer authorizer.Authorizer, jwks *token.JWKS, issuer string, audience string) *AuthzProcessor {
+func NewAuthzProcessor(authorizer authorizer.Authorizer, jwks *token.JWKS, revocations *revocation.Cache, references token.ReferenceResolver, issuer string, audience string) *AuthzProcessor {
 	return &AuthzProcessor{
-		authorizer: authorizer,
-		jwks:       jwks,
-		issuer:     issuer,
-		audience:   audience,
+		authorizer:  authorizer,
+		jwks:        jwks,
+		revocations: revocations,
+		references:  references,
+		issuer:      issuer,
+		audience:    audience,
 	}
 }
 
 // ProcessCreate handles the creates requests for Authzs.
-func (p *AuthzProcessor) ProcessCreate(bctx bahamut.Context) error {
+func (p *AuthzProcessor) ProcessCreate(bctx bahamut.Context) (err error) {
 
 	req := bctx.InputData().(*api.Authz)
 
-	idt, err := token.Parse(req.Token, p.jwks, p.issuer, req.Audience)
+	tkn := req.Token
+	if p.references != nil && token.IsReference(tkn) {
+		if tkn, err = p.references.Resolve(bctx.Context(), tkn); err != nil {
+			bctx.SetStatusCode(http.StatusForbidden)
+			return nil
+		}
+	}
+
+	idt, err := token.Parse(tkn, p.jwks, p.issuer, req.Audience)
 	if err != nil {
 		return elemental.NewError(
 			"Bad Request",
@@ -44,6 +57,11 @@ func (p *AuthzProcessor) ProcessCreate(bctx bahamut.Context) error {
 		)
 	}
 
+	if p.revocations != nil && p.revocations.IsRevoked(idt) {
+		bctx.SetStatusCode(http.StatusForbidden)
+		return nil
+	}
+
 	var r permissions.Restrictions
 	if idt.Restrictions != nil {
 		r = *idt.Restrictions
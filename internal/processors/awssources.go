@@ -0,0 +1,50 @@
+package processors
+
+import (
+	"go.aporeto.io/a3s/pkgs/api"
+	"go.aporeto.io/a3s/pkgs/crud"
+	"go.aporeto.io/bahamut"
+	"go.aporeto.io/manipulate"
+)
+
+// A AWSSourcesProcessor is a bahamut processor for AWSSource.
+type AWSSourcesProcessor struct {
+	manipulator manipulate.Manipulator
+}
+
+// NewAWSSourcesProcessor returns a new AWSSourcesProcessor.
+func NewAWSSourcesProcessor(manipulator manipulate.Manipulator) *AWSSourcesProcessor {
+	return &AWSSourcesProcessor{
+		manipulator: manipulator,
+	}
+}
+
+// ProcessCreate handles the creates requests for AWSSource.
+func (p *AWSSourcesProcessor) ProcessCreate(bctx bahamut.Context) error {
+	return crud.Create(bctx, p.manipulator, bctx.InputData().(*api.AWSSource))
+}
+
+// ProcessRetrieveMany handles the retrieve many requests for AWSSource.
+func (p *AWSSourcesProcessor) ProcessRetrieveMany(bctx bahamut.Context) error {
+	return crud.RetrieveMany(bctx, p.manipulator, &api.AWSSourcesList{})
+}
+
+// ProcessRetrieve handles the retrieve requests for AWSSource.
+func (p *AWSSourcesProcessor) ProcessRetrieve(bctx bahamut.Context) error {
+	return crud.Retrieve(bctx, p.manipulator, api.NewAWSSource())
+}
+
+// ProcessUpdate handles the update requests for AWSSource.
+func (p *AWSSourcesProcessor) ProcessUpdate(bctx bahamut.Context) error {
+	return crud.Update(bctx, p.manipulator, bctx.InputData().(*api.AWSSource))
+}
+
+// ProcessDelete handles the delete requests for AWSSource.
+func (p *AWSSourcesProcessor) ProcessDelete(bctx bahamut.Context) error {
+	return crud.Delete(bctx, p.manipulator, api.NewAWSSource())
+}
+
+// ProcessInfo handles the info request for AWSSource.
+func (p *AWSSourcesProcessor) ProcessInfo(bctx bahamut.Context) error {
+	return crud.Info(bctx, p.manipulator, api.AWSSourceIdentity)
+}
@@ -0,0 +1,50 @@
+package processors
+
+import (
+	"go.aporeto.io/a3s/pkgs/api"
+	"go.aporeto.io/a3s/pkgs/crud"
+	"go.aporeto.io/bahamut"
+	"go.aporeto.io/manipulate"
+)
+
+// A AzureSourcesProcessor is a bahamut processor for AzureSource.
+type AzureSourcesProcessor struct {
+	manipulator manipulate.Manipulator
+}
+
+// NewAzureSourcesProcessor returns a new AzureSourcesProcessor.
+func NewAzureSourcesProcessor(manipulator manipulate.Manipulator) *AzureSourcesProcessor {
+	return &AzureSourcesProcessor{
+		manipulator: manipulator,
+	}
+}
+
+// ProcessCreate handles the creates requests for AzureSource.
+func (p *AzureSourcesProcessor) ProcessCreate(bctx bahamut.Context) error {
+	return crud.Create(bctx, p.manipulator, bctx.InputData().(*api.AzureSource))
+}
+
+// ProcessRetrieveMany handles the retrieve many requests for AzureSource.
+func (p *AzureSourcesProcessor) ProcessRetrieveMany(bctx bahamut.Context) error {
+	return crud.RetrieveMany(bctx, p.manipulator, &api.AzureSourcesList{})
+}
+
+// ProcessRetrieve handles the retrieve requests for AzureSource.
+func (p *AzureSourcesProcessor) ProcessRetrieve(bctx bahamut.Context) error {
+	return crud.Retrieve(bctx, p.manipulator, api.NewAzureSource())
+}
+
+// ProcessUpdate handles the update requests for AzureSource.
+func (p *AzureSourcesProcessor) ProcessUpdate(bctx bahamut.Context) error {
+	return crud.Update(bctx, p.manipulator, bctx.InputData().(*api.AzureSource))
+}
+
+// ProcessDelete handles the delete requests for AzureSource.
+func (p *AzureSourcesProcessor) ProcessDelete(bctx bahamut.Context) error {
+	return crud.Delete(bctx, p.manipulator, api.NewAzureSource())
+}
+
+// ProcessInfo handles the info request for AzureSource.
+func (p *AzureSourcesProcessor) ProcessInfo(bctx bahamut.Context) error {
+	return crud.Info(bctx, p.manipulator, api.AzureSourceIdentity)
+}
@@ -0,0 +1,50 @@
+package processors
+
+import (
+	"go.aporeto.io/a3s/pkgs/api"
+	"go.aporeto.io/a3s/pkgs/crud"
+	"go.aporeto.io/bahamut"
+	"go.aporeto.io/manipulate"
+)
+
+// A GCPSourcesProcessor is a bahamut processor for GCPSource.
+type GCPSourcesProcessor struct {
+	manipulator manipulate.Manipulator
+}
+
+// NewGCPSourcesProcessor returns a new GCPSourcesProcessor.
+func NewGCPSourcesProcessor(manipulator manipulate.Manipulator) *GCPSourcesProcessor {
+	return &GCPSourcesProcessor{
+		manipulator: manipulator,
+	}
+}
+
+// ProcessCreate handles the creates requests for GCPSource.
+func (p *GCPSourcesProcessor) ProcessCreate(bctx bahamut.Context) error {
+	return crud.Create(bctx, p.manipulator, bctx.InputData().(*api.GCPSource))
+}
+
+// ProcessRetrieveMany handles the retrieve many requests for GCPSource.
+func (p *GCPSourcesProcessor) ProcessRetrieveMany(bctx bahamut.Context) error {
+	return crud.RetrieveMany(bctx, p.manipulator, &api.GCPSourcesList{})
+}
+
+// ProcessRetrieve handles the retrieve requests for GCPSource.
+func (p *GCPSourcesProcessor) ProcessRetrieve(bctx bahamut.Context) error {
+	return crud.Retrieve(bctx, p.manipulator, api.NewGCPSource())
+}
+
+// ProcessUpdate handles the update requests for GCPSource.
+func (p *GCPSourcesProcessor) ProcessUpdate(bctx bahamut.Context) error {
+	return crud.Update(bctx, p.manipulator, bctx.InputData().(*api.GCPSource))
+}
+
+// ProcessDelete handles the delete requests for GCPSource.
+func (p *GCPSourcesProcessor) ProcessDelete(bctx bahamut.Context) error {
+	return crud.Delete(bctx, p.manipulator, api.NewGCPSource())
+}
+
+// ProcessInfo handles the info request for GCPSource.
+func (p *GCPSourcesProcessor) ProcessInfo(bctx bahamut.Context) error {
+	return crud.Info(bctx, p.manipulator, api.GCPSourceIdentity)
+}
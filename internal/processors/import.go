@@ -8,7 +8,6 @@ import (
 	"go.aporeto.io/a3s/pkgs/authorizer"
 	"go.aporeto.io/a3s/pkgs/bearermanip"
 	"go.aporeto.io/a3s/pkgs/importing"
-	"go.aporeto.io/a3s/pkgs/permissions"
 	"go.aporeto.io/a3s/pkgs/token"
 	"go.aporeto.io/bahamut"
 	"go.aporeto.io/elemental"
@@ -18,13 +17,15 @@ import (
 type ImportProcessor struct {
 	bmanipMaker bearermanip.MakerFunc
 	authz       authorizer.Authorizer
+	references  token.ReferenceResolver
 }
 
 // NewImportProcessor returns a new ImportProcessor .
-func NewImportProcessor(bmanipMaker bearermanip.MakerFunc, authz authorizer.Authorizer) *ImportProcessor {
+func NewImportProcessor(bmanipMaker bearermanip.MakerFunc, authz authorizer.Authorizer, references token.ReferenceResolver) *ImportProcessor {
 	return &ImportProcessor{
 		bmanipMaker: bmanipMaker,
 		authz:       authz,
+		references:  references,
 	}
 }
 
@@ -38,12 +39,20 @@ func (p *ImportProcessor) ProcessCreate(bctx bahamut.Context) error {
 		req.LDAPSources,
 		req.OIDCSources,
 		req.A3SSources,
+		req.AWSSources,
+		req.AzureSources,
+		req.GCPSources,
+		req.KubernetesSources,
+		req.JWTSources,
+		req.SPIFFESources,
+		req.LocalSources,
+		req.SAMLSources,
 		req.MTLSSources,
 		req.HTTPSources,
 		req.Authorizations,
 	}
 
-	restrictions, err := permissions.GetRestrictions(token.FromRequest(bctx.Request()))
+	restrictions, err := getRestrictions(bctx.Context(), p.references, token.FromRequest(bctx.Request()))
 	if err != nil {
 		return err
 	}
@@ -2,37 +2,67 @@ package processors
 
 import (
 	"context"
+	"crypto/subtle"
 	"crypto/tls"
+	"encoding/base64"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/crewjam/saml"
 	"github.com/golang-jwt/jwt/v4"
 	"go.aporeto.io/a3s/internal/issuer/a3sissuer"
 	"go.aporeto.io/a3s/internal/issuer/awsissuer"
 	"go.aporeto.io/a3s/internal/issuer/azureissuer"
+	"go.aporeto.io/a3s/internal/issuer/exchangeissuer"
 	"go.aporeto.io/a3s/internal/issuer/gcpissuer"
 	"go.aporeto.io/a3s/internal/issuer/httpissuer"
+	"go.aporeto.io/a3s/internal/issuer/jwtissuer"
+	"go.aporeto.io/a3s/internal/issuer/kubernetesissuer"
 	"go.aporeto.io/a3s/internal/issuer/ldapissuer"
+	"go.aporeto.io/a3s/internal/issuer/localissuer"
 	"go.aporeto.io/a3s/internal/issuer/mtlsissuer"
 	"go.aporeto.io/a3s/internal/issuer/oidcissuer"
 	"go.aporeto.io/a3s/internal/issuer/remotea3sissuer"
+	"go.aporeto.io/a3s/internal/issuer/samlissuer"
+	"go.aporeto.io/a3s/internal/issuer/spiffeissuer"
+	"go.aporeto.io/a3s/internal/lockout"
+	"go.aporeto.io/a3s/internal/mfa"
 	"go.aporeto.io/a3s/internal/oidcceremony"
+	"go.aporeto.io/a3s/internal/reference"
+	"go.aporeto.io/a3s/internal/refreshfamily"
+	"go.aporeto.io/a3s/internal/samlceremony"
 	"go.aporeto.io/a3s/pkgs/api"
+	"go.aporeto.io/a3s/pkgs/authorizer"
 	"go.aporeto.io/a3s/pkgs/permissions"
+	"go.aporeto.io/a3s/pkgs/revocation"
 	"go.aporeto.io/a3s/pkgs/token"
 	"go.aporeto.io/bahamut"
 	"go.aporeto.io/bahamut/authorizer/mtls"
 	"go.aporeto.io/elemental"
 	"go.aporeto.io/manipulate"
+	"go.uber.org/zap"
 	"golang.org/x/oauth2"
 )
 
+// The permission the bearer of the actor token must have in the
+// restricted namespace of a token exchange. It does not use the issue
+// resource, which is public and always authorized.
+const (
+	delegationPermissionResource  = "token"
+	delegationPermissionOperation = "delegate"
+)
+
 // A IssueProcessor is a bahamut processor for Issue.
 type IssueProcessor struct {
 	manipulator          manipulate.Manipulator
 	jwks                 *token.JWKS
+	revocations          *revocation.Cache
+	revoker              *RevocationsProcessor
+	references           token.ReferenceResolver
+	authorizer           authorizer.Authorizer
 	maxValidity          time.Duration
 	defaultValidity      time.Duration
 	audience             string
@@ -42,12 +72,17 @@ type IssueProcessor struct {
 	mtlsHeaderEnabled    bool
 	mtlsHeaderKey        string
 	mtlsHeaderPass       string
+	publicAPIURL         string
+	webAuthn             *mfa.RelyingParty
 }
 
 // NewIssueProcessor returns a new IssueProcessor.
 func NewIssueProcessor(
 	manipulator manipulate.Manipulator,
 	jwks *token.JWKS,
+	revocations *revocation.Cache,
+	revoker *RevocationsProcessor,
+	authorizer authorizer.Authorizer,
 	defaultValidity time.Duration,
 	maxValidity time.Duration,
 	issuer string,
@@ -57,11 +92,17 @@ func NewIssueProcessor(
 	mtlsHeaderEnabled bool,
 	mtlsHeaderKey string,
 	mtlsHeaderPass string,
+	publicAPIURL string,
+	webAuthn *mfa.RelyingParty,
 ) *IssueProcessor {
 
 	return &IssueProcessor{
 		manipulator:          manipulator,
 		jwks:                 jwks,
+		revocations:          revocations,
+		revoker:              revoker,
+		references:           reference.NewResolver(manipulator),
+		authorizer:           authorizer,
 		defaultValidity:      defaultValidity,
 		maxValidity:          maxValidity,
 		issuer:               issuer,
@@ -71,6 +112,8 @@ func NewIssueProcessor(
 		mtlsHeaderEnabled:    mtlsHeaderEnabled,
 		mtlsHeaderKey:        mtlsHeaderKey,
 		mtlsHeaderPass:       mtlsHeaderPass,
+		publicAPIURL:         publicAPIURL,
+		webAuthn:             webAuthn,
 	}
 }
 
@@ -119,17 +162,29 @@ func (p *IssueProcessor) ProcessCreate(bctx bahamut.Context) (err error) {
 	case api.IssueSourceTypeLDAP:
 		issuer, err = p.handleLDAPIssue(bctx.Context(), req)
 
+	case api.IssueSourceTypeLocal:
+		issuer, err = p.handleLocalIssue(bctx.Context(), req)
+
 	case api.IssueSourceTypeHTTP:
 		issuer, err = p.handleHTTPIssue(bctx.Context(), req)
 
 	case api.IssueSourceTypeAWS:
-		issuer, err = p.handleAWSIssue(req)
+		issuer, err = p.handleAWSIssue(bctx.Context(), req)
 
 	case api.IssueSourceTypeAzure:
 		issuer, err = p.handleAzureIssue(bctx.Context(), req)
 
 	case api.IssueSourceTypeGCP:
-		issuer, err = p.handleGCPIssue(req)
+		issuer, err = p.handleGCPIssue(bctx.Context(), req)
+
+	case api.IssueSourceTypeKubernetes:
+		issuer, err = p.handleKubernetesIssue(bctx.Context(), req)
+
+	case api.IssueSourceTypeJWT:
+		issuer, err = p.handleJWTIssue(bctx.Context(), req)
+
+	case api.IssueSourceTypeSPIFFE:
+		issuer, err = p.handleSPIFFEIssue(bctx.Context(), req)
 
 	case api.IssueSourceTypeRemoteA3S:
 		issuer, err = p.handleRemoteA3SIssue(bctx.Context(), req)
@@ -140,14 +195,38 @@ func (p *IssueProcessor) ProcessCreate(bctx bahamut.Context) (err error) {
 			return nil
 		}
 
+	case api.IssueSourceTypeSAML:
+		issuer, err = p.handleSAMLIssue(bctx, req)
+		if issuer == nil && err == nil {
+			return nil
+		}
+
 	case api.IssueSourceTypeA3S:
 		if req.Validity == "" {
 			validity = 0
 		}
-		issuer, err = p.handleTokenIssue(req, validity, audience)
+		issuer, err = p.handleTokenIssue(bctx.Context(), req, validity, audience)
 		// we reset to 0 to skip setting exp during issuing of the token
 		// as the token issers already caps it.
 		exp = time.Time{}
+
+	case api.IssueSourceTypeMFA:
+		if req.Validity == "" {
+			validity = 0
+		}
+		issuer, err = p.handleMFAIssue(bctx, req, validity, audience)
+		if issuer == nil && err == nil {
+			return nil
+		}
+		// the upgraded token keeps the
+		// expiration of the input token.
+		exp = time.Time{}
+
+	case api.IssueSourceTypeTokenExchange:
+		issuer, err = p.handleTokenExchangeIssue(bctx.Context(), req, validity, audience)
+		// the exchange issuer caps the expiration
+		// to the one of the input tokens.
+		exp = time.Time{}
 	}
 
 	if err != nil {
@@ -156,6 +235,25 @@ func (p *IssueProcessor) ProcessCreate(bctx bahamut.Context) (err error) {
 
 	idt := issuer.Issue()
 
+	var refresh *token.IdentityToken
+	if riss, ok := issuer.(a3sissuer.RefreshIssuer); ok {
+		refresh = riss.Refresh()
+	}
+
+	// The token issued from a refresh token belongs to its family,
+	// but only the next refresh token returned in refreshToken
+	// becomes the current one of the family.
+	if refresh != nil && req.TokenType == api.IssueTokenTypeRefresh {
+		return elemental.NewError(
+			"Invalid token type",
+			"You cannot request a refresh token from a refresh token. Use the one returned in refreshToken",
+			"a3s:authn",
+			http.StatusBadRequest,
+		)
+	}
+
+	stripForgeableClaims(req.SourceType, idt)
+
 	if err := idt.Restrict(permissions.Restrictions{
 		Namespace:   req.RestrictedNamespace,
 		Networks:    req.RestrictedNetworks,
@@ -173,27 +271,46 @@ func (p *IssueProcessor) ProcessCreate(bctx bahamut.Context) (err error) {
 		idt.Refresh = true
 	}
 
-	k := p.jwks.GetLast()
-	tkn, err := idt.JWT(
-		k.PrivateKey(),
-		k.KID,
-		p.issuer,
-		audience,
-		exp,
-		req.Cloak,
-	)
+	tkn, err := p.sign(idt, audience, exp, req.Cloak)
 	if err != nil {
 		return err
 	}
 
+	if req.TokenType == api.IssueTokenTypeReference {
+		if tkn, err = p.storeReference(bctx.Context(), tkn, idt); err != nil {
+			return elemental.NewError(
+				"Reference Error",
+				err.Error(),
+				"a3s:authn",
+				http.StatusInternalServerError,
+			)
+		}
+	}
+
+	// The refresh token family is only rotated once the token has been
+	// issued, so a failure does not consume the presented refresh token,
+	// which would revoke the whole family when the client retries.
+	if refresh != nil {
+		if req.RefreshToken, err = p.rotateRefreshToken(bctx.Context(), refresh); err != nil {
+			return elemental.NewError("Unauthorized", err.Error(), "a3s:authn", http.StatusUnauthorized)
+		}
+	}
+
 	req.Validity = time.Until(idt.ExpiresAt.Time).Round(time.Second).String()
 	req.InputLDAP = nil
+	req.InputLocal = nil
+	req.InputMFA = nil
 	req.InputAWS = nil
 	req.InputAzure = nil
 	req.InputGCP = nil
+	req.InputKubernetes = nil
+	req.InputJWT = nil
+	req.InputSPIFFE = nil
 	req.InputOIDC = nil
+	req.InputSAML = nil
 	req.InputA3S = nil
 	req.InputRemoteA3S = nil
+	req.InputTokenExchange = nil
 
 	if req.Cookie {
 		domain := req.CookieDomain
@@ -266,6 +383,10 @@ func (p *IssueProcessor) handleCertificateIssue(ctx context.Context, req *api.Is
 		return nil, err
 	}
 
+	if req.CertificateBound {
+		iss.Issue().Confirmation = token.NewConfirmation(cert[0])
+	}
+
 	return iss, nil
 }
 
@@ -285,6 +406,33 @@ func (p *IssueProcessor) handleLDAPIssue(ctx context.Context, req *api.Issue) (t
 	return iss, nil
 }
 
+func (p *IssueProcessor) handleLocalIssue(ctx context.Context, req *api.Issue) (token.Issuer, error) {
+
+	out, err := retrieveSource(ctx, p.manipulator, req.SourceNamespace, req.SourceName, api.LocalSourceIdentity)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := retrieveLocalUser(ctx, p.manipulator, req.SourceNamespace, req.SourceName, req.InputLocal.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	done, err := localLimiter.Acquire(localUserKey(req.SourceNamespace, req.SourceName, req.InputLocal.Username))
+	if err != nil {
+		return nil, err
+	}
+
+	src := out.(*api.LocalSource)
+	iss, err := localissuer.New(ctx, src, user, req.InputLocal.Password)
+	done(!errors.Is(err, localissuer.ErrInvalidCredentials))
+	if err != nil {
+		return nil, err
+	}
+
+	return iss, nil
+}
+
 func (p *IssueProcessor) handleHTTPIssue(ctx context.Context, req *api.Issue) (token.Issuer, error) {
 
 	out, err := retrieveSource(ctx, p.manipulator, req.SourceNamespace, req.SourceName, api.HTTPSourceIdentity)
@@ -305,9 +453,15 @@ func (p *IssueProcessor) handleHTTPIssue(ctx context.Context, req *api.Issue) (t
 	return iss, nil
 }
 
-func (p *IssueProcessor) handleAWSIssue(req *api.Issue) (token.Issuer, error) {
+func (p *IssueProcessor) handleAWSIssue(ctx context.Context, req *api.Issue) (token.Issuer, error) {
 
-	iss, err := awsissuer.New(req.InputAWS.ID, req.InputAWS.Secret, req.InputAWS.Token)
+	out, err := retrieveSource(ctx, p.manipulator, req.SourceNamespace, req.SourceName, api.AWSSourceIdentity)
+	if err != nil {
+		return nil, err
+	}
+
+	src := out.(*api.AWSSource)
+	iss, err := awsissuer.New(ctx, src, req.InputAWS.ID, req.InputAWS.Secret, req.InputAWS.Token)
 	if err != nil {
 		return nil, err
 	}
@@ -317,7 +471,45 @@ func (p *IssueProcessor) handleAWSIssue(req *api.Issue) (token.Issuer, error) {
 
 func (p *IssueProcessor) handleAzureIssue(ctx context.Context, req *api.Issue) (token.Issuer, error) {
 
-	iss, err := azureissuer.New(ctx, req.InputAzure.Token)
+	out, err := retrieveSource(ctx, p.manipulator, req.SourceNamespace, req.SourceName, api.AzureSourceIdentity)
+	if err != nil {
+		return nil, err
+	}
+
+	src := out.(*api.AzureSource)
+	iss, err := azureissuer.New(ctx, src, req.InputAzure.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	return iss, nil
+}
+
+func (p *IssueProcessor) handleGCPIssue(ctx context.Context, req *api.Issue) (token.Issuer, error) {
+
+	out, err := retrieveSource(ctx, p.manipulator, req.SourceNamespace, req.SourceName, api.GCPSourceIdentity)
+	if err != nil {
+		return nil, err
+	}
+
+	src := out.(*api.GCPSource)
+	iss, err := gcpissuer.New(ctx, src, req.InputGCP.Token, req.InputGCP.Audience)
+	if err != nil {
+		return nil, err
+	}
+
+	return iss, nil
+}
+
+func (p *IssueProcessor) handleKubernetesIssue(ctx context.Context, req *api.Issue) (token.Issuer, error) {
+
+	out, err := retrieveSource(ctx, p.manipulator, req.SourceNamespace, req.SourceName, api.KubernetesSourceIdentity)
+	if err != nil {
+		return nil, err
+	}
+
+	src := out.(*api.KubernetesSource)
+	iss, err := kubernetesissuer.New(ctx, src, req.InputKubernetes.Token)
 	if err != nil {
 		return nil, err
 	}
@@ -325,9 +517,31 @@ func (p *IssueProcessor) handleAzureIssue(ctx context.Context, req *api.Issue) (
 	return iss, nil
 }
 
-func (p *IssueProcessor) handleGCPIssue(req *api.Issue) (token.Issuer, error) {
+func (p *IssueProcessor) handleJWTIssue(ctx context.Context, req *api.Issue) (token.Issuer, error) {
 
-	iss, err := gcpissuer.New(req.InputGCP.Token, req.InputGCP.Audience)
+	out, err := retrieveSource(ctx, p.manipulator, req.SourceNamespace, req.SourceName, api.JWTSourceIdentity)
+	if err != nil {
+		return nil, err
+	}
+
+	src := out.(*api.JWTSource)
+	iss, err := jwtissuer.New(ctx, src, req.InputJWT.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	return iss, nil
+}
+
+func (p *IssueProcessor) handleSPIFFEIssue(ctx context.Context, req *api.Issue) (token.Issuer, error) {
+
+	out, err := retrieveSource(ctx, p.manipulator, req.SourceNamespace, req.SourceName, api.SPIFFESourceIdentity)
+	if err != nil {
+		return nil, err
+	}
+
+	src := out.(*api.SPIFFESource)
+	iss, err := spiffeissuer.New(ctx, src, req.InputSPIFFE.Token)
 	if err != nil {
 		return nil, err
 	}
@@ -335,10 +549,15 @@ func (p *IssueProcessor) handleGCPIssue(req *api.Issue) (token.Issuer, error) {
 	return iss, nil
 }
 
-func (p *IssueProcessor) handleTokenIssue(req *api.Issue, validity time.Duration, audience []string) (token.Issuer, error) {
+func (p *IssueProcessor) handleTokenIssue(ctx context.Context, req *api.Issue, validity time.Duration, audience []string) (token.Issuer, error) {
+
+	tkn, err := p.resolveReference(ctx, req.InputA3S.Token)
+	if err != nil {
+		return nil, err
+	}
 
 	iss, err := a3sissuer.New(
-		req.InputA3S.Token,
+		tkn,
 		p.jwks,
 		p.issuer,
 		audience,
@@ -348,148 +567,738 @@ func (p *IssueProcessor) handleTokenIssue(req *api.Issue, validity time.Duration
 		return nil, err
 	}
 
+	if p.revocations != nil && p.revocations.IsRevoked(iss.Issue()) {
+		return nil, fmt.Errorf("the input token has been revoked")
+	}
+
 	return iss, nil
 }
 
-func (p *IssueProcessor) handleRemoteA3SIssue(ctx context.Context, req *api.Issue) (token.Issuer, error) {
+func (p *IssueProcessor) handleTokenExchangeIssue(ctx context.Context, req *api.Issue, validity time.Duration, audience []string) (token.Issuer, error) {
 
-	out, err := retrieveSource(ctx, p.manipulator, req.SourceNamespace, req.SourceName, api.A3SSourceIdentity)
+	subjectToken, err := p.resolveReference(ctx, req.InputTokenExchange.SubjectToken)
 	if err != nil {
 		return nil, err
 	}
 
-	src := out.(*api.A3SSource)
-	iss, err := remotea3sissuer.New(ctx, src, req.InputRemoteA3S.Token)
+	actorToken, err := p.resolveReference(ctx, req.InputTokenExchange.ActorToken)
+	if err != nil {
+		return nil, err
+	}
+
+	iss, err := exchangeissuer.New(
+		subjectToken,
+		actorToken,
+		p.jwks,
+		p.issuer,
+		p.audience,
+		audience,
+		validity,
+	)
 	if err != nil {
 		return nil, err
 	}
 
+	subject := iss.Issue()
+	actor := iss.Actor()
+
+	if p.revocations != nil {
+		if p.revocations.IsRevoked(subject) {
+			return nil, fmt.Errorf("the subject token has been revoked")
+		}
+		if p.revocations.IsRevoked(actor) {
+			return nil, fmt.Errorf("the actor token has been revoked")
+		}
+	}
+
+	var r permissions.Restrictions
+	if actor.Restrictions != nil {
+		r = *actor.Restrictions
+	}
+
+	ok, err := p.authorizer.CheckAuthorization(
+		ctx,
+		actor.Identity,
+		delegationPermissionOperation,
+		req.RestrictedNamespace,
+		delegationPermissionResource,
+		authorizer.OptionCheckRestrictions(r),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to check delegation permission: %w", err)
+	}
+
+	if !ok {
+		return nil, fmt.Errorf("the actor is not allowed to delegate in namespace '%s'", req.RestrictedNamespace)
+	}
+
+	zap.L().Info("Token exchanged",
+		zap.String("subject", subject.Subject),
+		zap.Strings("subject-identity", subject.Identity),
+		zap.String("actor", actor.Subject),
+		zap.Strings("actor-identity", actor.Identity),
+		zap.String("namespace", req.RestrictedNamespace),
+		zap.Strings("audience", req.Audience),
+	)
+
 	return iss, nil
 }
 
-func (p *IssueProcessor) handleOIDCIssue(bctx bahamut.Context, req *api.Issue) (token.Issuer, error) {
+// handleMFAIssue upgrades the given token using a TOTP code or a WebAuthn
+// credential enrolled by its bearer. If neither is given, it starts a WebAuthn
+// ceremony, and returns the options to pass to the authenticator.
+func (p *IssueProcessor) handleMFAIssue(bctx bahamut.Context, req *api.Issue, validity time.Duration, audience []string) (token.Issuer, error) {
 
-	state := req.InputOIDC.State
-	code := req.InputOIDC.Code
+	ctx := bctx.Context()
 
-	out, err := retrieveSource(
-		bctx.Context(),
-		p.manipulator,
-		req.SourceNamespace,
-		req.SourceName,
-		api.OIDCSourceIdentity,
+	tkn, err := p.resolveReference(ctx, req.InputMFA.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	iss, err := a3sissuer.New(
+		tkn,
+		p.jwks,
+		p.issuer,
+		audience,
+		validity,
 	)
 	if err != nil {
 		return nil, err
 	}
-	src := out.(*api.OIDCSource)
 
-	if code == "" && state == "" {
+	if iss.Refresh() != nil {
+		return nil, fmt.Errorf("a refresh token cannot be upgraded using a second factor")
+	}
 
-		client, err := oidcceremony.MakeOIDCProviderClient(src.CA)
+	idt := iss.Issue()
+
+	if p.revocations != nil && p.revocations.IsRevoked(idt) {
+		return nil, fmt.Errorf("the input token has been revoked")
+	}
+
+	if idt.Actor != nil {
+		return nil, fmt.Errorf("an exchanged token cannot be upgraded using a second factor")
+	}
+
+	subject, err := mfa.TokenSubject(idt)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.InputMFA.TOTP != "" {
+
+		creds, err := retrieveMFACredentials(ctx, p.manipulator, idt.Source.Namespace, subject, api.MFACredentialTypeTOTP)
 		if err != nil {
-			return nil, oidcceremony.RedirectErrorEventually(
-				bctx,
-				req.InputOIDC.RedirectErrorURL,
-				elemental.NewError(
-					"Bad Request",
-					err.Error(),
-					"a3s:authn",
-					http.StatusBadRequest,
-				),
-			)
+			return nil, err
 		}
 
-		oidcCtx := oidc.ClientContext(bctx.Context(), client)
-		provider, err := oidc.NewProvider(oidcCtx, src.Endpoint)
+		cred, step, done, err := matchTOTP(creds, req.InputMFA.TOTP, time.Now())
 		if err != nil {
-			return nil, oidcceremony.RedirectErrorEventually(
-				bctx,
-				req.InputOIDC.RedirectErrorURL,
-				elemental.NewError(
-					"Bad Request",
-					err.Error(),
-					"a3s:authn",
-					http.StatusBadRequest,
-				),
-			)
+			return nil, err
 		}
 
-		oauth2Config := oauth2.Config{
-			ClientID:     src.ClientID,
-			ClientSecret: src.ClientSecret,
-			RedirectURL:  req.InputOIDC.RedirectURL,
-			Endpoint:     provider.Endpoint(),
-			Scopes:       append([]string{oidc.ScopeOpenID}, src.Scopes...),
+		if cred == nil {
+			done(false)
+			return nil, fmt.Errorf("invalid totp code")
 		}
 
-		state, err = oidcceremony.GenerateNonce(12)
+		err = mfa.UseTOTP(ctx, p.manipulator, cred.ID, cred.TOTPLastStep, int(step))
+		done(err == nil)
 		if err != nil {
-			return nil, oidcceremony.RedirectErrorEventually(
-				bctx,
-				req.InputOIDC.RedirectErrorURL,
-				err,
-			)
+			return nil, err
 		}
 
-		cacheItem := &oidcceremony.CacheItem{
-			State:            state,
-			ProviderEndpoint: src.Endpoint,
-			CA:               src.CA,
-			ClientID:         src.ClientID,
-			OAuth2Config:     oauth2Config,
+		idt.Identity, idt.AMR = mfa.AddClaim(idt.Identity, idt.AMR, mfa.ClaimTOTP, mfa.AMROTP)
+
+		return iss, nil
+	}
+
+	creds, err := retrieveMFACredentials(ctx, p.manipulator, idt.Source.Namespace, subject, api.MFACredentialTypeWebAuthn)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(creds) == 0 {
+		return nil, fmt.Errorf("no second factor enrolled")
+	}
+
+	if req.InputMFA.WebAuthnResponse == "" {
+
+		challenge, err := mfa.NewWebAuthnChallenge()
+		if err != nil {
+			return nil, err
 		}
 
-		if err := oidcceremony.Set(p.manipulator, cacheItem); err != nil {
-			return nil, oidcceremony.RedirectErrorEventually(
-				bctx,
-				req.InputOIDC.RedirectErrorURL,
-				err,
-			)
+		allow := make([][]byte, 0, len(creds))
+		for _, cred := range creds {
+			if id, err := base64.RawURLEncoding.DecodeString(cred.WebAuthnCredentialID); err == nil {
+				allow = append(allow, id)
+			}
 		}
 
-		authURL := oauth2Config.AuthCodeURL(state)
+		if req.InputMFA.WebAuthnOptions, err = p.webAuthn.RequestOptions(challenge, allow); err != nil {
+			return nil, err
+		}
 
-		if req.InputOIDC.NoAuthRedirect {
-			req.InputOIDC.AuthURL = authURL
-			bctx.SetOutputData(req)
-		} else {
-			bctx.SetRedirect(authURL)
+		// The response can only be used to
+		// upgrade the token of this request.
+		if err := mfa.Set(p.manipulator, &mfa.CacheItem{
+			Challenge: challenge,
+			Namespace: idt.Source.Namespace,
+			TokenID:   idt.ID,
+		}); err != nil {
+			return nil, err
 		}
 
+		req.InputMFA.Token = ""
+		bctx.SetOutputData(req)
+
 		return nil, nil
 	}
 
-	oidcReq, err := oidcceremony.Get(p.manipulator, state)
+	resp, err := mfa.ParseWebAuthnResponse(req.InputMFA.WebAuthnResponse)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := oidcceremony.Delete(p.manipulator, state); err != nil {
-		return nil, err
+	item, err := mfa.Pop(p.manipulator, resp.Challenge())
+	if err != nil || item.TokenID == "" || item.TokenID != idt.ID {
+		return nil, fmt.Errorf("unknown or expired webauthn challenge")
 	}
 
-	client, err := oidcceremony.MakeOIDCProviderClient(oidcReq.CA)
-	if err != nil {
-		return nil, fmt.Errorf("unable to create oidc http client: %s", err)
-	}
+	credentialID := base64.RawURLEncoding.EncodeToString(resp.CredentialID())
 
-	oidcctx := oidc.ClientContext(bctx.Context(), client)
+	for _, cred := range creds {
 
-	oauth2Token, err := oidcReq.OAuth2Config.Exchange(oidcctx, code)
-	if err != nil {
-		return nil, elemental.NewError(
-			"OAuth2 Error",
-			err.Error(),
-			"a3s:authn",
-			http.StatusNotAcceptable,
-		)
-	}
+		if cred.WebAuthnCredentialID != credentialID {
+			continue
+		}
 
-	rawIDToken, ok := oauth2Token.Extra("id_token").(string)
-	if !ok {
-		return nil, fmt.Errorf("missing ID token")
-	}
+		pub, err := base64.StdEncoding.DecodeString(cred.WebAuthnPublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid webauthn public key: %w", err)
+		}
+
+		count, err := p.webAuthn.VerifyAssertion(resp, item.Challenge, &mfa.WebAuthnCredential{
+			ID:        resp.CredentialID(),
+			PublicKey: pub,
+			SignCount: uint32(cred.WebAuthnSignCount),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if err := mfa.UseWebAuthn(ctx, p.manipulator, cred.ID, cred.WebAuthnSignCount, int(count)); err != nil {
+			return nil, err
+		}
+
+		idt.Identity, idt.AMR = mfa.AddClaim(idt.Identity, idt.AMR, mfa.ClaimWebAuthn, mfa.AMRHWK)
+
+		return iss, nil
+	}
+
+	return nil, fmt.Errorf("unknown webauthn credential")
+}
+
+// matchTOTP returns the credential the given code is valid for among the
+// given TOTP credentials, and the step of the code, or nil if there is none.
+// The credentials locked by totpLimiter are skipped, and lockout.ErrLocked
+// is returned if they all are. Otherwise, the returned function must be
+// called with the outcome of the attempt.
+func matchTOTP(creds api.MFACredentialsList, code string, now time.Time) (*api.MFACredential, int64, func(bool), error) {
+
+	var releases []func(bool)
+	done := func(success bool) {
+		for _, release := range releases {
+			release(success)
+		}
+	}
+
+	var match *api.MFACredential
+	var matchStep int64
+
+	for _, cred := range creds {
+
+		release, err := totpLimiter.Acquire(cred.ID)
+		if err != nil {
+			continue
+		}
+		releases = append(releases, release)
+
+		step, ok, err := mfa.ValidateTOTP(cred.TOTPSecret, code, now, int64(cred.TOTPLastStep))
+		if err != nil {
+			done(false)
+			return nil, 0, nil, err
+		}
+
+		if ok {
+			match, matchStep = cred, step
+			break
+		}
+	}
+
+	if len(creds) > 0 && len(releases) == 0 {
+		return nil, 0, nil, lockout.ErrLocked
+	}
+
+	return match, matchStep, done, nil
+}
+
+// stripForgeableClaims removes the claims that only a3s can set from the given
+// token, if it has not been derived from a token issued by a3s. The @mfa and
+// reserved claims like @actor and the amr claim could otherwise be forged by
+// any source passing the keys of the claims through.
+func stripForgeableClaims(sourceType api.IssueSourceTypeValue, idt *token.IdentityToken) {
+
+	switch sourceType {
+	case api.IssueSourceTypeA3S, api.IssueSourceTypeMFA, api.IssueSourceTypeTokenExchange:
+	default:
+		idt.Identity = token.StripReservedClaims(mfa.StripClaims(idt.Identity))
+		idt.AMR = nil
+	}
+}
+
+// rotateRefreshToken signs the given next refresh token of a family and
+// registers it as the only one that can be used. If the refresh token
+// that was used has already been used before, the whole family is revoked.
+func (p *IssueProcessor) rotateRefreshToken(ctx context.Context, refresh *token.IdentityToken) (string, error) {
+
+	used := refresh.ID
+
+	tkn, err := p.sign(refresh, refresh.Audience, time.Time{}, nil)
+	if err != nil {
+		return "", err
+	}
+
+	if err := refreshfamily.Rotate(ctx, p.manipulator, refresh.RefreshFamily, used, refresh.ID, refresh.ExpiresAt.Time); err != nil {
+
+		if errors.Is(err, refreshfamily.ErrReused) {
+			p.revokeRefreshFamily(ctx, refresh.RefreshFamily)
+		}
+
+		return "", err
+	}
+
+	return tkn, nil
+}
+
+func (p *IssueProcessor) revokeRefreshFamily(ctx context.Context, family string) {
+
+	zap.L().Warn("Refresh token reuse detected: revoking family", zap.String("family", family))
+
+	if err := refreshfamily.Revoke(ctx, p.manipulator, family); err != nil {
+		zap.L().Error("Unable to revoke refresh token family", zap.String("family", family), zap.Error(err))
+	}
+
+	if p.revoker == nil {
+		return
+	}
+
+	rev := api.NewRevocation()
+	rev.TokenFamily = family
+	rev.Description = "Refresh token reuse detected"
+
+	if err := p.revoker.Revoke(ctx, rev); err != nil {
+		zap.L().Error("Unable to create revocation for refresh token family", zap.String("family", family), zap.Error(err))
+	}
+}
+
+func (p *IssueProcessor) sign(idt *token.IdentityToken, audience []string, exp time.Time, cloak []string) (string, error) {
+
+	k := p.jwks.GetActive()
+	if k == nil {
+		return "", elemental.NewError(
+			"Signing Error",
+			"No active signing key is available",
+			"a3s:authn",
+			http.StatusInternalServerError,
+		)
+	}
+
+	return idt.JWTWithSigningMethod(
+		k.SigningMethod(),
+		k.PrivateKey(),
+		k.KID,
+		p.issuer,
+		audience,
+		exp,
+		cloak,
+	)
+}
+
+// storeReference stores the given signed token and returns
+// the reference token referring to it.
+func (p *IssueProcessor) storeReference(ctx context.Context, tkn string, idt *token.IdentityToken) (string, error) {
+
+	ref, err := token.NewReference()
+	if err != nil {
+		return "", err
+	}
+
+	if err := reference.Store(ctx, p.manipulator, ref, tkn, idt); err != nil {
+		return "", fmt.Errorf("unable to store reference token: %w", err)
+	}
+
+	return ref, nil
+}
+
+// resolveReference returns the token referred to by the given
+// token if it is a reference token, or the token itself otherwise.
+func (p *IssueProcessor) resolveReference(ctx context.Context, tkn string) (string, error) {
+
+	if !token.IsReference(tkn) {
+		return tkn, nil
+	}
+
+	resolved, err := p.references.Resolve(ctx, tkn)
+	if err != nil {
+		return "", fmt.Errorf("unable to resolve reference token: %w", err)
+	}
+
+	return resolved, nil
+}
+
+func (p *IssueProcessor) handleRemoteA3SIssue(ctx context.Context, req *api.Issue) (token.Issuer, error) {
+
+	out, err := retrieveSource(ctx, p.manipulator, req.SourceNamespace, req.SourceName, api.A3SSourceIdentity)
+	if err != nil {
+		return nil, err
+	}
+
+	src := out.(*api.A3SSource)
+	iss, err := remotea3sissuer.New(ctx, src, req.InputRemoteA3S.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	return iss, nil
+}
+
+func (p *IssueProcessor) handleOIDCIssue(bctx bahamut.Context, req *api.Issue) (token.Issuer, error) {
+
+	state := req.InputOIDC.State
+	code := req.InputOIDC.Code
+
+	out, err := retrieveSource(
+		bctx.Context(),
+		p.manipulator,
+		req.SourceNamespace,
+		req.SourceName,
+		api.OIDCSourceIdentity,
+	)
+	if err != nil {
+		return nil, err
+	}
+	src := out.(*api.OIDCSource)
+
+	if req.InputOIDC.DeviceAuth {
+		return p.handleOIDCDeviceIssue(bctx, req, src)
+	}
+
+	if code == "" && state == "" {
+
+		oauth2Config, err := makeOIDCOAuth2Config(bctx.Context(), src, req.InputOIDC.RedirectURL)
+		if err != nil {
+			return nil, oidcceremony.RedirectErrorEventually(
+				bctx,
+				req.InputOIDC.RedirectErrorURL,
+				elemental.NewError(
+					"Bad Request",
+					err.Error(),
+					"a3s:authn",
+					http.StatusBadRequest,
+				),
+			)
+		}
+
+		state, err = oidcceremony.GenerateNonce(12)
+		if err != nil {
+			return nil, oidcceremony.RedirectErrorEventually(
+				bctx,
+				req.InputOIDC.RedirectErrorURL,
+				err,
+			)
+		}
+
+		nonce, err := oidcceremony.GenerateNonce(12)
+		if err != nil {
+			return nil, oidcceremony.RedirectErrorEventually(
+				bctx,
+				req.InputOIDC.RedirectErrorURL,
+				err,
+			)
+		}
+
+		cacheItem := &oidcceremony.CacheItem{
+			State:            state,
+			SourceNamespace:  src.Namespace,
+			SourceName:       src.Name,
+			ProviderEndpoint: src.Endpoint,
+			CA:               src.CA,
+			ClientID:         src.ClientID,
+			OAuth2Config:     oauth2Config,
+			CodeVerifier:     oauth2.GenerateVerifier(),
+			Nonce:            nonce,
+		}
+
+		if err := oidcceremony.Set(p.manipulator, cacheItem); err != nil {
+			return nil, oidcceremony.RedirectErrorEventually(
+				bctx,
+				req.InputOIDC.RedirectErrorURL,
+				err,
+			)
+		}
+
+		authURL := oauth2Config.AuthCodeURL(
+			state,
+			oauth2.S256ChallengeOption(cacheItem.CodeVerifier),
+			oidc.Nonce(cacheItem.Nonce),
+		)
+
+		if req.InputOIDC.NoAuthRedirect {
+			req.InputOIDC.AuthURL = authURL
+			bctx.SetOutputData(req)
+		} else {
+			bctx.SetRedirect(authURL)
+		}
+
+		return nil, nil
+	}
+
+	oidcReq, err := oidcceremony.Get(p.manipulator, state)
+	if err != nil {
+		return nil, err
+	}
+
+	if oidcReq.DeviceCode != "" {
+		return nil, fmt.Errorf("the state has been issued for a device authorization request")
+	}
+
+	if err := oidcceremony.Delete(p.manipulator, state); err != nil {
+		return nil, err
+	}
+
+	if oidcReq.SourceNamespace != src.Namespace || oidcReq.SourceName != src.Name {
+		return nil, fmt.Errorf("the state has not been issued for the requested source")
+	}
+
+	client, err := oidcceremony.MakeOIDCProviderClient(oidcReq.CA)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create oidc http client: %s", err)
+	}
+
+	oidcctx := oidc.ClientContext(bctx.Context(), client)
+
+	oauth2Token, err := oidcReq.OAuth2Config.Exchange(
+		oidcctx,
+		code,
+		oauth2.VerifierOption(oidcReq.CodeVerifier),
+	)
+	if err != nil {
+		return nil, elemental.NewError(
+			"OAuth2 Error",
+			err.Error(),
+			"a3s:authn",
+			http.StatusNotAcceptable,
+		)
+	}
+
+	claims, err := verifyOIDCToken(oidcctx, oidcReq, oauth2Token, src.FetchUserInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return oidcissuer.New(bctx.Context(), src, claims)
+}
+
+func (p *IssueProcessor) handleOIDCDeviceIssue(bctx bahamut.Context, req *api.Issue, src *api.OIDCSource) (token.Issuer, error) {
+
+	state := req.InputOIDC.State
+
+	if state == "" {
+
+		oauth2Config, err := makeOIDCOAuth2Config(bctx.Context(), src, "")
+		if err != nil {
+			return nil, elemental.NewError(
+				"Bad Request",
+				err.Error(),
+				"a3s:authn",
+				http.StatusBadRequest,
+			)
+		}
+
+		if oauth2Config.Endpoint.DeviceAuthURL == "" {
+			return nil, elemental.NewError(
+				"Bad Request",
+				"The OIDC provider does not support the device authorization flow",
+				"a3s:authn",
+				http.StatusBadRequest,
+			)
+		}
+
+		client, err := oidcceremony.MakeOIDCProviderClient(src.CA)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create oidc http client: %s", err)
+		}
+
+		da, err := oauth2Config.DeviceAuth(oidc.ClientContext(bctx.Context(), client))
+		if err != nil {
+			return nil, elemental.NewError(
+				"OAuth2 Error",
+				err.Error(),
+				"a3s:authn",
+				http.StatusNotAcceptable,
+			)
+		}
+
+		state, err = oidcceremony.GenerateNonce(12)
+		if err != nil {
+			return nil, err
+		}
+
+		// RFC 8628: if no interval is provided,
+		// clients must use 5 seconds.
+		interval := int(da.Interval)
+		if interval == 0 {
+			interval = 5
+		}
+
+		cacheItem := &oidcceremony.CacheItem{
+			State:            state,
+			SourceNamespace:  src.Namespace,
+			SourceName:       src.Name,
+			ProviderEndpoint: src.Endpoint,
+			CA:               src.CA,
+			ClientID:         src.ClientID,
+			OAuth2Config:     oauth2Config,
+			DeviceCode:       da.DeviceCode,
+			DeviceExpiry:     da.Expiry,
+			Interval:         interval,
+		}
+
+		if err := oidcceremony.Set(p.manipulator, cacheItem); err != nil {
+			return nil, err
+		}
+
+		req.InputOIDC.State = state
+		req.InputOIDC.UserCode = da.UserCode
+		req.InputOIDC.VerificationURI = da.VerificationURI
+		req.InputOIDC.VerificationURIComplete = da.VerificationURIComplete
+		req.InputOIDC.Interval = interval
+		bctx.SetOutputData(req)
+
+		return nil, nil
+	}
+
+	oidcReq, err := oidcceremony.Get(p.manipulator, state)
+	if err != nil {
+		return nil, err
+	}
+
+	if oidcReq.DeviceCode == "" {
+		return nil, fmt.Errorf("the state has not been issued for a device authorization request")
+	}
+
+	if oidcReq.SourceNamespace != src.Namespace || oidcReq.SourceName != src.Name {
+		return nil, fmt.Errorf("the state has not been issued for the requested source")
+	}
+
+	if !oidcReq.DeviceExpiry.IsZero() && time.Now().After(oidcReq.DeviceExpiry) {
+		_ = oidcceremony.Delete(p.manipulator, state)
+		return nil, fmt.Errorf("the device authorization request has expired")
+	}
+
+	client, err := oidcceremony.MakeOIDCProviderClient(oidcReq.CA)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create oidc http client: %s", err)
+	}
+
+	oidcctx := oidc.ClientContext(bctx.Context(), client)
+
+	oauth2Token, err := oidcceremony.RetrieveDeviceToken(oidcctx, client, &oidcReq.OAuth2Config, oidcReq.DeviceCode)
+	switch {
+
+	case errors.Is(err, oidcceremony.ErrAuthorizationPending), errors.Is(err, oidcceremony.ErrSlowDown):
+
+		// RFC 8628: the interval must be increased by
+		// 5 seconds for this and all subsequent requests.
+		if errors.Is(err, oidcceremony.ErrSlowDown) {
+			oidcReq.Interval += 5
+			if err := oidcceremony.SetInterval(p.manipulator, state, oidcReq.Interval); err != nil {
+				return nil, err
+			}
+		}
+
+		req.InputOIDC.AuthorizationPending = true
+		req.InputOIDC.Interval = oidcReq.Interval
+		bctx.SetOutputData(req)
+
+		return nil, nil
+
+	case err != nil:
+		_ = oidcceremony.Delete(p.manipulator, state)
+		return nil, elemental.NewError(
+			"OAuth2 Error",
+			err.Error(),
+			"a3s:authn",
+			http.StatusNotAcceptable,
+		)
+	}
+
+	if err := oidcceremony.Delete(p.manipulator, state); err != nil {
+		return nil, err
+	}
+
+	claims, err := verifyOIDCToken(oidcctx, oidcReq, oauth2Token, src.FetchUserInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return oidcissuer.New(bctx.Context(), src, claims)
+}
+
+// makeOIDCOAuth2Config discovers the endpoints of the provider
+// of the given source, and returns the oauth2.Config to use.
+func makeOIDCOAuth2Config(ctx context.Context, src *api.OIDCSource, redirectURL string) (oauth2.Config, error) {
+
+	client, err := oidcceremony.MakeOIDCProviderClient(src.CA)
+	if err != nil {
+		return oauth2.Config{}, err
+	}
+
+	provider, err := oidc.NewProvider(oidc.ClientContext(ctx, client), src.Endpoint)
+	if err != nil {
+		return oauth2.Config{}, err
+	}
+
+	endpoint := provider.Endpoint()
+
+	// Public clients have no secret to authenticate
+	// to the token endpoint. The client ID must be
+	// sent with the parameters.
+	if src.ClientSecret == "" {
+		endpoint.AuthStyle = oauth2.AuthStyleInParams
+	}
+
+	return oauth2.Config{
+		ClientID:     src.ClientID,
+		ClientSecret: src.ClientSecret,
+		RedirectURL:  redirectURL,
+		Endpoint:     endpoint,
+		Scopes:       append([]string{oidc.ScopeOpenID}, src.Scopes...),
+	}, nil
+}
+
+// verifyOIDCToken verifies the ID token returned with the given
+// oauth2.Token, and returns its claims. If fetchUserInfo is set, the
+// claims returned by the userinfo endpoint are merged with them.
+func verifyOIDCToken(oidcctx context.Context, oidcReq *oidcceremony.CacheItem, oauth2Token *oauth2.Token, fetchUserInfo bool) (map[string]any, error) {
+
+	rawIDToken, ok := oauth2Token.Extra("id_token").(string)
+	if !ok {
+		return nil, fmt.Errorf("missing ID token")
+	}
 
 	provider, err := oidc.NewProvider(oidcctx, oidcReq.ProviderEndpoint)
 	if err != nil {
@@ -517,6 +1326,16 @@ func (p *IssueProcessor) handleOIDCIssue(bctx bahamut.Context, req *api.Issue) (
 		)
 	}
 
+	// The device authorization flow does not use a nonce.
+	if oidcReq.DeviceCode == "" && subtle.ConstantTimeCompare([]byte(idToken.Nonce), []byte(oidcReq.Nonce)) != 1 {
+		return nil, elemental.NewError(
+			"OAuth2 Verification Error",
+			"ID token nonce does not match the one of the request",
+			"a3s:authn",
+			http.StatusNotAcceptable,
+		)
+	}
+
 	claims := map[string]any{}
 	if err := idToken.Claims(&claims); err != nil {
 		return nil, elemental.NewError(
@@ -527,7 +1346,242 @@ func (p *IssueProcessor) handleOIDCIssue(bctx bahamut.Context, req *api.Issue) (
 		)
 	}
 
-	return oidcissuer.New(bctx.Context(), src, claims)
+	if !fetchUserInfo {
+		return claims, nil
+	}
+
+	userInfo, err := provider.UserInfo(oidcctx, oauth2.StaticTokenSource(oauth2Token))
+	if err != nil {
+		return nil, elemental.NewError(
+			"OIDC Error",
+			fmt.Sprintf("unable to retrieve user info: %s", err),
+			"a3s:authn",
+			http.StatusUnauthorized,
+		)
+	}
+
+	// The sub claim of the userinfo response must be
+	// verified to prevent token substitution attacks.
+	if userInfo.Subject != idToken.Subject {
+		return nil, elemental.NewError(
+			"OIDC Error",
+			"The subject of the user info does not match the one of the ID token",
+			"a3s:authn",
+			http.StatusUnauthorized,
+		)
+	}
+
+	userInfoClaims := map[string]any{}
+	if err := userInfo.Claims(&userInfoClaims); err != nil {
+		return nil, elemental.NewError(
+			"Claims Decoding Error",
+			err.Error(),
+			"a3s:authn",
+			http.StatusNotAcceptable,
+		)
+	}
+
+	for k, v := range userInfoClaims {
+		if _, ok := claims[k]; !ok {
+			claims[k] = v
+		}
+	}
+
+	return claims, nil
+}
+
+func (p *IssueProcessor) handleSAMLIssue(bctx bahamut.Context, req *api.Issue) (token.Issuer, error) {
+
+	state := req.InputSAML.State
+
+	out, err := retrieveSource(
+		bctx.Context(),
+		p.manipulator,
+		req.SourceNamespace,
+		req.SourceName,
+		api.SAMLSourceIdentity,
+	)
+	if err != nil {
+		return nil, err
+	}
+	src := out.(*api.SAMLSource)
+
+	if state == "" {
+
+		if req.InputSAML.RedirectURL == "" {
+			return nil, elemental.NewError(
+				"Bad Request",
+				"You must set redirectURL to start a SAML ceremony",
+				"a3s:authn",
+				http.StatusBadRequest,
+			)
+		}
+
+		if !samlceremony.IsRedirectURLAllowed(src, req.InputSAML.RedirectURL) {
+			return nil, elemental.NewError(
+				"Bad Request",
+				"The redirectURL is not allowed by the SAML source",
+				"a3s:authn",
+				http.StatusBadRequest,
+			)
+		}
+
+		if req.InputSAML.RedirectErrorURL != "" && !samlceremony.IsRedirectURLAllowed(src, req.InputSAML.RedirectErrorURL) {
+			return nil, elemental.NewError(
+				"Bad Request",
+				"The redirectErrorURL is not allowed by the SAML source",
+				"a3s:authn",
+				http.StatusBadRequest,
+			)
+		}
+
+		sp, err := samlceremony.MakeServiceProvider(src, p.publicAPIURL)
+		if err != nil {
+			return nil, oidcceremony.RedirectErrorEventually(
+				bctx,
+				req.InputSAML.RedirectErrorURL,
+				elemental.NewError(
+					"Bad Request",
+					err.Error(),
+					"a3s:authn",
+					http.StatusBadRequest,
+				),
+			)
+		}
+
+		authReq, err := sp.MakeAuthenticationRequest(
+			sp.GetSSOBindingLocation(saml.HTTPRedirectBinding),
+			saml.HTTPRedirectBinding,
+			saml.HTTPPostBinding,
+		)
+		if err != nil {
+			return nil, oidcceremony.RedirectErrorEventually(
+				bctx,
+				req.InputSAML.RedirectErrorURL,
+				elemental.NewError(
+					"Bad Request",
+					err.Error(),
+					"a3s:authn",
+					http.StatusBadRequest,
+				),
+			)
+		}
+
+		state, err = samlceremony.GenerateState(12)
+		if err != nil {
+			return nil, oidcceremony.RedirectErrorEventually(
+				bctx,
+				req.InputSAML.RedirectErrorURL,
+				err,
+			)
+		}
+
+		authURL, err := authReq.Redirect(state, sp)
+		if err != nil {
+			return nil, oidcceremony.RedirectErrorEventually(
+				bctx,
+				req.InputSAML.RedirectErrorURL,
+				err,
+			)
+		}
+
+		// The verifier is only given to the client starting
+		// the ceremony, so a leaked state cannot be redeemed
+		// by anyone else.
+		verifier, err := samlceremony.GenerateState(32)
+		if err != nil {
+			return nil, oidcceremony.RedirectErrorEventually(
+				bctx,
+				req.InputSAML.RedirectErrorURL,
+				err,
+			)
+		}
+
+		cacheItem := &samlceremony.CacheItem{
+			State:            state,
+			RequestID:        authReq.ID,
+			SourceNamespace:  src.Namespace,
+			SourceName:       src.Name,
+			RedirectURL:      req.InputSAML.RedirectURL,
+			RedirectErrorURL: req.InputSAML.RedirectErrorURL,
+			VerifierHash:     samlceremony.HashVerifier(verifier),
+		}
+
+		if err := samlceremony.Set(p.manipulator, cacheItem); err != nil {
+			return nil, oidcceremony.RedirectErrorEventually(
+				bctx,
+				req.InputSAML.RedirectErrorURL,
+				err,
+			)
+		}
+
+		if req.InputSAML.NoAuthRedirect {
+			req.InputSAML.AuthURL = authURL.String()
+			req.InputSAML.Verifier = verifier
+			bctx.SetOutputData(req)
+		} else {
+			domain := req.CookieDomain
+			if domain == "" {
+				domain = p.cookieDomain
+			}
+			bctx.AddOutputCookies(
+				&http.Cookie{
+					Name:     samlceremony.VerifierCookie,
+					Value:    verifier,
+					HttpOnly: true,
+					Secure:   true,
+					Expires:  time.Now().Add(5 * time.Minute),
+					SameSite: p.cookieSameSitePolicy,
+					Domain:   domain,
+				},
+			)
+			bctx.SetRedirect(authURL.String())
+		}
+
+		return nil, nil
+	}
+
+	samlReq, err := samlceremony.Get(p.manipulator, state)
+	if err != nil {
+		return nil, err
+	}
+
+	verifier := req.InputSAML.Verifier
+	if verifier == "" {
+		if hreq := bctx.Request().HTTPRequest(); hreq != nil {
+			if cookie, err := hreq.Cookie(samlceremony.VerifierCookie); err == nil {
+				verifier = cookie.Value
+			}
+		}
+	}
+
+	if !samlceremony.CheckVerifier(samlReq, verifier) {
+		return nil, elemental.NewError(
+			"Forbidden",
+			"The state has not been issued for this client",
+			"a3s:authn",
+			http.StatusForbidden,
+		)
+	}
+
+	if err := samlceremony.Delete(p.manipulator, state); err != nil {
+		return nil, err
+	}
+
+	if samlReq.Response == "" {
+		return nil, fmt.Errorf("no response received from the identity provider")
+	}
+
+	if samlReq.SourceNamespace != src.Namespace || samlReq.SourceName != src.Name {
+		return nil, fmt.Errorf("the state has not been issued for the requested source")
+	}
+
+	sp, err := samlceremony.MakeServiceProvider(src, p.publicAPIURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return samlissuer.New(bctx.Context(), src, sp, samlReq.Response, samlReq.RequestID)
 }
 
 func retrieveSource(
@@ -0,0 +1,447 @@
+package processors
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/golang-jwt/jwt/v4"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.aporeto.io/a3s/internal/lockout"
+	"go.aporeto.io/a3s/internal/mfa"
+	"go.aporeto.io/a3s/internal/oidcceremony"
+	"go.aporeto.io/a3s/pkgs/api"
+	"go.aporeto.io/a3s/pkgs/authorizer"
+	"go.aporeto.io/a3s/pkgs/token"
+	"go.aporeto.io/bahamut"
+	"golang.org/x/oauth2"
+)
+
+type fakeAuthorizer struct {
+	allowed   bool
+	claims    []string
+	operation string
+	namespace string
+	resource  string
+}
+
+func (a *fakeAuthorizer) IsAuthorized(bctx bahamut.Context) (bahamut.AuthAction, error) {
+	return bahamut.AuthActionContinue, nil
+}
+
+func (a *fakeAuthorizer) CheckAuthorization(ctx context.Context, claims []string, op string, ns string, resource string, opts ...authorizer.OptionCheck) (bool, error) {
+	a.claims = claims
+	a.operation = op
+	a.namespace = ns
+	a.resource = resource
+	return a.allowed, nil
+}
+
+func TestHandleTokenExchangeIssue(t *testing.T) {
+
+	Convey("Given an issue processor", t, func() {
+
+		jwks, k := makeTestJWKS()
+		authz := &fakeAuthorizer{}
+		p := NewIssueProcessor(nil, jwks, nil, nil, authz, time.Hour, 24*time.Hour, "iss", "aud", 0, "", false, "", "", "", nil)
+
+		subject, _ := makeTestToken(k, "/a")
+
+		actor := token.NewIdentityToken(token.Source{Type: "mtls", Namespace: "/a", Name: "services"})
+		actor.Identity = []string{"commonname=service-a"}
+		actorToken, err := actor.JWT(k.PrivateKey(), k.KID, "iss", jwt.ClaimStrings{"aud"}, time.Now().Add(time.Hour), nil)
+		So(err, ShouldBeNil)
+
+		req := api.NewIssue()
+		req.SourceType = api.IssueSourceTypeTokenExchange
+		req.RestrictedNamespace = "/a/b"
+		req.InputTokenExchange = &api.IssueTokenExchange{
+			SubjectToken: subject,
+			ActorToken:   actorToken,
+		}
+
+		Convey("When the actor is allowed to delegate", func() {
+
+			authz.allowed = true
+
+			iss, err := p.handleTokenExchangeIssue(context.Background(), req, time.Hour, []string{"aud"})
+
+			So(err, ShouldBeNil)
+			So(iss.Issue().Actor.Identity, ShouldContain, "commonname=service-a")
+			So(authz.claims, ShouldContain, "commonname=service-a")
+			So(authz.operation, ShouldEqual, "delegate")
+			So(authz.resource, ShouldEqual, "token")
+			So(authz.namespace, ShouldEqual, "/a/b")
+		})
+
+		Convey("When the actor is not allowed to delegate", func() {
+
+			iss, err := p.handleTokenExchangeIssue(context.Background(), req, time.Hour, []string{"aud"})
+
+			So(iss, ShouldBeNil)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "the actor is not allowed to delegate in namespace '/a/b'")
+		})
+
+		Convey("When the requested audience is not declared in the subject token", func() {
+
+			authz.allowed = true
+
+			iss, err := p.handleTokenExchangeIssue(context.Background(), req, time.Hour, []string{"other"})
+
+			So(iss, ShouldBeNil)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "unable to parse subject token: requested audience 'other' is not declared in subject token")
+		})
+	})
+}
+
+func Test_stripForgeableClaims(t *testing.T) {
+
+	claims := []string{
+		"@actor:identity=commonname=service-a",
+		"@issuer=https://a3s.com",
+		"@mfa=totp",
+		"@source:namespace=/",
+		"email=alice@example.com",
+	}
+
+	tests := []struct {
+		name       string
+		sourceType api.IssueSourceTypeValue
+
+		wantIdentity []string
+		wantAMR      []string
+	}{
+		{
+			"oidc source",
+			api.IssueSourceTypeOIDC,
+			[]string{"email=alice@example.com"},
+			nil,
+		},
+		{
+			"http source",
+			api.IssueSourceTypeHTTP,
+			[]string{"email=alice@example.com"},
+			nil,
+		},
+		{
+			"a3s source",
+			api.IssueSourceTypeA3S,
+			claims,
+			[]string{"otp"},
+		},
+		{
+			"mfa source",
+			api.IssueSourceTypeMFA,
+			claims,
+			[]string{"otp"},
+		},
+		{
+			"token exchange",
+			api.IssueSourceTypeTokenExchange,
+			claims,
+			[]string{"otp"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			idt := token.NewIdentityToken(token.Source{Type: "src"})
+			idt.Identity = append([]string{}, claims...)
+			idt.AMR = []string{"otp"}
+
+			stripForgeableClaims(tt.sourceType, idt)
+
+			if !reflect.DeepEqual(idt.Identity, tt.wantIdentity) {
+				t.Errorf("stripForgeableClaims identity = %v, want %v", idt.Identity, tt.wantIdentity)
+			}
+
+			if !reflect.DeepEqual(idt.AMR, tt.wantAMR) {
+				t.Errorf("stripForgeableClaims amr = %v, want %v", idt.AMR, tt.wantAMR)
+			}
+		})
+	}
+}
+
+type fakeOIDCProvider struct {
+	*httptest.Server
+	key           *rsa.PrivateKey
+	nonce         string
+	codeChallenge string
+	form          url.Values
+	hasBasic      bool
+}
+
+func newFakeOIDCProvider() *fakeOIDCProvider {
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+
+	p := &fakeOIDCProvider{key: key}
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"issuer":                                p.URL,
+			"authorization_endpoint":                p.URL + "/auth",
+			"token_endpoint":                        p.URL + "/token",
+			"jwks_uri":                              p.URL + "/jwks",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	})
+
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"keys": []map[string]string{
+				{
+					"kty": "RSA",
+					"kid": "k",
+					"alg": "RS256",
+					"use": "sig",
+					"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+					"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+				},
+			},
+		})
+	})
+
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+
+		_ = r.ParseForm()
+		p.form = r.PostForm
+		_, _, p.hasBasic = r.BasicAuth()
+
+		sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+		if base64.RawURLEncoding.EncodeToString(sum[:]) != p.codeChallenge {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+			return
+		}
+
+		idt := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"iss":   p.URL,
+			"aud":   "client",
+			"sub":   "john",
+			"nonce": p.nonce,
+			"iat":   time.Now().Unix(),
+			"exp":   time.Now().Add(time.Hour).Unix(),
+		})
+		idt.Header["kid"] = "k"
+
+		rawIDToken, err := idt.SignedString(key)
+		if err != nil {
+			panic(err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "at",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token":     rawIDToken,
+		})
+	})
+
+	p.Server = httptest.NewServer(mux)
+
+	return p
+}
+
+func TestOIDCCeremony(t *testing.T) {
+
+	Convey("Given a fake OIDC provider and a source", t, func() {
+
+		provider := newFakeOIDCProvider()
+		defer provider.Close()
+
+		src := api.NewOIDCSource()
+		src.Endpoint = provider.URL
+		src.ClientID = "client"
+		src.ClientSecret = "secret"
+
+		// exchange runs the ceremony as handleOIDCIssue does, starting
+		// from the authorization URL sent to the user agent.
+		exchange := func(src *api.OIDCSource) (*oauth2.Config, map[string]any, error) {
+
+			cfg, err := makeOIDCOAuth2Config(context.Background(), src, "https://redirect")
+			So(err, ShouldBeNil)
+
+			item := &oidcceremony.CacheItem{
+				ProviderEndpoint: src.Endpoint,
+				ClientID:         src.ClientID,
+				OAuth2Config:     cfg,
+				CodeVerifier:     oauth2.GenerateVerifier(),
+				Nonce:            "nonce",
+			}
+
+			authURL, err := url.Parse(cfg.AuthCodeURL(
+				"state",
+				oauth2.S256ChallengeOption(item.CodeVerifier),
+				oidc.Nonce(item.Nonce),
+			))
+			So(err, ShouldBeNil)
+			So(authURL.Query().Get("code_challenge_method"), ShouldEqual, "S256")
+			So(authURL.Query().Get("nonce"), ShouldEqual, "nonce")
+
+			provider.codeChallenge = authURL.Query().Get("code_challenge")
+			if provider.nonce == "" {
+				provider.nonce = authURL.Query().Get("nonce")
+			}
+
+			oauth2Token, err := cfg.Exchange(context.Background(), "code", oauth2.VerifierOption(item.CodeVerifier))
+			if err != nil {
+				return &cfg, nil, err
+			}
+
+			claims, err := verifyOIDCToken(context.Background(), item, oauth2Token, false)
+			return &cfg, claims, err
+		}
+
+		Convey("When the ceremony is completed", func() {
+
+			cfg, claims, err := exchange(src)
+
+			So(err, ShouldBeNil)
+			So(claims["sub"], ShouldEqual, "john")
+			So(cfg.Endpoint.AuthStyle, ShouldEqual, oauth2.AuthStyleAutoDetect)
+			So(cfg.Scopes, ShouldResemble, []string{oidc.ScopeOpenID})
+			So(provider.form.Get("code_verifier"), ShouldNotBeEmpty)
+			So(provider.hasBasic, ShouldBeTrue)
+		})
+
+		Convey("When the code verifier does not match the challenge", func() {
+
+			provider.nonce = "nonce"
+
+			cfg, err := makeOIDCOAuth2Config(context.Background(), src, "https://redirect")
+			So(err, ShouldBeNil)
+
+			provider.codeChallenge = oauth2.S256ChallengeFromVerifier(oauth2.GenerateVerifier())
+
+			_, err = cfg.Exchange(context.Background(), "code", oauth2.VerifierOption(oauth2.GenerateVerifier()))
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("When the nonce of the ID token does not match", func() {
+
+			provider.nonce = "not-the-nonce"
+
+			_, claims, err := exchange(src)
+
+			So(claims, ShouldBeNil)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "error 406 (a3s:authn): OAuth2 Verification Error: ID token nonce does not match the one of the request")
+		})
+
+		Convey("When the source is a public client", func() {
+
+			src.ClientSecret = ""
+
+			cfg, claims, err := exchange(src)
+
+			So(err, ShouldBeNil)
+			So(claims["sub"], ShouldEqual, "john")
+			So(cfg.Endpoint.AuthStyle, ShouldEqual, oauth2.AuthStyleInParams)
+			So(provider.hasBasic, ShouldBeFalse)
+			So(provider.form.Get("client_id"), ShouldEqual, "client")
+			So(provider.form.Get("client_secret"), ShouldBeEmpty)
+		})
+	})
+}
+
+func TestMatchTOTP(t *testing.T) {
+
+	Convey("Given TOTP credentials and a limiter", t, func() {
+
+		limiter := totpLimiter
+		totpLimiter = lockout.NewLimiter(2, time.Hour, time.Hour)
+		Reset(func() { totpLimiter = limiter })
+
+		secret1, err := mfa.GenerateTOTPSecret()
+		So(err, ShouldBeNil)
+		secret2, err := mfa.GenerateTOTPSecret()
+		So(err, ShouldBeNil)
+
+		cred1 := api.NewMFACredential()
+		cred1.ID = "cred1"
+		cred1.TOTPSecret = secret1
+
+		cred2 := api.NewMFACredential()
+		cred2.ID = "cred2"
+		cred2.TOTPSecret = secret2
+
+		creds := api.MFACredentialsList{cred1, cred2}
+		now := time.Now()
+
+		code2, err := mfa.TOTPCode(secret2, now)
+		So(err, ShouldBeNil)
+
+		fail := func() error {
+			cred, _, done, err := matchTOTP(api.MFACredentialsList{cred1}, "000000", now)
+			if err != nil {
+				return err
+			}
+			So(cred, ShouldBeNil)
+			done(false)
+			return nil
+		}
+
+		Convey("When I use a valid code", func() {
+
+			cred, step, done, err := matchTOTP(creds, code2, now)
+			So(err, ShouldBeNil)
+			done(true)
+
+			Convey("Then the matching credential should be returned", func() {
+				So(cred, ShouldEqual, cred2)
+				So(step, ShouldEqual, now.Unix()/30)
+			})
+		})
+
+		Convey("When too many invalid codes have been used", func() {
+
+			So(fail(), ShouldBeNil)
+			So(fail(), ShouldBeNil)
+
+			Convey("Then the credential should be locked", func() {
+				So(fail(), ShouldEqual, lockout.ErrLocked)
+			})
+
+			Convey("Then the other credentials should still be usable", func() {
+				cred, _, done, err := matchTOTP(creds, code2, now)
+				So(err, ShouldBeNil)
+				So(cred, ShouldEqual, cred2)
+				done(true)
+			})
+
+			Convey("Then a valid code for the locked credential should be rejected", func() {
+				code1, err := mfa.TOTPCode(secret1, now)
+				So(err, ShouldBeNil)
+
+				cred, _, done, err := matchTOTP(creds, code1, now)
+				So(err, ShouldBeNil)
+				So(cred, ShouldBeNil)
+				done(false)
+			})
+		})
+	})
+}
@@ -0,0 +1,50 @@
+package processors
+
+import (
+	"go.aporeto.io/a3s/pkgs/api"
+	"go.aporeto.io/a3s/pkgs/crud"
+	"go.aporeto.io/bahamut"
+	"go.aporeto.io/manipulate"
+)
+
+// A JWTSourcesProcessor is a bahamut processor for JWTSource.
+type JWTSourcesProcessor struct {
+	manipulator manipulate.Manipulator
+}
+
+// NewJWTSourcesProcessor returns a new JWTSourcesProcessor.
+func NewJWTSourcesProcessor(manipulator manipulate.Manipulator) *JWTSourcesProcessor {
+	return &JWTSourcesProcessor{
+		manipulator: manipulator,
+	}
+}
+
+// ProcessCreate handles the creates requests for JWTSource.
+func (p *JWTSourcesProcessor) ProcessCreate(bctx bahamut.Context) error {
+	return crud.Create(bctx, p.manipulator, bctx.InputData().(*api.JWTSource))
+}
+
+// ProcessRetrieveMany handles the retrieve many requests for JWTSource.
+func (p *JWTSourcesProcessor) ProcessRetrieveMany(bctx bahamut.Context) error {
+	return crud.RetrieveMany(bctx, p.manipulator, &api.JWTSourcesList{})
+}
+
+// ProcessRetrieve handles the retrieve requests for JWTSource.
+func (p *JWTSourcesProcessor) ProcessRetrieve(bctx bahamut.Context) error {
+	return crud.Retrieve(bctx, p.manipulator, api.NewJWTSource())
+}
+
+// ProcessUpdate handles the update requests for JWTSource.
+func (p *JWTSourcesProcessor) ProcessUpdate(bctx bahamut.Context) error {
+	return crud.Update(bctx, p.manipulator, bctx.InputData().(*api.JWTSource))
+}
+
+// ProcessDelete handles the delete requests for JWTSource.
+func (p *JWTSourcesProcessor) ProcessDelete(bctx bahamut.Context) error {
+	return crud.Delete(bctx, p.manipulator, api.NewJWTSource())
+}
+
+// ProcessInfo handles the info request for JWTSource.
+func (p *JWTSourcesProcessor) ProcessInfo(bctx bahamut.Context) error {
+	return crud.Info(bctx, p.manipulator, api.JWTSourceIdentity)
+}
@@ -0,0 +1,50 @@
+package processors
+
+import (
+	"go.aporeto.io/a3s/pkgs/api"
+	"go.aporeto.io/a3s/pkgs/crud"
+	"go.aporeto.io/bahamut"
+	"go.aporeto.io/manipulate"
+)
+
+// A KubernetesSourcesProcessor is a bahamut processor for KubernetesSource.
+type KubernetesSourcesProcessor struct {
+	manipulator manipulate.Manipulator
+}
+
+// NewKubernetesSourcesProcessor returns a new KubernetesSourcesProcessor.
+func NewKubernetesSourcesProcessor(manipulator manipulate.Manipulator) *KubernetesSourcesProcessor {
+	return &KubernetesSourcesProcessor{
+		manipulator: manipulator,
+	}
+}
+
+// ProcessCreate handles the creates requests for KubernetesSource.
+func (p *KubernetesSourcesProcessor) ProcessCreate(bctx bahamut.Context) error {
+	return crud.Create(bctx, p.manipulator, bctx.InputData().(*api.KubernetesSource))
+}
+
+// ProcessRetrieveMany handles the retrieve many requests for KubernetesSource.
+func (p *KubernetesSourcesProcessor) ProcessRetrieveMany(bctx bahamut.Context) error {
+	return crud.RetrieveMany(bctx, p.manipulator, &api.KubernetesSourcesList{})
+}
+
+// ProcessRetrieve handles the retrieve requests for KubernetesSource.
+func (p *KubernetesSourcesProcessor) ProcessRetrieve(bctx bahamut.Context) error {
+	return crud.Retrieve(bctx, p.manipulator, api.NewKubernetesSource())
+}
+
+// ProcessUpdate handles the update requests for KubernetesSource.
+func (p *KubernetesSourcesProcessor) ProcessUpdate(bctx bahamut.Context) error {
+	return crud.Update(bctx, p.manipulator, bctx.InputData().(*api.KubernetesSource))
+}
+
+// ProcessDelete handles the delete requests for KubernetesSource.
+func (p *KubernetesSourcesProcessor) ProcessDelete(bctx bahamut.Context) error {
+	return crud.Delete(bctx, p.manipulator, api.NewKubernetesSource())
+}
+
+// ProcessInfo handles the info request for KubernetesSource.
+func (p *KubernetesSourcesProcessor) ProcessInfo(bctx bahamut.Context) error {
+	return crud.Info(bctx, p.manipulator, api.KubernetesSourceIdentity)
+}
@@ -0,0 +1,80 @@
+package processors
+
+import (
+	"context"
+
+	"go.aporeto.io/a3s/pkgs/api"
+	"go.aporeto.io/a3s/pkgs/crud"
+	"go.aporeto.io/bahamut"
+	"go.aporeto.io/elemental"
+	"go.aporeto.io/manipulate"
+	"go.uber.org/zap"
+)
+
+// A LocalSourcesProcessor is a bahamut processor for LocalSource.
+type LocalSourcesProcessor struct {
+	manipulator manipulate.Manipulator
+}
+
+// NewLocalSourcesProcessor returns a new LocalSourcesProcessor.
+func NewLocalSourcesProcessor(manipulator manipulate.Manipulator) *LocalSourcesProcessor {
+	return &LocalSourcesProcessor{
+		manipulator: manipulator,
+	}
+}
+
+// ProcessCreate handles the creates requests for LocalSource.
+func (p *LocalSourcesProcessor) ProcessCreate(bctx bahamut.Context) error {
+	return crud.Create(bctx, p.manipulator, bctx.InputData().(*api.LocalSource))
+}
+
+// ProcessRetrieveMany handles the retrieve many requests for LocalSource.
+func (p *LocalSourcesProcessor) ProcessRetrieveMany(bctx bahamut.Context) error {
+	return crud.RetrieveMany(bctx, p.manipulator, &api.LocalSourcesList{})
+}
+
+// ProcessRetrieve handles the retrieve requests for LocalSource.
+func (p *LocalSourcesProcessor) ProcessRetrieve(bctx bahamut.Context) error {
+	return crud.Retrieve(bctx, p.manipulator, api.NewLocalSource())
+}
+
+// ProcessUpdate handles the update requests for LocalSource.
+func (p *LocalSourcesProcessor) ProcessUpdate(bctx bahamut.Context) error {
+	return crud.Update(bctx, p.manipulator, bctx.InputData().(*api.LocalSource))
+}
+
+// ProcessDelete handles the delete requests for LocalSource.
+func (p *LocalSourcesProcessor) ProcessDelete(bctx bahamut.Context) error {
+	return crud.Delete(bctx, p.manipulator, api.NewLocalSource(),
+		crud.OptionPostWriteHook(p.deleteUsers),
+	)
+}
+
+// ProcessInfo handles the info request for LocalSource.
+func (p *LocalSourcesProcessor) ProcessInfo(bctx bahamut.Context) error {
+	return crud.Info(bctx, p.manipulator, api.LocalSourceIdentity)
+}
+
+// deleteUsers deletes the local users of the deleted source,
+// so they are not inherited by a source created later with
+// the same name.
+func (p *LocalSourcesProcessor) deleteUsers(obj elemental.Identifiable) {
+
+	src := obj.(*api.LocalSource)
+
+	mctx := manipulate.NewContext(
+		context.Background(),
+		manipulate.ContextOptionNamespace(src.Namespace),
+		manipulate.ContextOptionFilter(
+			elemental.NewFilterComposer().WithKey("sourceName").Equals(src.Name).Done(),
+		),
+	)
+
+	if err := p.manipulator.DeleteMany(mctx, api.LocalUserIdentity); err != nil {
+		zap.L().Error("Unable to delete local users of deleted source",
+			zap.String("namespace", src.Namespace),
+			zap.String("source", src.Name),
+			zap.Error(err),
+		)
+	}
+}
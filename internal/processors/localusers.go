@@ -0,0 +1,160 @@
+package processors
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"go.aporeto.io/a3s/internal/localpassword"
+	"go.aporeto.io/a3s/internal/lockout"
+	"go.aporeto.io/a3s/pkgs/api"
+	"go.aporeto.io/a3s/pkgs/crud"
+	"go.aporeto.io/bahamut"
+	"go.aporeto.io/elemental"
+	"go.aporeto.io/manipulate"
+)
+
+// localLimiter limits the failed attempts to verify the password
+// of the local users, as hashing the passwords is costly and
+// can be done without being authenticated.
+var localLimiter = lockout.NewLimiter(5, time.Second, 15*time.Minute)
+
+// A LocalUsersProcessor is a bahamut processor for LocalUser.
+type LocalUsersProcessor struct {
+	manipulator manipulate.Manipulator
+}
+
+// NewLocalUsersProcessor returns a new LocalUsersProcessor.
+func NewLocalUsersProcessor(manipulator manipulate.Manipulator) *LocalUsersProcessor {
+	return &LocalUsersProcessor{
+		manipulator: manipulator,
+	}
+}
+
+// ProcessCreate handles the creates requests for LocalUser.
+func (p *LocalUsersProcessor) ProcessCreate(bctx bahamut.Context) error {
+	return crud.Create(bctx, p.manipulator, bctx.InputData().(*api.LocalUser),
+		crud.OptionPreWriteHook(p.makePreCreateHook(bctx.Context())),
+	)
+}
+
+// ProcessRetrieveMany handles the retrieve many requests for LocalUser.
+func (p *LocalUsersProcessor) ProcessRetrieveMany(bctx bahamut.Context) error {
+	return crud.RetrieveMany(bctx, p.manipulator, &api.LocalUsersList{})
+}
+
+// ProcessRetrieve handles the retrieve requests for LocalUser.
+func (p *LocalUsersProcessor) ProcessRetrieve(bctx bahamut.Context) error {
+	return crud.Retrieve(bctx, p.manipulator, api.NewLocalUser())
+}
+
+// ProcessUpdate handles the update requests for LocalUser.
+func (p *LocalUsersProcessor) ProcessUpdate(bctx bahamut.Context) error {
+	return crud.Update(bctx, p.manipulator, bctx.InputData().(*api.LocalUser))
+}
+
+// ProcessDelete handles the delete requests for LocalUser.
+func (p *LocalUsersProcessor) ProcessDelete(bctx bahamut.Context) error {
+	return crud.Delete(bctx, p.manipulator, api.NewLocalUser())
+}
+
+// ProcessInfo handles the info request for LocalUser.
+func (p *LocalUsersProcessor) ProcessInfo(bctx bahamut.Context) error {
+	return crud.Info(bctx, p.manipulator, api.LocalUserIdentity)
+}
+
+// makePreCreateHook returns a hook making sure the user is unique
+// in its source, and replacing the password by its hash.
+func (p *LocalUsersProcessor) makePreCreateHook(ctx context.Context) crud.PreWriteHook {
+
+	return func(obj elemental.Identifiable, original elemental.Identifiable) error {
+
+		user := obj.(*api.LocalUser)
+
+		out, err := retrieveSource(ctx, p.manipulator, user.Namespace, user.SourceName, api.LocalSourceIdentity)
+		if err != nil {
+			return err
+		}
+
+		existing, err := retrieveLocalUser(ctx, p.manipulator, user.Namespace, user.SourceName, user.Username)
+		if err != nil {
+			return err
+		}
+
+		if existing != nil {
+			return elemental.NewError(
+				"Conflict",
+				"A user with the same username already exists in the local source",
+				"a3s:localuser",
+				http.StatusConflict,
+			)
+		}
+
+		return setLocalUserPassword(out.(*api.LocalSource), user, user.Password, "password")
+	}
+}
+
+// localUserKey returns the key identifying the given
+// user of the given local source in localLimiter.
+func localUserKey(namespace string, sourceName string, username string) string {
+	return namespace + "\n" + sourceName + "\n" + username
+}
+
+// retrieveLocalUser returns the local user with the given username
+// in the given local source. It returns nil if the user does not exist.
+func retrieveLocalUser(
+	ctx context.Context,
+	m manipulate.Manipulator,
+	namespace string,
+	sourceName string,
+	username string,
+) (*api.LocalUser, error) {
+
+	mctx := manipulate.NewContext(ctx,
+		manipulate.ContextOptionNamespace(namespace),
+		manipulate.ContextOptionFilter(
+			elemental.NewFilterComposer().
+				WithKey("sourceName").Equals(sourceName).
+				WithKey("username").Equals(username).
+				Done(),
+		),
+	)
+
+	users := api.LocalUsersList{}
+	if err := m.RetrieveMany(mctx, &users); err != nil {
+		return nil, err
+	}
+
+	if len(users) == 0 {
+		return nil, nil
+	}
+
+	return users[0], nil
+}
+
+// setLocalUserPassword checks the given password complies with the
+// policy of the given source, and sets its hash on the given user.
+// The attribute is the one reported in the validation errors.
+func setLocalUserPassword(src *api.LocalSource, user *api.LocalUser, password string, attribute string) error {
+
+	if err := localpassword.CheckPolicy(src, password); err != nil {
+		return elemental.NewErrorWithData(
+			"Validation Error",
+			err.Error(),
+			"a3s:localuser",
+			http.StatusUnprocessableEntity,
+			map[string]any{"attribute": attribute},
+		)
+	}
+
+	hash, err := localpassword.Hash(password, src.HashAlgorithm)
+	if err != nil {
+		return err
+	}
+
+	user.PasswordHash = hash
+	user.PasswordUpdateTime = time.Now().Round(time.Millisecond)
+	user.Password = ""
+
+	return nil
+}
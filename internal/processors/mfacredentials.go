@@ -0,0 +1,94 @@
+package processors
+
+import (
+	"context"
+	"time"
+
+	"go.aporeto.io/a3s/internal/lockout"
+	"go.aporeto.io/a3s/internal/mfa"
+	"go.aporeto.io/a3s/pkgs/api"
+	"go.aporeto.io/a3s/pkgs/crud"
+	"go.aporeto.io/bahamut"
+	"go.aporeto.io/elemental"
+	"go.aporeto.io/manipulate"
+)
+
+// totpLimiter limits the failed attempts to validate a code
+// for the TOTP credentials, so they cannot be brute forced.
+var totpLimiter = lockout.NewLimiter(5, time.Second, 15*time.Minute)
+
+// A MFACredentialsProcessor is a bahamut processor for MFACredential.
+type MFACredentialsProcessor struct {
+	manipulator manipulate.Manipulator
+}
+
+// NewMFACredentialsProcessor returns a new MFACredentialsProcessor.
+func NewMFACredentialsProcessor(manipulator manipulate.Manipulator) *MFACredentialsProcessor {
+	return &MFACredentialsProcessor{
+		manipulator: manipulator,
+	}
+}
+
+// ProcessRetrieveMany handles the retrieve many requests for MFACredential.
+func (p *MFACredentialsProcessor) ProcessRetrieveMany(bctx bahamut.Context) error {
+	return crud.RetrieveMany(bctx, p.manipulator, &api.MFACredentialsList{})
+}
+
+// ProcessRetrieve handles the retrieve requests for MFACredential.
+func (p *MFACredentialsProcessor) ProcessRetrieve(bctx bahamut.Context) error {
+	return crud.Retrieve(bctx, p.manipulator, api.NewMFACredential())
+}
+
+// ProcessUpdate handles the update requests for MFACredential.
+func (p *MFACredentialsProcessor) ProcessUpdate(bctx bahamut.Context) error {
+	return crud.Update(bctx, p.manipulator, bctx.InputData().(*api.MFACredential))
+}
+
+// ProcessDelete handles the delete requests for MFACredential.
+func (p *MFACredentialsProcessor) ProcessDelete(bctx bahamut.Context) error {
+	return crud.Delete(bctx, p.manipulator, api.NewMFACredential())
+}
+
+// ProcessInfo handles the info request for MFACredential.
+func (p *MFACredentialsProcessor) ProcessInfo(bctx bahamut.Context) error {
+	return crud.Info(bctx, p.manipulator, api.MFACredentialIdentity)
+}
+
+// retrieveMFACredentials returns the active credentials of the given
+// type living in the given namespace that can be used by the given
+// subject. If the type is empty, credentials of all types are returned.
+func retrieveMFACredentials(
+	ctx context.Context,
+	m manipulate.Manipulator,
+	namespace string,
+	subject []string,
+	typ api.MFACredentialTypeValue,
+) (api.MFACredentialsList, error) {
+
+	filter := elemental.NewFilterComposer().
+		WithKey("active").Equals(true).
+		WithKey("subject").Equals(subject)
+
+	if typ != "" {
+		filter = filter.WithKey("type").Equals(typ)
+	}
+
+	mctx := manipulate.NewContext(ctx,
+		manipulate.ContextOptionNamespace(namespace),
+		manipulate.ContextOptionFilter(filter.Done()),
+	)
+
+	creds := api.MFACredentialsList{}
+	if err := m.RetrieveMany(mctx, &creds); err != nil {
+		return nil, err
+	}
+
+	out := make(api.MFACredentialsList, 0, len(creds))
+	for _, cred := range creds {
+		if mfa.SameSubject(cred.Subject, subject) {
+			out = append(out, cred)
+		}
+	}
+
+	return out, nil
+}
@@ -0,0 +1,383 @@
+package processors
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.aporeto.io/a3s/internal/mfa"
+	"go.aporeto.io/a3s/internal/reference"
+	"go.aporeto.io/a3s/pkgs/api"
+	"go.aporeto.io/a3s/pkgs/token"
+	"go.aporeto.io/bahamut"
+	"go.aporeto.io/elemental"
+	"go.aporeto.io/manipulate"
+	"go.uber.org/zap"
+)
+
+// A MFAEnrollmentsProcessor is a bahamut processor for MFAEnrollment.
+type MFAEnrollmentsProcessor struct {
+	manipulator manipulate.Manipulator
+	references  token.ReferenceResolver
+	webAuthn    *mfa.RelyingParty
+	totpIssuer  string
+	issuer      string
+}
+
+// NewMFAEnrollmentsProcessor returns a new MFAEnrollmentsProcessor.
+func NewMFAEnrollmentsProcessor(
+	manipulator manipulate.Manipulator,
+	webAuthn *mfa.RelyingParty,
+	totpIssuer string,
+	issuer string,
+) *MFAEnrollmentsProcessor {
+	return &MFAEnrollmentsProcessor{
+		manipulator: manipulator,
+		references:  reference.NewResolver(manipulator),
+		webAuthn:    webAuthn,
+		totpIssuer:  totpIssuer,
+		issuer:      issuer,
+	}
+}
+
+// ProcessCreate handles the creates requests for MFAEnrollment.
+// The credentials are bound to the source of the token of the request
+// and the claim identifying its user, and are stored in the namespace
+// of the source.
+func (p *MFAEnrollmentsProcessor) ProcessCreate(bctx bahamut.Context) error {
+
+	req := bctx.InputData().(*api.MFAEnrollment)
+	ctx := bctx.Context()
+	claims := bctx.Claims()
+
+	var namespace string
+	for _, c := range claims {
+		if ns, ok := strings.CutPrefix(c, "@source:namespace="); ok {
+			namespace = ns
+		}
+	}
+
+	if namespace == "" || !hasClaim(claims, "@issuer="+p.issuer) {
+		return elemental.NewError(
+			"Forbidden",
+			"Only tokens issued by a3s from a source can enroll a second factor",
+			"a3s:mfa",
+			http.StatusForbidden,
+		)
+	}
+
+	restricted, err := p.isRestricted(ctx, token.FromRequest(bctx.Request()))
+	if err != nil {
+		return err
+	}
+
+	if restricted || hasClaimPrefix(claims, "@actor:") {
+		return elemental.NewError(
+			"Forbidden",
+			"Restricted or exchanged tokens cannot enroll a second factor",
+			"a3s:mfa",
+			http.StatusForbidden,
+		)
+	}
+
+	subject, err := mfa.Subject(claims)
+	if err != nil {
+		return elemental.NewError(
+			"Forbidden",
+			"Only tokens identifying a single user of a local, ldap, oidc, jwt, saml or mtls source can enroll a second factor",
+			"a3s:mfa",
+			http.StatusForbidden,
+		)
+	}
+
+	existing, err := retrieveMFACredentials(ctx, p.manipulator, namespace, subject, "")
+	if err != nil {
+		return err
+	}
+
+	if len(existing) > 0 && !mfa.HasClaim(claims) {
+		return elemental.NewError(
+			"Forbidden",
+			"You must upgrade your token using one of your second factors to enroll a new one",
+			"a3s:mfa",
+			http.StatusForbidden,
+		)
+	}
+
+	if req.Name == "" {
+		req.Name = string(req.Type)
+	}
+
+	switch req.Type {
+	case api.MFAEnrollmentTypeTOTP:
+		err = p.enrollTOTP(ctx, req, namespace, subject)
+	case api.MFAEnrollmentTypeWebAuthn:
+		err = p.enrollWebAuthn(ctx, req, namespace, subject, existing)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	req.TOTP = ""
+	req.WebAuthnResponse = ""
+	bctx.SetOutputData(req)
+
+	return nil
+}
+
+// isRestricted returns true if the given token, or
+// the token it refers to, has restrictions.
+func (p *MFAEnrollmentsProcessor) isRestricted(ctx context.Context, tkn string) (bool, error) {
+
+	restrictions, err := getRestrictions(ctx, p.references, tkn)
+	if err != nil {
+		return false, err
+	}
+
+	return !restrictions.Zero(), nil
+}
+
+// enrollTOTP creates an inactive TOTP credential and returns its
+// URI if no credential ID is given. Otherwise, it activates the
+// credential with the given ID if the given code is valid.
+func (p *MFAEnrollmentsProcessor) enrollTOTP(ctx context.Context, req *api.MFAEnrollment, namespace string, subject []string) error {
+
+	mctx := manipulate.NewContext(ctx, manipulate.ContextOptionNamespace(namespace))
+	now := time.Now().Round(time.Millisecond)
+
+	if req.CredentialID == "" {
+
+		// Pending secrets that were never
+		// activated are replaced by the new one.
+		if err := p.manipulator.DeleteMany(
+			manipulate.NewContext(ctx,
+				manipulate.ContextOptionNamespace(namespace),
+				manipulate.ContextOptionFilter(
+					elemental.NewFilterComposer().
+						WithKey("type").Equals(api.MFACredentialTypeTOTP).
+						WithKey("active").Equals(false).
+						WithKey("subject").Equals(subject).
+						Done(),
+				),
+			),
+			api.MFACredentialIdentity,
+		); err != nil {
+			return err
+		}
+
+		secret, err := mfa.GenerateTOTPSecret()
+		if err != nil {
+			return err
+		}
+
+		cred := api.NewMFACredential()
+		cred.Namespace = namespace
+		cred.Name = req.Name
+		cred.Type = api.MFACredentialTypeTOTP
+		cred.Subject = subject
+		cred.TOTPSecret = secret
+		cred.CreateTime = now
+		cred.UpdateTime = now
+
+		if err := p.manipulator.Create(mctx, cred); err != nil {
+			return err
+		}
+
+		req.CredentialID = cred.ID
+		req.TOTPURI = mfa.TOTPURI(p.totpIssuer, req.Name, secret)
+
+		return nil
+	}
+
+	cred := api.NewMFACredential()
+	cred.ID = req.CredentialID
+	if err := p.manipulator.Retrieve(mctx, cred); err != nil {
+		return err
+	}
+
+	if cred.Namespace != namespace || cred.Type != api.MFACredentialTypeTOTP || cred.Active || !mfa.SameSubject(cred.Subject, subject) {
+		return elemental.NewError(
+			"Not Found",
+			"Unable to find the pending TOTP credential",
+			"a3s:mfa",
+			http.StatusNotFound,
+		)
+	}
+
+	step, ok, err := mfa.ValidateTOTP(cred.TOTPSecret, req.TOTP, now, 0)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return elemental.NewErrorWithData(
+			"Validation Error",
+			"Invalid TOTP code",
+			"a3s:mfa",
+			http.StatusUnprocessableEntity,
+			map[string]any{"attribute": "TOTP"},
+		)
+	}
+
+	cred.Active = true
+	cred.TOTPLastStep = int(step)
+	cred.UpdateTime = now
+
+	if err := p.manipulator.Update(mctx, cred); err != nil {
+		return err
+	}
+
+	zap.L().Info("TOTP credential enrolled",
+		zap.String("namespace", namespace),
+		zap.String("id", cred.ID),
+		zap.Strings("subject", subject),
+	)
+
+	return nil
+}
+
+// enrollWebAuthn returns the options to create a new WebAuthn credential
+// if no response is given. Otherwise, it verifies the given response and
+// creates the credential.
+func (p *MFAEnrollmentsProcessor) enrollWebAuthn(
+	ctx context.Context,
+	req *api.MFAEnrollment,
+	namespace string,
+	subject []string,
+	existing api.MFACredentialsList,
+) error {
+
+	if req.WebAuthnResponse == "" {
+
+		challenge, err := mfa.NewWebAuthnChallenge()
+		if err != nil {
+			return err
+		}
+
+		var exclude [][]byte
+		for _, cred := range existing {
+			if id, err := base64.RawURLEncoding.DecodeString(cred.WebAuthnCredentialID); err == nil && len(id) > 0 {
+				exclude = append(exclude, id)
+			}
+		}
+
+		// The user handle must not contain personal
+		// information, so we use a hash of the subject.
+		userID := sha256.Sum256([]byte(strings.Join(subject, "\n")))
+
+		if req.WebAuthnOptions, err = p.webAuthn.CreationOptions(challenge, userID[:], req.Name, exclude); err != nil {
+			return err
+		}
+
+		return mfa.Set(p.manipulator, &mfa.CacheItem{
+			Challenge: challenge,
+			Namespace: namespace,
+			Subject:   subject,
+			Name:      req.Name,
+		})
+	}
+
+	resp, err := mfa.ParseWebAuthnResponse(req.WebAuthnResponse)
+	if err != nil {
+		return makeWebAuthnResponseError(err)
+	}
+
+	item, err := mfa.Pop(p.manipulator, resp.Challenge())
+	if err != nil || item.Namespace != namespace || !mfa.SameSubject(item.Subject, subject) {
+		return makeWebAuthnResponseError(fmt.Errorf("unknown or expired webauthn challenge"))
+	}
+
+	wcred, err := p.webAuthn.VerifyRegistration(resp, item.Challenge)
+	if err != nil {
+		return makeWebAuthnResponseError(err)
+	}
+
+	credentialID := base64.RawURLEncoding.EncodeToString(wcred.ID)
+
+	mctx := manipulate.NewContext(ctx,
+		manipulate.ContextOptionNamespace(namespace),
+		manipulate.ContextOptionFilter(
+			elemental.NewFilterComposer().WithKey("webAuthnCredentialID").Equals(credentialID).Done(),
+		),
+	)
+
+	n, err := p.manipulator.Count(mctx, api.MFACredentialIdentity)
+	if err != nil {
+		return err
+	}
+
+	if n > 0 {
+		return elemental.NewError(
+			"Conflict",
+			"The WebAuthn credential is already enrolled",
+			"a3s:mfa",
+			http.StatusConflict,
+		)
+	}
+
+	now := time.Now().Round(time.Millisecond)
+
+	cred := api.NewMFACredential()
+	cred.Namespace = namespace
+	cred.Name = item.Name
+	cred.Type = api.MFACredentialTypeWebAuthn
+	cred.Subject = subject
+	cred.Active = true
+	cred.WebAuthnCredentialID = credentialID
+	cred.WebAuthnPublicKey = base64.StdEncoding.EncodeToString(wcred.PublicKey)
+	cred.WebAuthnSignCount = int(wcred.SignCount)
+	cred.CreateTime = now
+	cred.UpdateTime = now
+
+	if err := p.manipulator.Create(manipulate.NewContext(ctx, manipulate.ContextOptionNamespace(namespace)), cred); err != nil {
+		return err
+	}
+
+	zap.L().Info("WebAuthn credential enrolled",
+		zap.String("namespace", namespace),
+		zap.String("id", cred.ID),
+		zap.Strings("subject", subject),
+	)
+
+	req.CredentialID = cred.ID
+	req.Name = cred.Name
+
+	return nil
+}
+
+func makeWebAuthnResponseError(err error) error {
+	return elemental.NewErrorWithData(
+		"Validation Error",
+		err.Error(),
+		"a3s:mfa",
+		http.StatusUnprocessableEntity,
+		map[string]any{"attribute": "webAuthnResponse"},
+	)
+}
+
+func hasClaim(claims []string, claim string) bool {
+
+	for _, c := range claims {
+		if c == claim {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasClaimPrefix(claims []string, prefix string) bool {
+
+	for _, c := range claims {
+		if strings.HasPrefix(c, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,74 @@
+package processors
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.aporeto.io/a3s/pkgs/api"
+	"go.aporeto.io/a3s/pkgs/token"
+	"go.aporeto.io/bahamut"
+	"go.aporeto.io/elemental"
+)
+
+func TestMFAEnrollmentsProcessCreate(t *testing.T) {
+
+	Convey("Given a mfa enrollments processor and a cloaked token", t, func() {
+
+		_, k := makeTestJWKS()
+		p := NewMFAEnrollmentsProcessor(nil, nil, "a3s", "iss")
+
+		idt := token.NewIdentityToken(token.Source{Type: "local", Namespace: "/a", Name: "src"})
+		idt.Identity = []string{"username=joe", "team=blue"}
+
+		tkn, err := idt.JWT(k.PrivateKey(), k.KID, "iss", jwt.ClaimStrings{"aud"}, time.Now().Add(time.Hour), []string{"team="})
+		So(err, ShouldBeNil)
+
+		bctx := bahamut.NewMockContext(context.Background())
+		req := elemental.NewRequest()
+		req.Password = tkn
+		bctx.MockRequest = req
+
+		enrollment := api.NewMFAEnrollment()
+		enrollment.Type = api.MFAEnrollmentTypeTOTP
+		bctx.MockInputData = enrollment
+
+		Convey("When I enroll a second factor with the cloaked claims", func() {
+
+			bctx.MockClaims = []string{
+				"team=blue",
+				"@source:type=local",
+				"@source:namespace=/a",
+				"@source:name=src",
+				"@issuer=iss",
+			}
+
+			err := p.ProcessCreate(bctx)
+
+			Convey("Then it should be rejected", func() {
+				So(err, ShouldNotBeNil)
+				So(err.(elemental.Error).Code, ShouldEqual, http.StatusForbidden)
+			})
+		})
+
+		Convey("When I enroll a second factor with only the @source claims", func() {
+
+			bctx.MockClaims = []string{
+				"@source:type=local",
+				"@source:namespace=/a",
+				"@source:name=src",
+				"@issuer=iss",
+			}
+
+			err := p.ProcessCreate(bctx)
+
+			Convey("Then it should be rejected", func() {
+				So(err, ShouldNotBeNil)
+				So(err.(elemental.Error).Code, ShouldEqual, http.StatusForbidden)
+			})
+		})
+	})
+}
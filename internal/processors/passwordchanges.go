@@ -0,0 +1,77 @@
+package processors
+
+import (
+	"net/http"
+	"time"
+
+	"go.aporeto.io/a3s/internal/localpassword"
+	"go.aporeto.io/a3s/pkgs/api"
+	"go.aporeto.io/bahamut"
+	"go.aporeto.io/elemental"
+	"go.aporeto.io/manipulate"
+)
+
+// A PasswordChangesProcessor is a bahamut processor for PasswordChange.
+type PasswordChangesProcessor struct {
+	manipulator manipulate.Manipulator
+}
+
+// NewPasswordChangesProcessor returns a new PasswordChangesProcessor.
+func NewPasswordChangesProcessor(manipulator manipulate.Manipulator) *PasswordChangesProcessor {
+	return &PasswordChangesProcessor{
+		manipulator: manipulator,
+	}
+}
+
+// ProcessCreate handles the creates requests for PasswordChange.
+func (p *PasswordChangesProcessor) ProcessCreate(bctx bahamut.Context) error {
+
+	req := bctx.InputData().(*api.PasswordChange)
+	ctx := bctx.Context()
+
+	out, err := retrieveSource(ctx, p.manipulator, req.SourceNamespace, req.SourceName, api.LocalSourceIdentity)
+	if err != nil {
+		return err
+	}
+
+	user, err := retrieveLocalUser(ctx, p.manipulator, req.SourceNamespace, req.SourceName, req.Username)
+	if err != nil {
+		return err
+	}
+
+	var hash string
+	if user != nil {
+		hash = user.PasswordHash
+	}
+
+	done, err := localLimiter.Acquire(localUserKey(req.SourceNamespace, req.SourceName, req.Username))
+	if err != nil {
+		return elemental.NewError("Too Many Requests", err.Error(), "a3s:localuser", http.StatusTooManyRequests)
+	}
+
+	ok, err := localpassword.Verify(hash, req.CurrentPassword)
+	done(ok)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return elemental.NewError("Unauthorized", "Invalid credentials", "a3s:localuser", http.StatusUnauthorized)
+	}
+
+	if err := setLocalUserPassword(out.(*api.LocalSource), user, req.NewPassword, "newPassword"); err != nil {
+		return err
+	}
+
+	user.UpdateTime = time.Now().Round(time.Millisecond)
+
+	if err := p.manipulator.Update(manipulate.NewContext(ctx), user); err != nil {
+		return err
+	}
+
+	req.CurrentPassword = ""
+	req.NewPassword = ""
+	bctx.SetOutputData(req)
+
+	return nil
+}
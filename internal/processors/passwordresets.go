@@ -0,0 +1,66 @@
+package processors
+
+import (
+	"net/http"
+	"time"
+
+	"go.aporeto.io/a3s/pkgs/api"
+	"go.aporeto.io/bahamut"
+	"go.aporeto.io/elemental"
+	"go.aporeto.io/manipulate"
+)
+
+// A PasswordResetsProcessor is a bahamut processor for PasswordReset.
+type PasswordResetsProcessor struct {
+	manipulator manipulate.Manipulator
+}
+
+// NewPasswordResetsProcessor returns a new PasswordResetsProcessor.
+func NewPasswordResetsProcessor(manipulator manipulate.Manipulator) *PasswordResetsProcessor {
+	return &PasswordResetsProcessor{
+		manipulator: manipulator,
+	}
+}
+
+// ProcessCreate handles the creates requests for PasswordReset.
+// The user must live in the namespace of the request.
+func (p *PasswordResetsProcessor) ProcessCreate(bctx bahamut.Context) error {
+
+	req := bctx.InputData().(*api.PasswordReset)
+	ctx := bctx.Context()
+	ns := bctx.Request().Namespace
+
+	out, err := retrieveSource(ctx, p.manipulator, ns, req.SourceName, api.LocalSourceIdentity)
+	if err != nil {
+		return err
+	}
+
+	user, err := retrieveLocalUser(ctx, p.manipulator, ns, req.SourceName, req.Username)
+	if err != nil {
+		return err
+	}
+
+	if user == nil {
+		return elemental.NewError(
+			"Not Found",
+			"Unable to find the local user",
+			"a3s:localuser",
+			http.StatusNotFound,
+		)
+	}
+
+	if err := setLocalUserPassword(out.(*api.LocalSource), user, req.NewPassword, "newPassword"); err != nil {
+		return err
+	}
+
+	user.UpdateTime = time.Now().Round(time.Millisecond)
+
+	if err := p.manipulator.Update(manipulate.NewContext(ctx), user); err != nil {
+		return err
+	}
+
+	req.NewPassword = ""
+	bctx.SetOutputData(req)
+
+	return nil
+}
@@ -0,0 +1,262 @@
+package processors
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.aporeto.io/a3s/internal/reference"
+	"go.aporeto.io/a3s/pkgs/api"
+	"go.aporeto.io/a3s/pkgs/crud"
+	"go.aporeto.io/a3s/pkgs/notification"
+	"go.aporeto.io/a3s/pkgs/revocation"
+	"go.aporeto.io/a3s/pkgs/token"
+	"go.aporeto.io/bahamut"
+	"go.aporeto.io/elemental"
+	"go.aporeto.io/manipulate"
+	"go.uber.org/zap"
+)
+
+// A RevocationsProcessor is a bahamut processor for Revocations.
+type RevocationsProcessor struct {
+	manipulator manipulate.Manipulator
+	pubsub      bahamut.PubSubClient
+	pusher      func(...*elemental.Event)
+	jwks        *token.JWKS
+	references  token.ReferenceResolver
+	issuer      string
+	maxValidity time.Duration
+}
+
+// NewRevocationsProcessor returns a new RevocationsProcessor.
+// The given pusher is used to push the events of the revocations
+// that are created internally, as they don't go through the api.
+// The given JWKS and issuer are used to verify the revoked tokens.
+func NewRevocationsProcessor(
+	manipulator manipulate.Manipulator,
+	pubsub bahamut.PubSubClient,
+	pusher func(...*elemental.Event),
+	jwks *token.JWKS,
+	issuer string,
+	maxValidity time.Duration,
+) *RevocationsProcessor {
+	return &RevocationsProcessor{
+		manipulator: manipulator,
+		pubsub:      pubsub,
+		pusher:      pusher,
+		jwks:        jwks,
+		references:  reference.NewResolver(manipulator),
+		issuer:      issuer,
+		maxValidity: maxValidity,
+	}
+}
+
+// ProcessCreate handles the creates requests for Revocations.
+func (p *RevocationsProcessor) ProcessCreate(bctx bahamut.Context) error {
+	return crud.Create(bctx, p.manipulator, bctx.InputData().(*api.Revocation),
+		crud.OptionPreWriteHook(p.makePreHook()),
+		crud.OptionPostWriteHook(p.makePostCreateHook()),
+	)
+}
+
+// ProcessRetrieveMany handles the retrieve many requests for Revocations.
+func (p *RevocationsProcessor) ProcessRetrieveMany(bctx bahamut.Context) error {
+	return crud.RetrieveMany(bctx, p.manipulator, &api.RevocationsList{})
+}
+
+// ProcessRetrieve handles the retrieve requests for Revocations.
+func (p *RevocationsProcessor) ProcessRetrieve(bctx bahamut.Context) error {
+	return crud.Retrieve(bctx, p.manipulator, api.NewRevocation())
+}
+
+// ProcessDelete handles the delete requests for Revocations.
+func (p *RevocationsProcessor) ProcessDelete(bctx bahamut.Context) error {
+	return crud.Delete(bctx, p.manipulator, api.NewRevocation(),
+		crud.OptionPostWriteHook(p.makeNotify(revocation.MessageTypeDelete)),
+	)
+}
+
+// ProcessInfo handles the info request for Revocations.
+func (p *RevocationsProcessor) ProcessInfo(bctx bahamut.Context) error {
+	return crud.Info(bctx, p.manipulator, api.RevocationIdentity)
+}
+
+// Revoke creates the given revocation in the root namespace
+// on behalf of the system.
+func (p *RevocationsProcessor) Revoke(ctx context.Context, rev *api.Revocation) error {
+
+	now := time.Now()
+	rev.Namespace = "/"
+	rev.CreateTime = now
+	rev.UpdateTime = now
+
+	if err := p.makePreHook()(rev, nil); err != nil {
+		return err
+	}
+
+	if err := rev.Validate(); err != nil {
+		return err
+	}
+
+	if err := p.manipulator.Create(manipulate.NewContext(ctx), rev); err != nil {
+		return err
+	}
+
+	p.makePostCreateHook()(rev)
+
+	if p.pusher != nil {
+		p.pusher(elemental.NewEvent(elemental.EventCreate, rev))
+	}
+
+	return nil
+}
+
+// makePostCreateHook returns a hook deleting the reference
+// tokens covered by the revocation, so they cannot be resolved
+// anymore, before notifying the revocation.
+func (p *RevocationsProcessor) makePostCreateHook() crud.PostWriteHook {
+
+	notify := p.makeNotify(revocation.MessageTypeCreate)
+
+	return func(obj elemental.Identifiable) {
+
+		rev := obj.(*api.Revocation)
+
+		if err := reference.Delete(context.Background(), p.manipulator, rev); err != nil {
+			zap.L().Error("Unable to delete revoked reference tokens", zap.String("revocation", rev.ID), zap.Error(err))
+		}
+
+		notify(obj)
+	}
+}
+
+func (p *RevocationsProcessor) makeNotify(messageType string) crud.PostWriteHook {
+	return func(obj elemental.Identifiable) {
+		_ = notification.Publish(
+			p.pubsub,
+			revocation.NotificationRevocationChanges,
+			&notification.Message{
+				Type: messageType,
+				Data: obj.(*api.Revocation),
+			},
+		)
+	}
+}
+
+func (p *RevocationsProcessor) makePreHook() crud.PreWriteHook {
+
+	return func(obj elemental.Identifiable, original elemental.Identifiable) error {
+
+		rev := obj.(*api.Revocation)
+
+		// Any token covered by the revocation will have
+		// expired after the max validity.
+		maxExpiration := rev.CreateTime.Add(p.maxValidity)
+
+		if rev.Token != "" {
+			if err := p.setTokenID(rev); err != nil {
+				return err
+			}
+		}
+
+		if rev.TokenID != "" {
+
+			if !rev.Expiration.IsZero() && !rev.Expiration.After(rev.CreateTime) {
+				return elemental.NewErrorWithData(
+					"Validation Error",
+					"The expiration must be in the future",
+					"a3s:revocation",
+					http.StatusUnprocessableEntity,
+					map[string]any{"attribute": "expiration"},
+				)
+			}
+
+			if rev.Expiration.IsZero() || rev.Expiration.After(maxExpiration) {
+				rev.Expiration = maxExpiration
+			}
+
+			return nil
+		}
+
+		rev.Expiration = maxExpiration
+
+		if len(rev.Subject) > 0 || rev.TokenFamily != "" {
+			return nil
+		}
+
+		if rev.SourceNamespace == "" {
+			rev.SourceNamespace = rev.Namespace
+		}
+
+		if rev.SourceNamespace != rev.Namespace && !elemental.IsNamespaceChildrenOfNamespace(rev.SourceNamespace, rev.Namespace) {
+			return elemental.NewErrorWithData(
+				"Validation Error",
+				"You can only revoke sources living in the namespace of the revocation or one of its children",
+				"a3s:revocation",
+				http.StatusUnprocessableEntity,
+				map[string]any{"attribute": "sourceNamespace"},
+			)
+		}
+
+		return nil
+	}
+}
+
+// setTokenID sets the token ID of the given revocation from its token,
+// after verifying the token has been issued by a source living in the
+// namespace of the revocation or one of its children. The token is then
+// removed from the revocation, so it is not stored.
+func (p *RevocationsProcessor) setTokenID(rev *api.Revocation) error {
+
+	tkn := rev.Token
+	rev.Token = ""
+
+	if token.IsReference(tkn) {
+		resolved, err := p.references.Resolve(context.Background(), tkn)
+		if err != nil {
+			return makeRevocationTokenError(fmt.Sprintf("Unable to resolve the reference token: %s", err))
+		}
+		tkn = resolved
+	}
+
+	idt, err := token.Parse(tkn, p.jwks, p.issuer, "")
+	if err != nil {
+		return makeRevocationTokenError(fmt.Sprintf("Unable to verify the token: %s", err))
+	}
+
+	if idt.ID == "" {
+		return makeRevocationTokenError("The token has no ID")
+	}
+
+	if rev.TokenID != "" && rev.TokenID != idt.ID {
+		return makeRevocationTokenError("The token ID does not match the tokenID of the revocation")
+	}
+
+	sourceNamespace := idt.Source.Namespace
+	if sourceNamespace == "" {
+		sourceNamespace = "/"
+	}
+
+	if sourceNamespace != rev.Namespace && !elemental.IsNamespaceChildrenOfNamespace(sourceNamespace, rev.Namespace) {
+		return makeRevocationTokenError("You can only revoke tokens issued by sources living in the namespace of the revocation or one of its children")
+	}
+
+	rev.TokenID = idt.ID
+
+	if rev.Expiration.IsZero() && idt.ExpiresAt != nil {
+		rev.Expiration = idt.ExpiresAt.Time
+	}
+
+	return nil
+}
+
+func makeRevocationTokenError(msg string) error {
+	return elemental.NewErrorWithData(
+		"Validation Error",
+		msg,
+		"a3s:revocation",
+		http.StatusUnprocessableEntity,
+		map[string]any{"attribute": "token"},
+	)
+}
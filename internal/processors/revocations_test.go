@@ -0,0 +1,131 @@
+package processors
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.aporeto.io/a3s/pkgs/api"
+	"go.aporeto.io/a3s/pkgs/token"
+	"go.aporeto.io/elemental"
+)
+
+func makeTestJWKS() (*token.JWKS, *token.JWKSKey) {
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+
+	tpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tpl, tpl, &key.PublicKey, key)
+	if err != nil {
+		panic(err)
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		panic(err)
+	}
+
+	jwks := token.NewJWKS()
+	if err := jwks.AppendWithPrivate(cert, key); err != nil {
+		panic(err)
+	}
+
+	return jwks, jwks.GetLast()
+}
+
+func makeTestToken(k *token.JWKSKey, namespace string) (string, *token.IdentityToken) {
+
+	idt := token.NewIdentityToken(token.Source{Type: "mtls", Namespace: namespace, Name: "src"})
+	idt.Identity = []string{"commonname=john"}
+
+	tkn, err := idt.JWT(k.PrivateKey(), k.KID, "iss", jwt.ClaimStrings{"aud"}, time.Now().Add(time.Hour), nil)
+	if err != nil {
+		panic(err)
+	}
+
+	return tkn, idt
+}
+
+func TestRevocationsPreHook(t *testing.T) {
+
+	Convey("Given a revocations processor", t, func() {
+
+		jwks, k := makeTestJWKS()
+		p := NewRevocationsProcessor(nil, nil, nil, jwks, "iss", 24*time.Hour)
+		hook := p.makePreHook()
+
+		Convey("When I revoke a token issued by a source living in the namespace of the revocation", func() {
+
+			tkn, idt := makeTestToken(k, "/a/b")
+
+			rev := api.NewRevocation()
+			rev.Namespace = "/a"
+			rev.Token = tkn
+			rev.CreateTime = time.Now()
+
+			So(hook(rev, nil), ShouldBeNil)
+			So(rev.TokenID, ShouldEqual, idt.ID)
+			So(rev.Token, ShouldBeEmpty)
+			So(rev.Expiration.Unix(), ShouldEqual, idt.ExpiresAt.Unix())
+		})
+
+		Convey("When I revoke a token issued by a source living in another namespace", func() {
+
+			tkn, _ := makeTestToken(k, "/b")
+
+			rev := api.NewRevocation()
+			rev.Namespace = "/a"
+			rev.Token = tkn
+			rev.CreateTime = time.Now()
+
+			err := hook(rev, nil)
+			So(err, ShouldNotBeNil)
+			So(err.(elemental.Error).Description, ShouldEqual, "You can only revoke tokens issued by sources living in the namespace of the revocation or one of its children")
+			So(rev.TokenID, ShouldBeEmpty)
+		})
+
+		Convey("When I revoke a token with a tokenID that does not match", func() {
+
+			tkn, _ := makeTestToken(k, "/a")
+
+			rev := api.NewRevocation()
+			rev.Namespace = "/a"
+			rev.Token = tkn
+			rev.TokenID = "not-the-jti"
+			rev.CreateTime = time.Now()
+
+			err := hook(rev, nil)
+			So(err, ShouldNotBeNil)
+			So(err.(elemental.Error).Description, ShouldEqual, "The token ID does not match the tokenID of the revocation")
+		})
+
+		Convey("When I revoke a token that is not signed by a3s", func() {
+
+			_, other := makeTestJWKS()
+			tkn, _ := makeTestToken(other, "/a")
+
+			rev := api.NewRevocation()
+			rev.Namespace = "/a"
+			rev.Token = tkn
+			rev.CreateTime = time.Now()
+
+			err := hook(rev, nil)
+			So(err, ShouldNotBeNil)
+			So(err.(elemental.Error).Description, ShouldStartWith, "Unable to verify the token: ")
+		})
+	})
+}
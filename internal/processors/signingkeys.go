@@ -0,0 +1,53 @@
+package processors
+
+import (
+	"time"
+
+	"go.aporeto.io/a3s/pkgs/api"
+	"go.aporeto.io/a3s/pkgs/token"
+	"go.aporeto.io/bahamut"
+)
+
+// A SigningKeysProcessor is a bahamut processor for SigningKeys.
+type SigningKeysProcessor struct {
+	jwks *token.JWKS
+}
+
+// NewSigningKeysProcessor returns a new SigningKeysProcessor.
+func NewSigningKeysProcessor(jwks *token.JWKS) *SigningKeysProcessor {
+	return &SigningKeysProcessor{
+		jwks: jwks,
+	}
+}
+
+// ProcessRetrieveMany handles the retrieve many requests for SigningKeys.
+func (p *SigningKeysProcessor) ProcessRetrieveMany(bctx bahamut.Context) error {
+
+	p.jwks.RLock()
+	keys := append([]*token.JWKSKey{}, p.jwks.Keys...)
+	p.jwks.RUnlock()
+
+	now := time.Now()
+
+	out := make(api.SigningKeysList, len(keys))
+	for i, k := range keys {
+
+		schedule := k.Schedule()
+
+		sk := api.NewSigningKey()
+		sk.Kid = k.KID
+		sk.Algorithm = k.Alg
+		sk.State = api.SigningKeyStateValue(k.State(now))
+		sk.PublishTime = schedule.PublishTime
+		sk.ActivateTime = schedule.ActivateTime
+		sk.RetireTime = schedule.RetireTime
+		sk.ExpireTime = schedule.ExpireTime
+
+		out[i] = sk
+	}
+
+	bctx.SetCount(len(out))
+	bctx.SetOutputData(out)
+
+	return nil
+}
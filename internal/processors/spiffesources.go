@@ -0,0 +1,50 @@
+package processors
+
+import (
+	"go.aporeto.io/a3s/pkgs/api"
+	"go.aporeto.io/a3s/pkgs/crud"
+	"go.aporeto.io/bahamut"
+	"go.aporeto.io/manipulate"
+)
+
+// A SPIFFESourcesProcessor is a bahamut processor for SPIFFESource.
+type SPIFFESourcesProcessor struct {
+	manipulator manipulate.Manipulator
+}
+
+// NewSPIFFESourcesProcessor returns a new SPIFFESourcesProcessor.
+func NewSPIFFESourcesProcessor(manipulator manipulate.Manipulator) *SPIFFESourcesProcessor {
+	return &SPIFFESourcesProcessor{
+		manipulator: manipulator,
+	}
+}
+
+// ProcessCreate handles the creates requests for SPIFFESource.
+func (p *SPIFFESourcesProcessor) ProcessCreate(bctx bahamut.Context) error {
+	return crud.Create(bctx, p.manipulator, bctx.InputData().(*api.SPIFFESource))
+}
+
+// ProcessRetrieveMany handles the retrieve many requests for SPIFFESource.
+func (p *SPIFFESourcesProcessor) ProcessRetrieveMany(bctx bahamut.Context) error {
+	return crud.RetrieveMany(bctx, p.manipulator, &api.SPIFFESourcesList{})
+}
+
+// ProcessRetrieve handles the retrieve requests for SPIFFESource.
+func (p *SPIFFESourcesProcessor) ProcessRetrieve(bctx bahamut.Context) error {
+	return crud.Retrieve(bctx, p.manipulator, api.NewSPIFFESource())
+}
+
+// ProcessUpdate handles the update requests for SPIFFESource.
+func (p *SPIFFESourcesProcessor) ProcessUpdate(bctx bahamut.Context) error {
+	return crud.Update(bctx, p.manipulator, bctx.InputData().(*api.SPIFFESource))
+}
+
+// ProcessDelete handles the delete requests for SPIFFESource.
+func (p *SPIFFESourcesProcessor) ProcessDelete(bctx bahamut.Context) error {
+	return crud.Delete(bctx, p.manipulator, api.NewSPIFFESource())
+}
+
+// ProcessInfo handles the info request for SPIFFESource.
+func (p *SPIFFESourcesProcessor) ProcessInfo(bctx bahamut.Context) error {
+	return crud.Info(bctx, p.manipulator, api.SPIFFESourceIdentity)
+}
@@ -0,0 +1,180 @@
+package reference
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"regexp"
+	"time"
+
+	"go.aporeto.io/a3s/pkgs/api"
+	"go.aporeto.io/a3s/pkgs/token"
+	"go.aporeto.io/elemental"
+	"go.aporeto.io/manipulate"
+	"go.aporeto.io/manipulate/manipmongo"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Identity is the identity of the internal collection
+// holding the tokens referred to by reference tokens.
+var Identity = elemental.MakeIdentity("reference", "references")
+
+// ErrNotFound is returned when a reference token
+// does not refer to any stored token.
+var ErrNotFound = errors.New("reference token not found")
+
+// A Reference holds the signed token referred to by a reference token.
+// Only the hash of the reference token is stored. The claims used to
+// match the revocations are stored along, so the stored tokens covered
+// by a revocation can be deleted.
+type Reference struct {
+	ID         string    `bson:"_id"`
+	Token      string    `bson:"token"`
+	TokenID    string    `bson:"tokenid"`
+	Family     string    `bson:"family"`
+	Identity   []string  `bson:"identity"`
+	SourceType string    `bson:"sourcetype"`
+	SourceName string    `bson:"sourcename"`
+	Namespace  string    `bson:"namespace"`
+	IssuedAt   time.Time `bson:"issuedat"`
+	Expiration time.Time `bson:"expiration"`
+}
+
+// Store stores the given signed token, described by the given
+// IdentityToken, so it can be retrieved using the given reference
+// until it expires.
+func Store(ctx context.Context, m manipulate.Manipulator, reference string, tkn string, idt *token.IdentityToken) error {
+
+	collection := manipmongo.GetDatabase(m).Collection(Identity.Name)
+
+	ref := &Reference{
+		ID:         hash(reference),
+		Token:      tkn,
+		TokenID:    idt.ID,
+		Family:     idt.RefreshFamily,
+		Identity:   idt.Identity,
+		SourceType: idt.Source.Type,
+		SourceName: idt.Source.Name,
+		Namespace:  normalizeNamespace(idt.Source.Namespace),
+	}
+
+	if idt.IssuedAt != nil {
+		ref.IssuedAt = idt.IssuedAt.Time
+	}
+
+	if idt.ExpiresAt != nil {
+		ref.Expiration = idt.ExpiresAt.Time
+	}
+
+	_, err := collection.InsertOne(ctx, ref)
+
+	return err
+}
+
+// Delete deletes the stored tokens covered by the given revocation,
+// so their reference tokens cannot be resolved anymore. It follows the
+// rules of the revocation cache: only the tokens issued by sources living
+// in the namespace of the revocation or one of its children are covered,
+// and revocations by subject or source only cover the tokens issued before
+// they were created.
+func Delete(ctx context.Context, m manipulate.Manipulator, rev *api.Revocation) error {
+
+	filter := bson.M{}
+
+	switch {
+
+	case rev.TokenID != "":
+		filter["tokenid"] = rev.TokenID
+
+	case rev.TokenFamily != "":
+		filter["family"] = rev.TokenFamily
+
+	case len(rev.Subject) > 0:
+		ors := make([]bson.M, 0, len(rev.Subject))
+		for _, ands := range rev.Subject {
+			if len(ands) > 0 {
+				ors = append(ors, bson.M{"identity": bson.M{"$all": ands}})
+			}
+		}
+		if len(ors) == 0 {
+			return nil
+		}
+		filter["$or"] = ors
+		filter["issuedat"] = bson.M{"$lte": rev.CreateTime}
+
+	default:
+		sourceNamespace := normalizeNamespace(rev.SourceNamespace)
+		if !isInNamespace(sourceNamespace, rev.Namespace) {
+			return nil
+		}
+		filter["sourcetype"] = rev.SourceType
+		filter["sourcename"] = rev.SourceName
+		filter["namespace"] = sourceNamespace
+		filter["issuedat"] = bson.M{"$lte": rev.CreateTime}
+	}
+
+	if _, ok := filter["namespace"]; !ok && rev.Namespace != "/" {
+		filter["namespace"] = bson.M{"$regex": "^" + regexp.QuoteMeta(rev.Namespace) + "(/|$)"}
+	}
+
+	collection := manipmongo.GetDatabase(m).Collection(Identity.Name)
+
+	_, err := collection.DeleteMany(ctx, filter)
+
+	return err
+}
+
+// A Resolver is a token.ReferenceResolver
+// resolving the stored tokens.
+type Resolver struct {
+	manipulator manipulate.Manipulator
+}
+
+// NewResolver returns a new Resolver.
+func NewResolver(m manipulate.Manipulator) *Resolver {
+	return &Resolver{
+		manipulator: m,
+	}
+}
+
+// Resolve returns the signed token referred to by the given
+// reference. If it does not exist or has expired, ErrNotFound
+// is returned.
+func (r *Resolver) Resolve(ctx context.Context, reference string) (string, error) {
+
+	collection := manipmongo.GetDatabase(r.manipulator).Collection(Identity.Name)
+
+	ref := &Reference{}
+	err := collection.FindOne(
+		ctx,
+		bson.M{"_id": hash(reference), "expiration": bson.M{"$gt": time.Now()}},
+	).Decode(ref)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return "", ErrNotFound
+		}
+		return "", err
+	}
+
+	return ref.Token, nil
+}
+
+func normalizeNamespace(namespace string) string {
+
+	if namespace == "" {
+		return "/"
+	}
+
+	return namespace
+}
+
+func isInNamespace(namespace string, parent string) bool {
+	return namespace == parent || elemental.IsNamespaceChildrenOfNamespace(namespace, parent)
+}
+
+func hash(reference string) string {
+	h := sha256.Sum256([]byte(reference))
+	return hex.EncodeToString(h[:])
+}
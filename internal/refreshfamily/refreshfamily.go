@@ -0,0 +1,80 @@
+package refreshfamily
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.aporeto.io/elemental"
+	"go.aporeto.io/manipulate"
+	"go.aporeto.io/manipulate/manipmongo"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Identity is the identity of the internal collection
+// holding the refresh token families.
+var Identity = elemental.MakeIdentity("refreshfamily", "refreshfamilies")
+
+// ErrReused is returned when a refresh token that has
+// already been used is presented again.
+var ErrReused = errors.New("refresh token has already been used")
+
+// A Family tracks the only refresh token of a family
+// that can still be used.
+type Family struct {
+	ID         string    `bson:"_id"`
+	Current    string    `bson:"current"`
+	Expiration time.Time `bson:"expiration"`
+}
+
+// Rotate marks the refresh token with the ID used as consumed and registers
+// the refresh token with the ID next as the only valid one of the given family.
+// The family is kept until the given expiration. If the used refresh token is not
+// the current one of the family, ErrReused is returned.
+func Rotate(ctx context.Context, m manipulate.Manipulator, family string, used string, next string, expiration time.Time) error {
+
+	collection := manipmongo.GetDatabase(m).Collection(Identity.Name)
+
+	res, err := collection.UpdateOne(
+		ctx,
+		bson.M{"_id": family, "current": used},
+		bson.M{"$set": bson.M{"current": next, "expiration": expiration}},
+	)
+	if err != nil {
+		return err
+	}
+
+	if res.MatchedCount == 1 {
+		return nil
+	}
+
+	// Only the first refresh token of a family can
+	// be used while the family does not exist yet.
+	if family != used {
+		return ErrReused
+	}
+
+	if _, err := collection.InsertOne(ctx, &Family{ID: family, Current: next, Expiration: expiration}); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return ErrReused
+		}
+		return err
+	}
+
+	return nil
+}
+
+// Revoke prevents any refresh token of the given family to be used.
+func Revoke(ctx context.Context, m manipulate.Manipulator, family string) error {
+
+	collection := manipmongo.GetDatabase(m).Collection(Identity.Name)
+
+	_, err := collection.UpdateOne(
+		ctx,
+		bson.M{"_id": family},
+		bson.M{"$set": bson.M{"current": ""}},
+	)
+
+	return err
+}
@@ -0,0 +1,89 @@
+package samlceremony
+
+import (
+	"context"
+	"time"
+
+	"go.aporeto.io/manipulate"
+	"go.aporeto.io/manipulate/manipmongo"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// CacheCollection is the name of the collection
+// holding the SAML requests info.
+const CacheCollection = "samlcache"
+
+// CacheItem represents a cache SAML request info.
+type CacheItem struct {
+	State            string    `bson:"state"`
+	RequestID        string    `bson:"requestid"`
+	SourceNamespace  string    `bson:"sourcenamespace"`
+	SourceName       string    `bson:"sourcename"`
+	RedirectURL      string    `bson:"redirecturl"`
+	RedirectErrorURL string    `bson:"redirecterrorurl"`
+	VerifierHash     string    `bson:"verifierhash"`
+	Response         string    `bson:"response"`
+	Time             time.Time `bson:"time"`
+}
+
+// Set sets the given CacheItem in the database.
+func Set(m manipulate.Manipulator, item *CacheItem) error {
+
+	item.Time = time.Now()
+
+	db := manipmongo.GetDatabase(m)
+
+	collection := db.Collection(CacheCollection)
+	// Insert the item
+	_, err := collection.InsertOne(context.TODO(), item)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Get gets the items with the given state.
+// If none is found, it will return nil.
+func Get(m manipulate.Manipulator, state string) (*CacheItem, error) {
+
+	db := manipmongo.GetDatabase(m)
+
+	item := &CacheItem{}
+	collection := db.Collection(CacheCollection)
+	filter := bson.M{"state": state}
+	err := collection.FindOne(context.TODO(), filter).Decode(item)
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// SetResponse stores the SAML response posted by the
+// identity provider in the item with the given state.
+func SetResponse(m manipulate.Manipulator, state string, response string) error {
+
+	db := manipmongo.GetDatabase(m)
+
+	collection := db.Collection(CacheCollection)
+	filter := bson.M{"state": state}
+	update := bson.M{"$set": bson.M{"response": response}}
+	_, err := collection.UpdateOne(context.TODO(), filter, update)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Delete deletes the items with the given state.
+func Delete(m manipulate.Manipulator, state string) error {
+
+	db := manipmongo.GetDatabase(m)
+
+	collection := db.Collection(CacheCollection)
+	filter := bson.M{"state": state}
+	_, err := collection.DeleteOne(context.TODO(), filter)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package samlceremony
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"go.aporeto.io/a3s/pkgs/api"
+	"go.aporeto.io/elemental"
+	"go.aporeto.io/manipulate"
+)
+
+// maxResponseSize is the maximum size of the
+// form posted by the identity providers.
+const maxResponseSize = 1 << 20
+
+// MakeMetadataHandler returns the handler serving the metadata of the
+// service provider of the SAML source given by the namespace and name
+// query parameters.
+func MakeMetadataHandler(m manipulate.Manipulator, publicAPIURL string) http.HandlerFunc {
+
+	return func(w http.ResponseWriter, req *http.Request) {
+
+		q := req.URL.Query()
+		namespace := q.Get("namespace")
+		name := q.Get("name")
+
+		if namespace == "" || name == "" {
+			http.Error(w, "You must set namespace and name", http.StatusBadRequest)
+			return
+		}
+
+		src, err := retrieveSource(req.Context(), m, namespace, name)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+
+		sp, err := MakeServiceProvider(src, publicAPIURL)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		data, err := xml.MarshalIndent(sp.Metadata(), "", "  ")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Add("Content-Type", "application/samlmetadata+xml")
+		_, _ = w.Write(data)
+	}
+}
+
+// MakeACSHandler returns the handler serving the assertion consumer
+// service. It stores the response posted by the identity provider with
+// the request matching its RelayState, and redirects the user to the
+// redirect URL of the request, if it is still allowed by the source.
+// The response is verified when the ceremony is finished by issuing a
+// token with the state and its verifier.
+func MakeACSHandler(m manipulate.Manipulator) http.HandlerFunc {
+
+	return func(w http.ResponseWriter, req *http.Request) {
+
+		if req.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		req.Body = http.MaxBytesReader(w, req.Body, maxResponseSize)
+		if err := req.ParseForm(); err != nil {
+			http.Error(w, fmt.Sprintf("Unable to parse form: %s", err), http.StatusBadRequest)
+			return
+		}
+
+		state := req.PostForm.Get("RelayState")
+		response := req.PostForm.Get("SAMLResponse")
+
+		if state == "" || response == "" {
+			http.Error(w, "Missing RelayState or SAMLResponse", http.StatusBadRequest)
+			return
+		}
+
+		item, err := Get(m, state)
+		if err != nil {
+			http.Error(w, "Unknown or expired RelayState", http.StatusBadRequest)
+			return
+		}
+
+		if item.Response != "" {
+			http.Error(w, "A response has already been received for this RelayState", http.StatusBadRequest)
+			return
+		}
+
+		src, err := retrieveSource(req.Context(), m, item.SourceNamespace, item.SourceName)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+
+		if !IsRedirectURLAllowed(src, item.RedirectURL) {
+			http.Error(w, "The redirect url is not allowed by the source", http.StatusForbidden)
+			return
+		}
+
+		u, err := url.Parse(item.RedirectURL)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Invalid redirect url: %s", err), http.StatusBadRequest)
+			return
+		}
+
+		if err := SetResponse(m, state, response); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		query := u.Query()
+		query.Set("state", state)
+		u.RawQuery = query.Encode()
+
+		http.Redirect(w, req, u.String(), http.StatusSeeOther)
+	}
+}
+
+func retrieveSource(ctx context.Context, m manipulate.Manipulator, namespace string, name string) (*api.SAMLSource, error) {
+
+	mctx := manipulate.NewContext(ctx,
+		manipulate.ContextOptionNamespace(namespace),
+		manipulate.ContextOptionFilter(
+			elemental.NewFilterComposer().WithKey("name").Equals(name).
+				Done(),
+		),
+	)
+
+	srcs := api.SAMLSourcesList{}
+	if err := m.RetrieveMany(mctx, &srcs); err != nil {
+		return nil, err
+	}
+
+	if len(srcs) != 1 {
+		return nil, fmt.Errorf("unable to find the requested saml source")
+	}
+
+	return srcs[0], nil
+}
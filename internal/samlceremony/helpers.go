@@ -0,0 +1,144 @@
+package samlceremony
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/crewjam/saml"
+	"go.aporeto.io/a3s/pkgs/api"
+)
+
+// Paths of the service provider endpoints served by a3s.
+const (
+	MetadataPath = "/saml/metadata"
+	ACSPath      = "/saml/acs"
+)
+
+// VerifierCookie is the name of the cookie holding the verifier
+// of a ceremony started with an HTTP redirection.
+const VerifierCookie = "x-a3s-saml-verifier"
+
+// GenerateState generates a random state that can
+// be used as a SAML RelayState.
+func GenerateState(size int) (string, error) {
+
+	state := make([]byte, size)
+	if _, err := rand.Read(state); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(state), nil
+}
+
+// HashVerifier returns the hash of the given verifier,
+// which is stored with the state instead of the verifier.
+func HashVerifier(verifier string) string {
+
+	sum := sha256.Sum256([]byte(verifier))
+
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// CheckVerifier returns true if the given verifier
+// matches the verifier hash of the given item.
+func CheckVerifier(item *CacheItem, verifier string) bool {
+
+	if item.VerifierHash == "" || verifier == "" {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(HashVerifier(verifier)), []byte(item.VerifierHash)) == 1
+}
+
+// IsRedirectURLAllowed returns true if the given URL
+// is one of the allowed redirect URLs of the given source.
+func IsRedirectURLAllowed(src *api.SAMLSource, u string) bool {
+
+	for _, allowed := range src.AllowedRedirectURLs {
+		if u == allowed {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MakeServiceProvider returns the service provider to use with the given
+// source. The endpoints of the service provider are served from the given
+// public API URL.
+func MakeServiceProvider(src *api.SAMLSource, publicAPIURL string) (*saml.ServiceProvider, error) {
+
+	idpMetadata, err := parseIDPMetadata([]byte(src.IDPMetadata))
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse idp metadata: %w", err)
+	}
+
+	base, err := url.Parse(strings.TrimRight(publicAPIURL, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse public api url: %w", err)
+	}
+
+	metadataURL := *base
+	metadataURL.Path += MetadataPath
+	metadataURL.RawQuery = url.Values{
+		"namespace": {src.Namespace},
+		"name":      {src.Name},
+	}.Encode()
+
+	acsURL := *base
+	acsURL.Path += ACSPath
+
+	return &saml.ServiceProvider{
+		EntityID:    src.EntityID,
+		MetadataURL: metadataURL,
+		AcsURL:      acsURL,
+		IDPMetadata: idpMetadata,
+	}, nil
+}
+
+// parseIDPMetadata parses the given metadata, which can either
+// be an EntityDescriptor, or an EntitiesDescriptor containing
+// the EntityDescriptor of the identity provider.
+func parseIDPMetadata(data []byte) (*saml.EntityDescriptor, error) {
+
+	root := struct {
+		XMLName xml.Name
+	}{}
+
+	if err := xml.Unmarshal(data, &root); err != nil {
+		return nil, err
+	}
+
+	if root.XMLName.Local == "EntitiesDescriptor" {
+
+		entities := &saml.EntitiesDescriptor{}
+		if err := xml.Unmarshal(data, entities); err != nil {
+			return nil, err
+		}
+
+		for i, e := range entities.EntityDescriptors {
+			if len(e.IDPSSODescriptors) > 0 {
+				return &entities.EntityDescriptors[i], nil
+			}
+		}
+
+		return nil, fmt.Errorf("no entity found with an IDPSSODescriptor")
+	}
+
+	entity := &saml.EntityDescriptor{}
+	if err := xml.Unmarshal(data, entity); err != nil {
+		return nil, err
+	}
+
+	if len(entity.IDPSSODescriptors) == 0 {
+		return nil, fmt.Errorf("entity '%s' has no IDPSSODescriptor", entity.EntityID)
+	}
+
+	return entity, nil
+}
@@ -0,0 +1,139 @@
+package samlceremony
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.aporeto.io/a3s/pkgs/api"
+)
+
+const idpMetadata = `<EntityDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://idp/metadata">
+  <IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://idp/sso"></SingleSignOnService>
+  </IDPSSODescriptor>
+</EntityDescriptor>`
+
+func TestGenerateState(t *testing.T) {
+
+	Convey("Calling GenerateState should work", t, func() {
+		s1, err := GenerateState(12)
+		So(err, ShouldBeNil)
+		So(len(s1), ShouldEqual, 16)
+		So(s1, ShouldNotContainSubstring, "+")
+		So(s1, ShouldNotContainSubstring, "/")
+
+		s2, _ := GenerateState(12)
+		So(s1, ShouldNotEqual, s2)
+	})
+}
+
+func TestVerifier(t *testing.T) {
+
+	Convey("Given a cache item with a verifier hash", t, func() {
+
+		item := &CacheItem{VerifierHash: HashVerifier("verifier")}
+
+		Convey("Calling CheckVerifier with the verifier should work", func() {
+			So(CheckVerifier(item, "verifier"), ShouldBeTrue)
+		})
+
+		Convey("Calling CheckVerifier with another verifier should fail", func() {
+			So(CheckVerifier(item, "other"), ShouldBeFalse)
+		})
+
+		Convey("Calling CheckVerifier with an empty verifier should fail", func() {
+			So(CheckVerifier(item, ""), ShouldBeFalse)
+		})
+
+		Convey("Calling CheckVerifier on an item without hash should fail", func() {
+			So(CheckVerifier(&CacheItem{}, ""), ShouldBeFalse)
+			So(CheckVerifier(&CacheItem{}, HashVerifier("")), ShouldBeFalse)
+		})
+	})
+}
+
+func TestIsRedirectURLAllowed(t *testing.T) {
+
+	Convey("Given a SAML source with allowed redirect urls", t, func() {
+
+		src := api.NewSAMLSource()
+		src.AllowedRedirectURLs = []string{"https://app.example.com/login"}
+
+		Convey("Then an allowed url should be allowed", func() {
+			So(IsRedirectURLAllowed(src, "https://app.example.com/login"), ShouldBeTrue)
+		})
+
+		Convey("Then other urls should not be allowed", func() {
+			So(IsRedirectURLAllowed(src, "https://evil.example.com/login"), ShouldBeFalse)
+			So(IsRedirectURLAllowed(src, "https://app.example.com/login/other"), ShouldBeFalse)
+			So(IsRedirectURLAllowed(src, "https://app.example.com/login?next=https://evil.example.com"), ShouldBeFalse)
+			So(IsRedirectURLAllowed(src, ""), ShouldBeFalse)
+		})
+
+		Convey("Then no url should be allowed by a source without allowed redirect urls", func() {
+			So(IsRedirectURLAllowed(api.NewSAMLSource(), "https://app.example.com/login"), ShouldBeFalse)
+		})
+	})
+}
+
+func TestMakeServiceProvider(t *testing.T) {
+
+	Convey("Given a SAML source", t, func() {
+
+		src := api.NewSAMLSource()
+		src.Namespace = "/my/ns"
+		src.Name = "saml"
+		src.IDPMetadata = idpMetadata
+
+		Convey("Calling MakeServiceProvider should work", func() {
+
+			sp, err := MakeServiceProvider(src, "https://a3s.com/")
+			So(err, ShouldBeNil)
+			So(sp.EntityID, ShouldEqual, "")
+			So(sp.AcsURL.String(), ShouldEqual, "https://a3s.com/saml/acs")
+			So(sp.MetadataURL.String(), ShouldEqual, "https://a3s.com/saml/metadata?name=saml&namespace=%2Fmy%2Fns")
+			So(sp.IDPMetadata.EntityID, ShouldEqual, "https://idp/metadata")
+			So(sp.GetSSOBindingLocation("urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"), ShouldEqual, "https://idp/sso")
+			So(sp.Metadata().EntityID, ShouldEqual, sp.MetadataURL.String())
+		})
+
+		Convey("Calling MakeServiceProvider with an entity ID should work", func() {
+
+			src.EntityID = "https://a3s.com/saml"
+			sp, err := MakeServiceProvider(src, "https://a3s.com")
+			So(err, ShouldBeNil)
+			So(sp.Metadata().EntityID, ShouldEqual, "https://a3s.com/saml")
+		})
+
+		Convey("Calling MakeServiceProvider with metadata wrapped in an EntitiesDescriptor should work", func() {
+
+			src.IDPMetadata = `<EntitiesDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata">
+  <EntityDescriptor entityID="https://sp"></EntityDescriptor>
+  ` + idpMetadata + `
+</EntitiesDescriptor>`
+
+			sp, err := MakeServiceProvider(src, "https://a3s.com")
+			So(err, ShouldBeNil)
+			So(sp.IDPMetadata.EntityID, ShouldEqual, "https://idp/metadata")
+		})
+
+		Convey("Calling MakeServiceProvider with metadata that is not an IDP should fail", func() {
+
+			src.IDPMetadata = `<EntityDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://sp"></EntityDescriptor>`
+
+			sp, err := MakeServiceProvider(src, "https://a3s.com")
+			So(sp, ShouldBeNil)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "unable to parse idp metadata: entity 'https://sp' has no IDPSSODescriptor")
+		})
+
+		Convey("Calling MakeServiceProvider with invalid metadata should fail", func() {
+
+			src.IDPMetadata = `not xml`
+
+			sp, err := MakeServiceProvider(src, "https://a3s.com")
+			So(sp, ShouldBeNil)
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
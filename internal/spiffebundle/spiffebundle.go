@@ -0,0 +1,161 @@
+package spiffebundle
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.aporeto.io/a3s/internal/oidcceremony"
+	"go.aporeto.io/a3s/pkgs/token"
+)
+
+const (
+	maxBundleSize = 1 << 20
+	retryInterval = 10 * time.Second
+)
+
+// A Bundle holds the JWT authorities of a SPIFFE trust bundle.
+type Bundle struct {
+	JWTAuthorities []crypto.PublicKey
+	RefreshHint    time.Duration
+}
+
+// Parse parses the given SPIFFE trust bundle in JWKS format.
+// Only the keys meant to verify JWT-SVIDs are kept.
+func Parse(data []byte) (*Bundle, error) {
+
+	jwks, err := token.ParseJWKS(data)
+	if err != nil {
+		return nil, err
+	}
+
+	params := struct {
+		RefreshHint int64 `json:"spiffe_refresh_hint"`
+	}{}
+	if err := json.Unmarshal(data, &params); err != nil {
+		return nil, fmt.Errorf("unable to parse bundle: %w", err)
+	}
+
+	b := &Bundle{
+		RefreshHint: time.Duration(params.RefreshHint) * time.Second,
+	}
+
+	for _, k := range jwks.Keys {
+		if k.Use != "jwt-svid" {
+			continue
+		}
+		if pk := k.PublicKey(); pk != nil {
+			b.JWTAuthorities = append(b.JWTAuthorities, pk)
+		}
+	}
+
+	if len(b.JWTAuthorities) == 0 {
+		return nil, fmt.Errorf("bundle does not contain any jwt-svid key")
+	}
+
+	return b, nil
+}
+
+// A Cache holds the bundles retrieved from bundle endpoints,
+// so they are shared across requests. A bundle is retrieved
+// again once it is older than its refresh hint, or than the
+// default refresh interval if the bundle does not have one.
+// If the endpoint cannot be reached, the last retrieved bundle
+// is kept until the endpoint can be reached again.
+type Cache struct {
+	defaultRefresh time.Duration
+	entries        map[string]cacheEntry
+
+	sync.Mutex
+}
+
+type cacheEntry struct {
+	bundle *Bundle
+	next   time.Time
+}
+
+// NewCache returns a new Cache refreshing the bundles
+// without refresh hint at the given interval.
+func NewCache(defaultRefresh time.Duration) *Cache {
+	return &Cache{
+		defaultRefresh: defaultRefresh,
+		entries:        map[string]cacheEntry{},
+	}
+}
+
+// Get returns the bundle served by the bundle endpoint at the given URL.
+// If ca is not empty, it is used to verify the server certificate instead
+// of the system trust store.
+func (c *Cache) Get(ctx context.Context, url string, ca string) (*Bundle, error) {
+
+	key := url + "\n" + ca
+	now := time.Now()
+
+	c.Lock()
+	entry, ok := c.entries[key]
+	c.Unlock()
+
+	if ok && now.Before(entry.next) {
+		return entry.bundle, nil
+	}
+
+	client, err := oidcceremony.MakeOIDCProviderClient(ca)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create bundle http client: %w", err)
+	}
+
+	bundle, err := fetch(ctx, client, url)
+	if err != nil {
+
+		if !ok {
+			return nil, err
+		}
+
+		c.Lock()
+		c.entries[key] = cacheEntry{bundle: entry.bundle, next: now.Add(retryInterval)}
+		c.Unlock()
+
+		return entry.bundle, nil
+	}
+
+	refresh := bundle.RefreshHint
+	if refresh <= 0 {
+		refresh = c.defaultRefresh
+	}
+
+	c.Lock()
+	c.entries[key] = cacheEntry{bundle: bundle, next: now.Add(refresh)}
+	c.Unlock()
+
+	return bundle, nil
+}
+
+func fetch(ctx context.Context, client *http.Client, url string) (*Bundle, error) {
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build bundle request: %w", err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve bundle: %w", err)
+	}
+	defer resp.Body.Close() // nolint: errcheck
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to retrieve bundle: server responded with '%s'", resp.Status)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxBundleSize))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read bundle: %w", err)
+	}
+
+	return Parse(data)
+}
@@ -0,0 +1,201 @@
+package spiffebundle
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func makeBundle(hint int, keys ...*ecdsa.PrivateKey) []byte {
+
+	jwks := []map[string]string{
+		{
+			"kty": "EC",
+			"use": "x509-svid",
+			"crv": "P-256",
+			"x":   base64.RawURLEncoding.EncodeToString(keys[0].X.Bytes()),
+			"y":   base64.RawURLEncoding.EncodeToString(keys[0].Y.Bytes()),
+		},
+	}
+
+	for i, k := range keys {
+		jwks = append(jwks, map[string]string{
+			"kty": "EC",
+			"use": "jwt-svid",
+			"kid": string(rune('a' + i)),
+			"crv": "P-256",
+			"x":   base64.RawURLEncoding.EncodeToString(k.X.Bytes()),
+			"y":   base64.RawURLEncoding.EncodeToString(k.Y.Bytes()),
+		})
+	}
+
+	data, _ := json.Marshal(map[string]any{
+		"keys":                jwks,
+		"spiffe_refresh_hint": hint,
+		"spiffe_sequence":     1,
+	})
+
+	return data
+}
+
+func TestParse(t *testing.T) {
+
+	key1, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	key2, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	Convey("Calling Parse on a valid bundle should work", t, func() {
+		b, err := Parse(makeBundle(300, key1, key2))
+		So(err, ShouldBeNil)
+		So(b.RefreshHint, ShouldEqual, 5*time.Minute)
+		So(len(b.JWTAuthorities), ShouldEqual, 2)
+		So(b.JWTAuthorities[0].(*ecdsa.PublicKey).Equal(&key1.PublicKey), ShouldBeTrue)
+		So(b.JWTAuthorities[1].(*ecdsa.PublicKey).Equal(&key2.PublicKey), ShouldBeTrue)
+	})
+
+	Convey("Calling Parse on a bundle without jwt-svid key should fail", t, func() {
+		b, err := Parse([]byte(`{"keys":[{"kty":"EC","use":"x509-svid"}]}`))
+		So(b, ShouldBeNil)
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldEqual, "bundle does not contain any jwt-svid key")
+	})
+
+	Convey("Calling Parse on invalid data should fail", t, func() {
+		b, err := Parse([]byte(`not json`))
+		So(b, ShouldBeNil)
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func TestCache(t *testing.T) {
+
+	Convey("Given a bundle endpoint", t, func() {
+
+		key1, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		key2, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+		var hits int32
+		var failing atomic.Bool
+		var bundle atomic.Value
+		bundle.Store(makeBundle(0, key1))
+
+		ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&hits, 1)
+			if failing.Load() {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			_, _ = w.Write(bundle.Load().([]byte))
+		}))
+		defer ts.Close()
+
+		ca := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw}))
+
+		Convey("When I get the bundle twice within the refresh interval", func() {
+
+			c := NewCache(time.Hour)
+
+			b1, err := c.Get(context.Background(), ts.URL, ca)
+			So(err, ShouldBeNil)
+			b2, err := c.Get(context.Background(), ts.URL, ca)
+			So(err, ShouldBeNil)
+
+			So(b2, ShouldEqual, b1)
+			So(atomic.LoadInt32(&hits), ShouldEqual, 1)
+		})
+
+		Convey("When I get the bundle after the refresh interval", func() {
+
+			c := NewCache(time.Millisecond)
+
+			b1, err := c.Get(context.Background(), ts.URL, ca)
+			So(err, ShouldBeNil)
+
+			bundle.Store(makeBundle(0, key1, key2))
+			time.Sleep(2 * time.Millisecond)
+
+			b2, err := c.Get(context.Background(), ts.URL, ca)
+			So(err, ShouldBeNil)
+
+			So(len(b1.JWTAuthorities), ShouldEqual, 1)
+			So(len(b2.JWTAuthorities), ShouldEqual, 2)
+			So(atomic.LoadInt32(&hits), ShouldEqual, 2)
+		})
+
+		Convey("When the bundle has a refresh hint", func() {
+
+			bundle.Store(makeBundle(3600, key1))
+			c := NewCache(time.Millisecond)
+
+			_, err := c.Get(context.Background(), ts.URL, ca)
+			So(err, ShouldBeNil)
+			time.Sleep(2 * time.Millisecond)
+			_, err = c.Get(context.Background(), ts.URL, ca)
+			So(err, ShouldBeNil)
+
+			So(atomic.LoadInt32(&hits), ShouldEqual, 1)
+		})
+
+		Convey("When the endpoint fails after the bundle has been retrieved", func() {
+
+			c := NewCache(time.Millisecond)
+
+			b1, err := c.Get(context.Background(), ts.URL, ca)
+			So(err, ShouldBeNil)
+
+			failing.Store(true)
+			time.Sleep(2 * time.Millisecond)
+
+			b2, err := c.Get(context.Background(), ts.URL, ca)
+			So(err, ShouldBeNil)
+			So(b2, ShouldEqual, b1)
+
+			Convey("Then it should not be queried again before the retry interval", func() {
+				_, err := c.Get(context.Background(), ts.URL, ca)
+				So(err, ShouldBeNil)
+				So(atomic.LoadInt32(&hits), ShouldEqual, 2)
+			})
+		})
+
+		Convey("When the endpoint fails", func() {
+
+			failing.Store(true)
+			c := NewCache(time.Hour)
+
+			b, err := c.Get(context.Background(), ts.URL, ca)
+			So(b, ShouldBeNil)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "unable to retrieve bundle: server responded with '500 Internal Server Error'")
+		})
+
+		Convey("When the endpoint is not trusted", func() {
+
+			c := NewCache(time.Hour)
+
+			b, err := c.Get(context.Background(), ts.URL, "")
+			So(b, ShouldBeNil)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldStartWith, "unable to retrieve bundle: ")
+		})
+
+		Convey("When the CA is invalid", func() {
+
+			c := NewCache(time.Hour)
+
+			b, err := c.Get(context.Background(), ts.URL, "not a certificate")
+			So(b, ShouldBeNil)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "unable to create bundle http client: unable to append given ca to ca pool")
+		})
+	})
+}
@@ -1,7 +1,10 @@
 package api
 
 import (
+	"crypto/x509"
+	"encoding/json"
 	"encoding/pem"
+	"encoding/xml"
 	"fmt"
 	"net"
 	"net/http"
@@ -112,6 +115,28 @@ func ValidateAuthorizationSubject(attribute string, subject [][]string) error {
 	return nil
 }
 
+// ValidateLocalUserClaims validates the additional claims of a local user.
+func ValidateLocalUserClaims(attribute string, claims []string) error {
+
+	for _, claim := range claims {
+
+		if len([]byte(claim)) >= 1024 {
+			return makeErr(attribute, fmt.Sprintf("'%s' must be less than 1024 bytes", claim))
+		}
+		if !tagRegex.MatchString(claim) {
+			return makeErr(attribute, fmt.Sprintf("'%s' must contain at least one '=' symbol separating two valid words", claim))
+		}
+		if strings.HasPrefix(claim, "@") {
+			return makeErr(attribute, fmt.Sprintf("'%s' must not start with '@'", claim))
+		}
+		if strings.HasPrefix(claim, "username=") {
+			return makeErr(attribute, fmt.Sprintf("'%s' must not override the username claim", claim))
+		}
+	}
+
+	return nil
+}
+
 // ValidatePEM validates a string contains a PEM.
 func ValidatePEM(attribute string, pemdata string) error {
 
@@ -137,6 +162,40 @@ func ValidatePEM(attribute string, pemdata string) error {
 	}
 }
 
+// ValidateCRL validates the given string contains
+// one or more certificate revocation lists in PEM format.
+func ValidateCRL(attribute string, crldata string) error {
+
+	if crldata == "" {
+		return nil
+	}
+
+	var i int
+	var block *pem.Block
+	rest := []byte(crldata)
+
+	for {
+		block, rest = pem.Decode(rest)
+
+		if block == nil {
+			return makeErr(attribute, fmt.Sprintf("Unable to decode PEM number %d", i))
+		}
+
+		if block.Type != "X509 CRL" {
+			return makeErr(attribute, fmt.Sprintf("PEM number %d is not a certificate revocation list", i))
+		}
+
+		if _, err := x509.ParseRevocationList(block.Bytes); err != nil {
+			return makeErr(attribute, fmt.Sprintf("Unable to parse certificate revocation list number %d: %s", i, err))
+		}
+
+		if len(rest) == 0 {
+			return nil
+		}
+		i++
+	}
+}
+
 // ValidateIssue validates a whole issue object.
 func ValidateIssue(iss *Issue) error {
 
@@ -148,6 +207,14 @@ func ValidateIssue(iss *Issue) error {
 		if iss.TokenType == IssueTokenTypeRefresh {
 			return makeErr("tokenType", "You cannot ask for a resfresh token for the request source type")
 		}
+	case IssueSourceTypeSAML:
+		if iss.InputSAML == nil {
+			return makeErr("inputSAML", "You must set inputSAML for the requested sourceType")
+		}
+	case IssueSourceTypeSPIFFE:
+		if iss.InputSPIFFE == nil {
+			return makeErr("inputSPIFFE", "You must set inputSPIFFE for the requested sourceType")
+		}
 	case IssueSourceTypeRemoteA3S:
 		if iss.InputRemoteA3S == nil {
 			return makeErr("inputRemoteA3S", "You must set inputRemoteA3S for the requested sourceType")
@@ -156,10 +223,29 @@ func ValidateIssue(iss *Issue) error {
 		if iss.InputAWS == nil {
 			return makeErr("inputAWS", "You must set inputAWS for the requested sourceType")
 		}
+	case IssueSourceTypeJWT:
+		if iss.InputJWT == nil {
+			return makeErr("inputJWT", "You must set inputJWT for the requested sourceType")
+		}
+	case IssueSourceTypeKubernetes:
+		if iss.InputKubernetes == nil {
+			return makeErr("inputKubernetes", "You must set inputKubernetes for the requested sourceType")
+		}
 	case IssueSourceTypeLDAP:
 		if iss.InputLDAP == nil {
 			return makeErr("inputLDAP", "You must set inputLDAP for the requested sourceType")
 		}
+	case IssueSourceTypeLocal:
+		if iss.InputLocal == nil {
+			return makeErr("inputLocal", "You must set inputLocal for the requested sourceType")
+		}
+	case IssueSourceTypeMFA:
+		if iss.InputMFA == nil {
+			return makeErr("inputMFA", "You must set inputMFA for the requested sourceType")
+		}
+		if iss.TokenType == IssueTokenTypeRefresh {
+			return makeErr("tokenType", "You cannot ask for a resfresh token for the request source type")
+		}
 	case IssueSourceTypeGCP:
 		if iss.InputGCP == nil {
 			return makeErr("inputGCP", "You must set inputCGP for the requested sourceType")
@@ -176,6 +262,268 @@ func ValidateIssue(iss *Issue) error {
 		if iss.InputHTTP == nil {
 			return makeErr("inputHTTP", "You must set inputHTTP for the requested sourceType")
 		}
+	case IssueSourceTypeTokenExchange:
+		if iss.InputTokenExchange == nil {
+			return makeErr("inputTokenExchange", "You must set inputTokenExchange for the requested sourceType")
+		}
+		if len(iss.Audience) == 0 {
+			return makeErr("audience", "You must set the audience of the token for the requested sourceType")
+		}
+		if iss.RestrictedNamespace == "" {
+			return makeErr("restrictedNamespace", "You must set restrictedNamespace for the requested sourceType")
+		}
+		if iss.TokenType == IssueTokenTypeRefresh {
+			return makeErr("tokenType", "You cannot ask for a resfresh token for the request source type")
+		}
+	}
+
+	if iss.CertificateBound && iss.SourceType != IssueSourceTypeMTLS {
+		return makeErr("certificateBound", "You can only ask for a certificate bound token for the MTLS sourceType")
+	}
+
+	return nil
+}
+
+// ValidateRevocation validates a whole revocation object.
+func ValidateRevocation(rev *Revocation) error {
+
+	byToken := rev.TokenID != "" || rev.Token != ""
+	bySource := rev.SourceType != "" || rev.SourceName != "" || rev.SourceNamespace != ""
+
+	var modes int
+	for _, set := range []bool{byToken, rev.TokenFamily != "", len(rev.Subject) > 0, bySource} {
+		if set {
+			modes++
+		}
+	}
+
+	switch {
+	case modes == 0:
+		return makeErr("tokenID", "You must set either tokenID or token, tokenFamily, subject or sourceType and sourceName")
+	case modes > 1:
+		return makeErr("tokenID", "You can only set one of tokenID or token, tokenFamily, subject or sourceType and sourceName")
+	}
+
+	if bySource {
+		if rev.SourceType == "" {
+			return makeErr("sourceType", "You must set sourceType when revoking by source")
+		}
+		if rev.SourceName == "" {
+			return makeErr("sourceName", "You must set sourceName when revoking by source")
+		}
+	}
+
+	if !byToken && !rev.Expiration.IsZero() {
+		return makeErr("expiration", "You can only set expiration when revoking by tokenID or token")
+	}
+
+	return nil
+}
+
+var awsAccountRegex = regexp.MustCompile(`^[0-9]{12}$`)
+
+// ValidateAWSSource validates a whole awssource object.
+func ValidateAWSSource(src *AWSSource) error {
+
+	if len(src.AllowedAccounts) == 0 && len(src.AllowedRoles) == 0 {
+		return makeErr("allowedAccounts", "You must set allowedAccounts, allowedRoles or both")
+	}
+
+	for _, account := range src.AllowedAccounts {
+		if !awsAccountRegex.MatchString(account) {
+			return makeErr("allowedAccounts", fmt.Sprintf("Invalid AWS account ID '%s': it must be 12 digits", account))
+		}
+	}
+
+	for _, role := range src.AllowedRoles {
+		if !strings.HasPrefix(role, "arn:") {
+			return makeErr("allowedRoles", fmt.Sprintf("Invalid role pattern '%s': it must be an ARN", role))
+		}
+	}
+
+	if src.Endpoint != "" {
+		if err := ValidateURL("endpoint", src.Endpoint); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateAzureSource validates a whole azuresource object.
+func ValidateAzureSource(src *AzureSource) error {
+
+	if len(src.AllowedTenants) == 0 && len(src.AllowedSubscriptions) == 0 {
+		return makeErr("allowedTenants", "You must set allowedTenants, allowedSubscriptions or both")
+	}
+
+	return nil
+}
+
+// ValidateGCPSource validates a whole gcpsource object.
+func ValidateGCPSource(src *GCPSource) error {
+
+	if len(src.AllowedProjectIDs) == 0 && len(src.AllowedProjectNumbers) == 0 {
+		return makeErr("allowedProjectIDs", "You must set allowedProjectIDs, allowedProjectNumbers or both")
+	}
+
+	for _, number := range src.AllowedProjectNumbers {
+		if number <= 0 {
+			return makeErr("allowedProjectNumbers", fmt.Sprintf("Invalid GCP project number '%d': it must be positive", number))
+		}
+	}
+
+	return nil
+}
+
+// ValidateKubernetesSource validates a whole kubernetessource object.
+func ValidateKubernetesSource(src *KubernetesSource) error {
+
+	switch src.Mode {
+
+	case KubernetesSourceModeJWKS:
+
+		if src.Issuer == "" {
+			return makeErr("issuer", "You must set issuer when the mode is JWKS")
+		}
+
+		if src.KeysURL == "" {
+			return makeErr("keysURL", "You must set keysURL when the mode is JWKS")
+		}
+
+		if err := ValidateURL("keysURL", src.KeysURL); err != nil {
+			return err
+		}
+
+	case KubernetesSourceModeTokenReview:
+
+		if src.Endpoint == "" {
+			return makeErr("endpoint", "You must set endpoint when the mode is TokenReview")
+		}
+
+		if err := ValidateURL("endpoint", src.Endpoint); err != nil {
+			return err
+		}
+
+		if src.ReviewerToken == "" {
+			return makeErr("reviewerToken", "You must set reviewerToken when the mode is TokenReview")
+		}
+	}
+
+	return nil
+}
+
+// ValidateJWTSource validates the given JWTSource.
+func ValidateJWTSource(src *JWTSource) error {
+
+	if src.KeysURL != "" {
+		if err := ValidateURL("keysURL", src.KeysURL); err != nil {
+			return err
+		}
+	}
+
+	if src.JWKS != "" {
+
+		jwks := struct {
+			Keys []json.RawMessage `json:"keys"`
+		}{}
+
+		if err := json.Unmarshal([]byte(src.JWKS), &jwks); err != nil {
+			return makeErr("JWKS", fmt.Sprintf("Invalid JWKS: %s", err))
+		}
+
+		if len(jwks.Keys) == 0 {
+			return makeErr("JWKS", "The JWKS must contain at least one key")
+		}
+	}
+
+	for _, c := range src.RequiredClaims {
+		if k, _, ok := strings.Cut(c, "="); !ok || k == "" {
+			return makeErr("requiredClaims", fmt.Sprintf("Required claim '%s' must be in the form key=value", c))
+		}
+	}
+
+	return nil
+}
+
+// ValidateOIDCSource validates the given OIDCSource.
+func ValidateOIDCSource(src *OIDCSource) error {
+
+	claims := make(map[string]struct{}, len(src.ClaimMapping))
+	for claim, key := range src.ClaimMapping {
+		if key == "" || strings.Contains(key, "=") || strings.HasPrefix(key, "@") {
+			return makeErr("claimMapping", fmt.Sprintf("Invalid claim key '%s' for claim '%s'", key, claim))
+		}
+		// Claims are matched case insensitively.
+		lclaim := strings.ToLower(claim)
+		if _, ok := claims[lclaim]; ok {
+			return makeErr("claimMapping", fmt.Sprintf("Claim '%s' is mapped more than once", lclaim))
+		}
+		claims[lclaim] = struct{}{}
+	}
+
+	return nil
+}
+
+// ValidateSAMLSource validates the given SAMLSource.
+func ValidateSAMLSource(src *SAMLSource) error {
+
+	root := struct {
+		XMLName xml.Name
+	}{}
+
+	if err := xml.Unmarshal([]byte(src.IDPMetadata), &root); err != nil {
+		return makeErr("IDPMetadata", fmt.Sprintf("Invalid IDP metadata: %s", err))
+	}
+
+	if root.XMLName.Local != "EntityDescriptor" && root.XMLName.Local != "EntitiesDescriptor" {
+		return makeErr("IDPMetadata", "The IDP metadata must be an EntityDescriptor or an EntitiesDescriptor")
+	}
+
+	for name, key := range src.AttributeMapping {
+		if key == "" || strings.Contains(key, "=") || strings.HasPrefix(key, "@") {
+			return makeErr("attributeMapping", fmt.Sprintf("Invalid claim key '%s' for attribute '%s'", key, name))
+		}
+	}
+
+	for _, u := range src.AllowedRedirectURLs {
+		if err := ValidateURL("allowedRedirectURLs", u); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateSPIFFESource validates the given SPIFFESource.
+func ValidateSPIFFESource(src *SPIFFESource) error {
+
+	if (src.Bundle == "") == (src.BundleEndpointURL == "") {
+		return makeErr("bundle", "You must set either bundle or bundleEndpointURL")
+	}
+
+	if src.BundleEndpointURL != "" {
+		if err := ValidateURL("bundleEndpointURL", src.BundleEndpointURL); err != nil {
+			return err
+		}
+		if !strings.HasPrefix(src.BundleEndpointURL, "https://") {
+			return makeErr("bundleEndpointURL", "The bundle endpoint must use https")
+		}
+	}
+
+	if src.Bundle != "" {
+
+		bundle := struct {
+			Keys []json.RawMessage `json:"keys"`
+		}{}
+
+		if err := json.Unmarshal([]byte(src.Bundle), &bundle); err != nil {
+			return makeErr("bundle", fmt.Sprintf("Invalid bundle: %s", err))
+		}
+
+		if len(bundle.Keys) == 0 {
+			return makeErr("bundle", "The bundle must contain at least one key")
+		}
 	}
 
 	return nil
@@ -2,7 +2,9 @@ package api
 
 import (
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestValidateCIDR(t *testing.T) {
@@ -407,6 +409,64 @@ func TestValidateTagsExpression(t *testing.T) {
 	}
 }
 
+func TestValidateLocalUserClaims(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		claims  []string
+		wantErr string
+	}{
+		{
+			"nil claims",
+			nil,
+			"",
+		},
+		{
+			"valid claims",
+			[]string{"team=blue", "email=joe@example.com"},
+			"",
+		},
+		{
+			"too long claim",
+			[]string{"a=" + strings.Repeat("a", 1024)},
+			fmt.Sprintf("error 422 (a3s): Validation Error: 'a=%s' must be less than 1024 bytes", strings.Repeat("a", 1024)),
+		},
+		{
+			"invalid claim",
+			[]string{"team=blue", "aa"},
+			"error 422 (a3s): Validation Error: 'aa' must contain at least one '=' symbol separating two valid words",
+		},
+		{
+			"reserved claim",
+			[]string{"@source:type=ldap"},
+			"error 422 (a3s): Validation Error: '@source:type=ldap' must not start with '@'",
+		},
+		{
+			"username claim",
+			[]string{"username=admin"},
+			"error 422 (a3s): Validation Error: 'username=admin' must not override the username claim",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			err := ValidateLocalUserClaims("claims", tt.claims)
+
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("ValidateLocalUserClaims error = %v, want nil", err)
+				}
+				return
+			}
+
+			if err == nil || err.Error() != tt.wantErr {
+				t.Fatalf("ValidateLocalUserClaims error = %v, want %s", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestValidateAuthorizationSubject(t *testing.T) {
 	type args struct {
 		attribute string
@@ -685,6 +745,106 @@ ADAKBggqhkjOPQQDAgNJADBGAiEAm1u2T1vRooIy3rd0BmBSAa6WR6BtHl9nDbGN
 	}
 }
 
+func TestValidateCRL(t *testing.T) {
+	type args struct {
+		attribute string
+		crldata   string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			"nothing set",
+			args{
+				"crl",
+				``,
+			},
+			false,
+		},
+		{
+			"valid single CRL",
+			args{
+				"crl",
+				`-----BEGIN X509 CRL-----
+MIHbMIGDAgEBMAoGCCqGSM49BAMCMA0xCzAJBgNVBAMTAkNBFw0yNjEwMTgwMTQ0
+MDlaFw0zNjEwMTUwMTQ0MDlaMBQwEgIBKhcNMjYxMDE4MDE0NDA5WqAvMC0wHwYD
+VR0jBBgwFoAUPwACOXiKRMy+OR0kaPZ58KJS+GUwCgYDVR0UBAMCAQEwCgYIKoZI
+zj0EAwIDRwAwRAIgQlO+YgNSK4rV9bGraNtTfgnH4/K2tiZJu0El/hxlDxgCIEc2
+OTv7C4jFAsH0Jwk2q96/ltElCi+OmDqdDpqIAYRO
+-----END X509 CRL-----`,
+			},
+			false,
+		},
+		{
+			"valid multiple CRLs",
+			args{
+				"crl",
+				`-----BEGIN X509 CRL-----
+MIHbMIGDAgEBMAoGCCqGSM49BAMCMA0xCzAJBgNVBAMTAkNBFw0yNjEwMTgwMTQ0
+MDlaFw0zNjEwMTUwMTQ0MDlaMBQwEgIBKhcNMjYxMDE4MDE0NDA5WqAvMC0wHwYD
+VR0jBBgwFoAUPwACOXiKRMy+OR0kaPZ58KJS+GUwCgYDVR0UBAMCAQEwCgYIKoZI
+zj0EAwIDRwAwRAIgQlO+YgNSK4rV9bGraNtTfgnH4/K2tiZJu0El/hxlDxgCIEc2
+OTv7C4jFAsH0Jwk2q96/ltElCi+OmDqdDpqIAYRO
+-----END X509 CRL-----
+-----BEGIN X509 CRL-----
+MIHbMIGDAgEBMAoGCCqGSM49BAMCMA0xCzAJBgNVBAMTAkNBFw0yNjEwMTgwMTQ0
+MDlaFw0zNjEwMTUwMTQ0MDlaMBQwEgIBKhcNMjYxMDE4MDE0NDA5WqAvMC0wHwYD
+VR0jBBgwFoAUPwACOXiKRMy+OR0kaPZ58KJS+GUwCgYDVR0UBAMCAQEwCgYIKoZI
+zj0EAwIDRwAwRAIgQlO+YgNSK4rV9bGraNtTfgnH4/K2tiZJu0El/hxlDxgCIEc2
+OTv7C4jFAsH0Jwk2q96/ltElCi+OmDqdDpqIAYRO
+-----END X509 CRL-----
+`,
+			},
+			false,
+		},
+		{
+			"certificate instead of CRL",
+			args{
+				"crl",
+				`-----BEGIN CERTIFICATE-----
+MIIBpDCCAUmgAwIBAgIQDbXKAZzk9RjcNSGMsWke1zAKBggqhkjOPQQDAjBGMRAw
+DgYDVQQKEwdBcG9yZXRvMQ8wDQYDVQQLEwZhcG9tdXgxITAfBgNVBAMTGEFwb211
+eCBQdWJsaWMgU2lnbmluZyBDQTAeFw0xOTAxMjQyMjQ3MjlaFw0yODEyMDIyMjQ3
+MjlaMCoxEjAQBgNVBAoTCXNlcGhpcm90aDEUMBIGA1UEAxMLYXV0b21hdGlvbnMw
+WTATBgcqhkjOPQIBBggqhkjOPQMBBwNCAASxKA9vbyk7FXXlOCi0kTKLVne/mK8o
+ZQDPRcehze0EMwTAR5loNahC19hQtExCi64fmI3QCcrEGH9ycUoITYPgozUwMzAO
+BgNVHQ8BAf8EBAMCB4AwEwYDVR0lBAwwCgYIKwYBBQUHAwIwDAYDVR0TAQH/BAIw
+ADAKBggqhkjOPQQDAgNJADBGAiEAm1u2T1vRooIy3rd0BmBSAa6WR6BtHl9nDbGN
+1ZM+SgsCIQDu4R6OziiWbRdn50bneZT5qPO+07ALY5m4DG96VyCaQw==
+-----END CERTIFICATE-----`,
+			},
+			true,
+		},
+		{
+			"invalid CRL content",
+			args{
+				"crl",
+				`-----BEGIN X509 CRL-----
+MIHbMIGDAgEBMAoGCCqGSM49BAMCMA0xCzAJBgNVBAMTAkNBFw0yNjEwMTgwMTQ0
+-----END X509 CRL-----`,
+			},
+			true,
+		},
+		{
+			"not PEM",
+			args{
+				"crl",
+				`not pem`,
+			},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateCRL(tt.args.attribute, tt.args.crldata); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateCRL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestValidateIssue(t *testing.T) {
 	type args struct {
 		iss *Issue
@@ -735,6 +895,106 @@ func TestValidateIssue(t *testing.T) {
 			true,
 			nil,
 		},
+		{
+			"test token exchange",
+			func(*testing.T) args {
+				return args{
+					&Issue{
+						SourceType:          IssueSourceTypeTokenExchange,
+						InputTokenExchange:  &IssueTokenExchange{},
+						Audience:            []string{"aud"},
+						RestrictedNamespace: "/a",
+					},
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"test token exchange missing input",
+			func(*testing.T) args {
+				return args{
+					&Issue{
+						SourceType:          IssueSourceTypeTokenExchange,
+						Audience:            []string{"aud"},
+						RestrictedNamespace: "/a",
+					},
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"test token exchange missing audience",
+			func(*testing.T) args {
+				return args{
+					&Issue{
+						SourceType:          IssueSourceTypeTokenExchange,
+						InputTokenExchange:  &IssueTokenExchange{},
+						RestrictedNamespace: "/a",
+					},
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"test token exchange missing restricted namespace",
+			func(*testing.T) args {
+				return args{
+					&Issue{
+						SourceType:         IssueSourceTypeTokenExchange,
+						InputTokenExchange: &IssueTokenExchange{},
+						Audience:           []string{"aud"},
+					},
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"test token exchange with refresh token",
+			func(*testing.T) args {
+				return args{
+					&Issue{
+						SourceType:          IssueSourceTypeTokenExchange,
+						InputTokenExchange:  &IssueTokenExchange{},
+						Audience:            []string{"aud"},
+						RestrictedNamespace: "/a",
+						TokenType:           IssueTokenTypeRefresh,
+					},
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"test certificate bound token with mtls source",
+			func(*testing.T) args {
+				return args{
+					&Issue{
+						SourceType:       IssueSourceTypeMTLS,
+						CertificateBound: true,
+					},
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"test certificate bound token with a3s source",
+			func(*testing.T) args {
+				return args{
+					&Issue{
+						SourceType:       IssueSourceTypeA3S,
+						InputA3S:         &IssueA3S{},
+						CertificateBound: true,
+					},
+				}
+			},
+			true,
+			nil,
+		},
 		{
 			"test refresh token with a3s source",
 			func(*testing.T) args {
@@ -815,12 +1075,12 @@ func TestValidateIssue(t *testing.T) {
 			nil,
 		},
 		{
-			"test gcp missing",
+			"test local missing",
 			func(*testing.T) args {
 				return args{
 					&Issue{
-						SourceType: IssueSourceTypeGCP,
-						InputGCP:   nil,
+						SourceType: IssueSourceTypeLocal,
+						InputLocal: nil,
 					},
 				}
 			},
@@ -828,12 +1088,12 @@ func TestValidateIssue(t *testing.T) {
 			nil,
 		},
 		{
-			"test gcp present",
+			"test local present",
 			func(*testing.T) args {
 				return args{
 					&Issue{
-						SourceType: IssueSourceTypeGCP,
-						InputGCP:   &IssueGCP{},
+						SourceType: IssueSourceTypeLocal,
+						InputLocal: &IssueLocal{},
 					},
 				}
 			},
@@ -841,12 +1101,12 @@ func TestValidateIssue(t *testing.T) {
 			nil,
 		},
 		{
-			"test azure missing",
+			"test mfa missing",
 			func(*testing.T) args {
 				return args{
 					&Issue{
-						SourceType: IssueSourceTypeAzure,
-						InputAzure: nil,
+						SourceType: IssueSourceTypeMFA,
+						InputMFA:   nil,
 					},
 				}
 			},
@@ -854,12 +1114,12 @@ func TestValidateIssue(t *testing.T) {
 			nil,
 		},
 		{
-			"test azure present",
+			"test mfa present",
 			func(*testing.T) args {
 				return args{
 					&Issue{
-						SourceType: IssueSourceTypeAzure,
-						InputAzure: &IssueAzure{},
+						SourceType: IssueSourceTypeMFA,
+						InputMFA:   &IssueMFA{},
 					},
 				}
 			},
@@ -867,12 +1127,13 @@ func TestValidateIssue(t *testing.T) {
 			nil,
 		},
 		{
-			"test oidc missing",
+			"test mfa with refresh token",
 			func(*testing.T) args {
 				return args{
 					&Issue{
-						SourceType: IssueSourceTypeOIDC,
-						InputOIDC:  nil,
+						SourceType: IssueSourceTypeMFA,
+						InputMFA:   &IssueMFA{},
+						TokenType:  IssueTokenTypeRefresh,
 					},
 				}
 			},
@@ -880,112 +1141,1306 @@ func TestValidateIssue(t *testing.T) {
 			nil,
 		},
 		{
-			"test oidc present",
+			"test gcp missing",
 			func(*testing.T) args {
 				return args{
 					&Issue{
-						SourceType: IssueSourceTypeOIDC,
-						InputOIDC:  &IssueOIDC{},
+						SourceType: IssueSourceTypeGCP,
+						InputGCP:   nil,
 					},
 				}
 			},
-			false,
+			true,
 			nil,
 		},
 		{
-			"test http missing",
+			"test gcp present",
 			func(*testing.T) args {
 				return args{
 					&Issue{
-						SourceType: IssueSourceTypeHTTP,
-						InputHTTP:  nil,
+						SourceType: IssueSourceTypeGCP,
+						InputGCP:   &IssueGCP{},
 					},
 				}
 			},
-			true,
+			false,
 			nil,
 		},
 		{
-			"test http present",
+			"test azure missing",
 			func(*testing.T) args {
 				return args{
 					&Issue{
-						SourceType: IssueSourceTypeHTTP,
-						InputHTTP:  &IssueHTTP{},
+						SourceType: IssueSourceTypeAzure,
+						InputAzure: nil,
 					},
 				}
 			},
-			false,
+			true,
 			nil,
 		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			tArgs := tt.args(t)
-
-			err := ValidateIssue(tArgs.iss)
-
-			if (err != nil) != tt.wantErr {
-				t.Fatalf("ValidateIssue error = %v, wantErr: %t", err, tt.wantErr)
-			}
-
-			if tt.inspectErr != nil {
-				tt.inspectErr(err, t)
-			}
-		})
-	}
-}
-
-func TestValidateDuration(t *testing.T) {
-	type args struct {
-		attribute string
-		duration  string
-	}
-	tests := []struct {
-		name string
-		args func(t *testing.T) args
-
-		wantErr    bool
-		inspectErr func(err error, t *testing.T) //use for more precise error evaluation after test
-	}{
 		{
-			"valid",
+			"test azure present",
 			func(*testing.T) args {
 				return args{
-					"attr",
-					"10s",
+					&Issue{
+						SourceType: IssueSourceTypeAzure,
+						InputAzure: &IssueAzure{},
+					},
 				}
 			},
 			false,
 			nil,
 		},
 		{
-			"invalid",
+			"test kubernetes missing",
 			func(*testing.T) args {
 				return args{
-					"attr",
-					"dog",
+					&Issue{
+						SourceType:      IssueSourceTypeKubernetes,
+						InputKubernetes: nil,
+					},
 				}
 			},
 			true,
 			nil,
 		},
 		{
-			"empty",
+			"test kubernetes present",
 			func(*testing.T) args {
 				return args{
-					"attr",
-					"",
+					&Issue{
+						SourceType:      IssueSourceTypeKubernetes,
+						InputKubernetes: &IssueKubernetes{},
+					},
 				}
 			},
 			false,
 			nil,
 		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
+		{
+			"test jwt missing",
+			func(*testing.T) args {
+				return args{
+					&Issue{
+						SourceType: IssueSourceTypeJWT,
+						InputJWT:   nil,
+					},
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"test jwt present",
+			func(*testing.T) args {
+				return args{
+					&Issue{
+						SourceType: IssueSourceTypeJWT,
+						InputJWT:   &IssueJWT{},
+					},
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"test spiffe missing",
+			func(*testing.T) args {
+				return args{
+					&Issue{
+						SourceType:  IssueSourceTypeSPIFFE,
+						InputSPIFFE: nil,
+					},
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"test spiffe present",
+			func(*testing.T) args {
+				return args{
+					&Issue{
+						SourceType:  IssueSourceTypeSPIFFE,
+						InputSPIFFE: &IssueSPIFFE{},
+					},
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"test saml missing",
+			func(*testing.T) args {
+				return args{
+					&Issue{
+						SourceType: IssueSourceTypeSAML,
+						InputSAML:  nil,
+					},
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"test saml present",
+			func(*testing.T) args {
+				return args{
+					&Issue{
+						SourceType: IssueSourceTypeSAML,
+						InputSAML:  &IssueSAML{},
+					},
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"test oidc missing",
+			func(*testing.T) args {
+				return args{
+					&Issue{
+						SourceType: IssueSourceTypeOIDC,
+						InputOIDC:  nil,
+					},
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"test oidc present",
+			func(*testing.T) args {
+				return args{
+					&Issue{
+						SourceType: IssueSourceTypeOIDC,
+						InputOIDC:  &IssueOIDC{},
+					},
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"test http missing",
+			func(*testing.T) args {
+				return args{
+					&Issue{
+						SourceType: IssueSourceTypeHTTP,
+						InputHTTP:  nil,
+					},
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"test http present",
+			func(*testing.T) args {
+				return args{
+					&Issue{
+						SourceType: IssueSourceTypeHTTP,
+						InputHTTP:  &IssueHTTP{},
+					},
+				}
+			},
+			false,
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tArgs := tt.args(t)
+
+			err := ValidateIssue(tArgs.iss)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateIssue error = %v, wantErr: %t", err, tt.wantErr)
+			}
+
+			if tt.inspectErr != nil {
+				tt.inspectErr(err, t)
+			}
+		})
+	}
+}
+
+func TestValidateRevocation(t *testing.T) {
+	type args struct {
+		rev *Revocation
+	}
+	tests := []struct {
+		name string
+		args func(t *testing.T) args
+
+		wantErr    bool
+		inspectErr func(err error, t *testing.T) //use for more precise error evaluation after test
+	}{
+		{
+			"empty",
+			func(*testing.T) args {
+				return args{
+					&Revocation{},
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"token id",
+			func(*testing.T) args {
+				return args{
+					&Revocation{
+						TokenID: "jti",
+					},
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"token id with expiration",
+			func(*testing.T) args {
+				return args{
+					&Revocation{
+						TokenID:    "jti",
+						Expiration: time.Now(),
+					},
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"token with expiration",
+			func(*testing.T) args {
+				return args{
+					&Revocation{
+						Token:      "a.b.c",
+						Expiration: time.Now(),
+					},
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"token and subject",
+			func(*testing.T) args {
+				return args{
+					&Revocation{
+						Token:   "a.b.c",
+						Subject: [][]string{{"@source:type=mtls", "commonname=john"}},
+					},
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"subject",
+			func(*testing.T) args {
+				return args{
+					&Revocation{
+						Subject: [][]string{{"@source:type=mtls", "commonname=john"}},
+					},
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"subject with expiration",
+			func(*testing.T) args {
+				return args{
+					&Revocation{
+						Subject:    [][]string{{"@source:type=mtls", "commonname=john"}},
+						Expiration: time.Now(),
+					},
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"source",
+			func(*testing.T) args {
+				return args{
+					&Revocation{
+						SourceType: "mtls",
+						SourceName: "mysource",
+					},
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"source without name",
+			func(*testing.T) args {
+				return args{
+					&Revocation{
+						SourceType: "mtls",
+					},
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"source without type",
+			func(*testing.T) args {
+				return args{
+					&Revocation{
+						SourceName:      "mysource",
+						SourceNamespace: "/a",
+					},
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"token family",
+			func(*testing.T) args {
+				return args{
+					&Revocation{
+						TokenFamily: "family",
+					},
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"token family with expiration",
+			func(*testing.T) args {
+				return args{
+					&Revocation{
+						TokenFamily: "family",
+						Expiration:  time.Now(),
+					},
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"token id and token family",
+			func(*testing.T) args {
+				return args{
+					&Revocation{
+						TokenID:     "jti",
+						TokenFamily: "family",
+					},
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"token id and subject",
+			func(*testing.T) args {
+				return args{
+					&Revocation{
+						TokenID: "jti",
+						Subject: [][]string{{"@source:type=mtls"}},
+					},
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"subject and source",
+			func(*testing.T) args {
+				return args{
+					&Revocation{
+						Subject:    [][]string{{"@source:type=mtls"}},
+						SourceType: "mtls",
+						SourceName: "mysource",
+					},
+				}
+			},
+			true,
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tArgs := tt.args(t)
+
+			err := ValidateRevocation(tArgs.rev)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateRevocation error = %v, wantErr: %t", err, tt.wantErr)
+			}
+
+			if tt.inspectErr != nil {
+				tt.inspectErr(err, t)
+			}
+		})
+	}
+}
+
+func TestValidateAWSSource(t *testing.T) {
+	type args struct {
+		src *AWSSource
+	}
+	tests := []struct {
+		name string
+		args func(t *testing.T) args
+
+		wantErr    bool
+		inspectErr func(err error, t *testing.T) //use for more precise error evaluation after test
+	}{
+		{
+			"empty",
+			func(*testing.T) args {
+				return args{
+					&AWSSource{},
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"accounts",
+			func(*testing.T) args {
+				return args{
+					&AWSSource{
+						AllowedAccounts: []string{"123456789012"},
+					},
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"roles",
+			func(*testing.T) args {
+				return args{
+					&AWSSource{
+						AllowedRoles: []string{"arn:aws:iam::*:role/admin"},
+					},
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"invalid account",
+			func(*testing.T) args {
+				return args{
+					&AWSSource{
+						AllowedAccounts: []string{"1234"},
+					},
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"invalid role",
+			func(*testing.T) args {
+				return args{
+					&AWSSource{
+						AllowedRoles: []string{"admin"},
+					},
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"valid endpoint",
+			func(*testing.T) args {
+				return args{
+					&AWSSource{
+						AllowedAccounts: []string{"123456789012"},
+						Endpoint:        "https://sts.us-west-2.amazonaws.com",
+					},
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"invalid endpoint",
+			func(*testing.T) args {
+				return args{
+					&AWSSource{
+						AllowedAccounts: []string{"123456789012"},
+						Endpoint:        "sts.us-west-2.amazonaws.com",
+					},
+				}
+			},
+			true,
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tArgs := tt.args(t)
+
+			err := ValidateAWSSource(tArgs.src)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateAWSSource error = %v, wantErr: %t", err, tt.wantErr)
+			}
+
+			if tt.inspectErr != nil {
+				tt.inspectErr(err, t)
+			}
+		})
+	}
+}
+
+func TestValidateAzureSource(t *testing.T) {
+	type args struct {
+		src *AzureSource
+	}
+	tests := []struct {
+		name string
+		args func(t *testing.T) args
+
+		wantErr    bool
+		inspectErr func(err error, t *testing.T) //use for more precise error evaluation after test
+	}{
+		{
+			"empty",
+			func(*testing.T) args {
+				return args{
+					&AzureSource{},
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"tenants",
+			func(*testing.T) args {
+				return args{
+					&AzureSource{
+						AllowedTenants: []string{"tenant"},
+					},
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"subscriptions",
+			func(*testing.T) args {
+				return args{
+					&AzureSource{
+						AllowedSubscriptions: []string{"sub"},
+					},
+				}
+			},
+			false,
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tArgs := tt.args(t)
+
+			err := ValidateAzureSource(tArgs.src)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateAzureSource error = %v, wantErr: %t", err, tt.wantErr)
+			}
+
+			if tt.inspectErr != nil {
+				tt.inspectErr(err, t)
+			}
+		})
+	}
+}
+
+func TestValidateGCPSource(t *testing.T) {
+	type args struct {
+		src *GCPSource
+	}
+	tests := []struct {
+		name string
+		args func(t *testing.T) args
+
+		wantErr    bool
+		inspectErr func(err error, t *testing.T) //use for more precise error evaluation after test
+	}{
+		{
+			"empty",
+			func(*testing.T) args {
+				return args{
+					&GCPSource{},
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"project ids",
+			func(*testing.T) args {
+				return args{
+					&GCPSource{
+						AllowedProjectIDs: []string{"my-project"},
+					},
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"project numbers",
+			func(*testing.T) args {
+				return args{
+					&GCPSource{
+						AllowedProjectNumbers: []int{123456789012},
+					},
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"invalid project number",
+			func(*testing.T) args {
+				return args{
+					&GCPSource{
+						AllowedProjectNumbers: []int{-1},
+					},
+				}
+			},
+			true,
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tArgs := tt.args(t)
+
+			err := ValidateGCPSource(tArgs.src)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateGCPSource error = %v, wantErr: %t", err, tt.wantErr)
+			}
+
+			if tt.inspectErr != nil {
+				tt.inspectErr(err, t)
+			}
+		})
+	}
+}
+
+func TestValidateKubernetesSource(t *testing.T) {
+	type args struct {
+		src *KubernetesSource
+	}
+	tests := []struct {
+		name string
+		args func(t *testing.T) args
+
+		wantErr    bool
+		inspectErr func(err error, t *testing.T) //use for more precise error evaluation after test
+	}{
+		{
+			"jwks valid",
+			func(*testing.T) args {
+				return args{
+					&KubernetesSource{
+						Mode:    KubernetesSourceModeJWKS,
+						Issuer:  "https://kubernetes.default.svc.cluster.local",
+						KeysURL: "https://kubernetes.default.svc/openid/v1/jwks",
+					},
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"jwks missing issuer",
+			func(*testing.T) args {
+				return args{
+					&KubernetesSource{
+						Mode:    KubernetesSourceModeJWKS,
+						KeysURL: "https://kubernetes.default.svc/openid/v1/jwks",
+					},
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"jwks missing keys url",
+			func(*testing.T) args {
+				return args{
+					&KubernetesSource{
+						Mode:   KubernetesSourceModeJWKS,
+						Issuer: "https://kubernetes.default.svc.cluster.local",
+					},
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"jwks invalid keys url",
+			func(*testing.T) args {
+				return args{
+					&KubernetesSource{
+						Mode:    KubernetesSourceModeJWKS,
+						Issuer:  "https://kubernetes.default.svc.cluster.local",
+						KeysURL: "kubernetes.default.svc/openid/v1/jwks",
+					},
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"tokenreview valid",
+			func(*testing.T) args {
+				return args{
+					&KubernetesSource{
+						Mode:          KubernetesSourceModeTokenReview,
+						Endpoint:      "https://kubernetes.default.svc",
+						ReviewerToken: "token",
+					},
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"tokenreview missing endpoint",
+			func(*testing.T) args {
+				return args{
+					&KubernetesSource{
+						Mode:          KubernetesSourceModeTokenReview,
+						ReviewerToken: "token",
+					},
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"tokenreview invalid endpoint",
+			func(*testing.T) args {
+				return args{
+					&KubernetesSource{
+						Mode:          KubernetesSourceModeTokenReview,
+						Endpoint:      "kubernetes.default.svc",
+						ReviewerToken: "token",
+					},
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"tokenreview missing reviewer token",
+			func(*testing.T) args {
+				return args{
+					&KubernetesSource{
+						Mode:     KubernetesSourceModeTokenReview,
+						Endpoint: "https://kubernetes.default.svc",
+					},
+				}
+			},
+			true,
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tArgs := tt.args(t)
+
+			err := ValidateKubernetesSource(tArgs.src)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateKubernetesSource error = %v, wantErr: %t", err, tt.wantErr)
+			}
+
+			if tt.inspectErr != nil {
+				tt.inspectErr(err, t)
+			}
+		})
+	}
+}
+
+func TestValidateJWTSource(t *testing.T) {
+	type args struct {
+		src *JWTSource
+	}
+	tests := []struct {
+		name string
+		args func(t *testing.T) args
+
+		wantErr    bool
+		inspectErr func(err error, t *testing.T) //use for more precise error evaluation after test
+	}{
+		{
+			"valid",
+			func(*testing.T) args {
+				return args{
+					&JWTSource{
+						Issuer:         "https://token.actions.githubusercontent.com",
+						Audience:       "a3s",
+						RequiredClaims: []string{"repository=org/repo", "ref=refs/heads/main"},
+					},
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"valid keys url",
+			func(*testing.T) args {
+				return args{
+					&JWTSource{
+						KeysURL: "https://token.actions.githubusercontent.com/.well-known/jwks",
+					},
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"invalid keys url",
+			func(*testing.T) args {
+				return args{
+					&JWTSource{
+						KeysURL: "token.actions.githubusercontent.com/.well-known/jwks",
+					},
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"valid jwks",
+			func(*testing.T) args {
+				return args{
+					&JWTSource{
+						JWKS: `{"keys":[{"kty":"RSA","n":"AQAB","e":"AQAB"}]}`,
+					},
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"invalid jwks",
+			func(*testing.T) args {
+				return args{
+					&JWTSource{
+						JWKS: `not json`,
+					},
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"empty jwks",
+			func(*testing.T) args {
+				return args{
+					&JWTSource{
+						JWKS: `{"keys":[]}`,
+					},
+				}
+			},
+			true,
+			func(err error, t *testing.T) {
+				wanted := "error 422 (a3s): Validation Error: The JWKS must contain at least one key"
+				if err.Error() != wanted {
+					t.Logf("wanted %s but got %s", wanted, err)
+					t.Fail()
+				}
+			},
+		},
+		{
+			"required claim without value",
+			func(*testing.T) args {
+				return args{
+					&JWTSource{
+						RequiredClaims: []string{"repository"},
+					},
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"required claim without key",
+			func(*testing.T) args {
+				return args{
+					&JWTSource{
+						RequiredClaims: []string{"=org/repo"},
+					},
+				}
+			},
+			true,
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tArgs := tt.args(t)
+
+			err := ValidateJWTSource(tArgs.src)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateJWTSource error = %v, wantErr: %t", err, tt.wantErr)
+			}
+
+			if tt.inspectErr != nil {
+				tt.inspectErr(err, t)
+			}
+		})
+	}
+}
+
+func TestValidateOIDCSource(t *testing.T) {
+	type args struct {
+		src *OIDCSource
+	}
+	tests := []struct {
+		name string
+		args func(t *testing.T) args
+
+		wantErr    bool
+		inspectErr func(err error, t *testing.T) //use for more precise error evaluation after test
+	}{
+		{
+			"valid",
+			func(*testing.T) args {
+				return args{
+					&OIDCSource{
+						ClaimMapping: map[string]string{"realm_access.roles": "role"},
+					},
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"valid without mapping",
+			func(*testing.T) args {
+				return args{
+					&OIDCSource{},
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"claim mapped twice with different case",
+			func(*testing.T) args {
+				return args{
+					&OIDCSource{
+						ClaimMapping: map[string]string{"groups": "group", "Groups": "role"},
+					},
+				}
+			},
+			true,
+			func(err error, t *testing.T) {
+				wanted := "error 422 (a3s): Validation Error: Claim 'groups' is mapped more than once"
+				if err.Error() != wanted {
+					t.Logf("wanted %s but got %s", wanted, err)
+					t.Fail()
+				}
+			},
+		},
+		{
+			"invalid claim mapping",
+			func(*testing.T) args {
+				return args{
+					&OIDCSource{
+						ClaimMapping: map[string]string{"groups": "gr=oup"},
+					},
+				}
+			},
+			true,
+			func(err error, t *testing.T) {
+				wanted := "error 422 (a3s): Validation Error: Invalid claim key 'gr=oup' for claim 'groups'"
+				if err.Error() != wanted {
+					t.Logf("wanted %s but got %s", wanted, err)
+					t.Fail()
+				}
+			},
+		},
+		{
+			"reserved claim mapping key",
+			func(*testing.T) args {
+				return args{
+					&OIDCSource{
+						ClaimMapping: map[string]string{"groups": "@source:type"},
+					},
+				}
+			},
+			true,
+			func(err error, t *testing.T) {
+				wanted := "error 422 (a3s): Validation Error: Invalid claim key '@source:type' for claim 'groups'"
+				if err.Error() != wanted {
+					t.Logf("wanted %s but got %s", wanted, err)
+					t.Fail()
+				}
+			},
+		},
+		{
+			"empty claim mapping key",
+			func(*testing.T) args {
+				return args{
+					&OIDCSource{
+						ClaimMapping: map[string]string{"groups": ""},
+					},
+				}
+			},
+			true,
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tArgs := tt.args(t)
+
+			err := ValidateOIDCSource(tArgs.src)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateOIDCSource error = %v, wantErr: %t", err, tt.wantErr)
+			}
+
+			if tt.inspectErr != nil {
+				tt.inspectErr(err, t)
+			}
+		})
+	}
+}
+
+func TestValidateSAMLSource(t *testing.T) {
+	type args struct {
+		src *SAMLSource
+	}
+	tests := []struct {
+		name string
+		args func(t *testing.T) args
+
+		wantErr    bool
+		inspectErr func(err error, t *testing.T) //use for more precise error evaluation after test
+	}{
+		{
+			"valid",
+			func(*testing.T) args {
+				return args{
+					&SAMLSource{
+						IDPMetadata:      `<EntityDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://idp"></EntityDescriptor>`,
+						AttributeMapping: map[string]string{"urn:oid:0.9.2342.19200300.100.1.3": "email"},
+					},
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"valid entities descriptor",
+			func(*testing.T) args {
+				return args{
+					&SAMLSource{
+						IDPMetadata: `<md:EntitiesDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata"></md:EntitiesDescriptor>`,
+					},
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"invalid metadata",
+			func(*testing.T) args {
+				return args{
+					&SAMLSource{
+						IDPMetadata: `not xml`,
+					},
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"unexpected metadata root",
+			func(*testing.T) args {
+				return args{
+					&SAMLSource{
+						IDPMetadata: `<Something></Something>`,
+					},
+				}
+			},
+			true,
+			func(err error, t *testing.T) {
+				wanted := "error 422 (a3s): Validation Error: The IDP metadata must be an EntityDescriptor or an EntitiesDescriptor"
+				if err.Error() != wanted {
+					t.Logf("wanted %s but got %s", wanted, err)
+					t.Fail()
+				}
+			},
+		},
+		{
+			"invalid attribute mapping",
+			func(*testing.T) args {
+				return args{
+					&SAMLSource{
+						IDPMetadata:      `<EntityDescriptor></EntityDescriptor>`,
+						AttributeMapping: map[string]string{"mail": "e=mail"},
+					},
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"reserved attribute mapping key",
+			func(*testing.T) args {
+				return args{
+					&SAMLSource{
+						IDPMetadata:      `<EntityDescriptor></EntityDescriptor>`,
+						AttributeMapping: map[string]string{"mail": "@source:type"},
+					},
+				}
+			},
+			true,
+			func(err error, t *testing.T) {
+				wanted := "error 422 (a3s): Validation Error: Invalid claim key '@source:type' for attribute 'mail'"
+				if err.Error() != wanted {
+					t.Logf("wanted %s but got %s", wanted, err)
+					t.Fail()
+				}
+			},
+		},
+		{
+			"empty attribute mapping key",
+			func(*testing.T) args {
+				return args{
+					&SAMLSource{
+						IDPMetadata:      `<EntityDescriptor></EntityDescriptor>`,
+						AttributeMapping: map[string]string{"mail": ""},
+					},
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"valid allowed redirect urls",
+			func(*testing.T) args {
+				return args{
+					&SAMLSource{
+						IDPMetadata:         `<EntityDescriptor></EntityDescriptor>`,
+						AllowedRedirectURLs: []string{"https://app.example.com/login", "http://localhost:8080"},
+					},
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"invalid allowed redirect url",
+			func(*testing.T) args {
+				return args{
+					&SAMLSource{
+						IDPMetadata:         `<EntityDescriptor></EntityDescriptor>`,
+						AllowedRedirectURLs: []string{"https://app.example.com/login", "app.example.com"},
+					},
+				}
+			},
+			true,
+			func(err error, t *testing.T) {
+				wanted := "error 422 (a3s): Validation Error: invalid url: missing scheme"
+				if err.Error() != wanted {
+					t.Logf("wanted %s but got %s", wanted, err)
+					t.Fail()
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tArgs := tt.args(t)
+
+			err := ValidateSAMLSource(tArgs.src)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateSAMLSource error = %v, wantErr: %t", err, tt.wantErr)
+			}
+
+			if tt.inspectErr != nil {
+				tt.inspectErr(err, t)
+			}
+		})
+	}
+}
+
+func TestValidateDuration(t *testing.T) {
+	type args struct {
+		attribute string
+		duration  string
+	}
+	tests := []struct {
+		name string
+		args func(t *testing.T) args
+
+		wantErr    bool
+		inspectErr func(err error, t *testing.T) //use for more precise error evaluation after test
+	}{
+		{
+			"valid",
+			func(*testing.T) args {
+				return args{
+					"attr",
+					"10s",
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"invalid",
+			func(*testing.T) args {
+				return args{
+					"attr",
+					"dog",
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"empty",
+			func(*testing.T) args {
+				return args{
+					"attr",
+					"",
+				}
+			},
+			false,
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
 			tArgs := tt.args(t)
 
 			err := ValidateDuration(tArgs.attribute, tArgs.duration)
@@ -1086,3 +2541,146 @@ func TestValidateURL(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateSPIFFESource(t *testing.T) {
+	type args struct {
+		src *SPIFFESource
+	}
+	tests := []struct {
+		name string
+		args func(t *testing.T) args
+
+		wantErr    bool
+		inspectErr func(err error, t *testing.T) //use for more precise error evaluation after test
+	}{
+		{
+			"valid bundle endpoint",
+			func(*testing.T) args {
+				return args{
+					&SPIFFESource{
+						BundleEndpointURL: "https://spire.example.org/bundle",
+					},
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"valid bundle",
+			func(*testing.T) args {
+				return args{
+					&SPIFFESource{
+						Bundle: `{"keys":[{"kty":"EC","use":"jwt-svid","kid":"a","crv":"P-256","x":"AQAB","y":"AQAB"}],"spiffe_refresh_hint":300}`,
+					},
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"no bundle",
+			func(*testing.T) args {
+				return args{
+					&SPIFFESource{},
+				}
+			},
+			true,
+			func(err error, t *testing.T) {
+				wanted := "error 422 (a3s): Validation Error: You must set either bundle or bundleEndpointURL"
+				if err.Error() != wanted {
+					t.Logf("wanted %s but got %s", wanted, err)
+					t.Fail()
+				}
+			},
+		},
+		{
+			"both bundle and bundle endpoint",
+			func(*testing.T) args {
+				return args{
+					&SPIFFESource{
+						Bundle:            `{"keys":[{"kty":"EC"}]}`,
+						BundleEndpointURL: "https://spire.example.org/bundle",
+					},
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"invalid bundle endpoint",
+			func(*testing.T) args {
+				return args{
+					&SPIFFESource{
+						BundleEndpointURL: "spire.example.org/bundle",
+					},
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"bundle endpoint not using https",
+			func(*testing.T) args {
+				return args{
+					&SPIFFESource{
+						BundleEndpointURL: "http://spire.example.org/bundle",
+					},
+				}
+			},
+			true,
+			func(err error, t *testing.T) {
+				wanted := "error 422 (a3s): Validation Error: The bundle endpoint must use https"
+				if err.Error() != wanted {
+					t.Logf("wanted %s but got %s", wanted, err)
+					t.Fail()
+				}
+			},
+		},
+		{
+			"invalid bundle",
+			func(*testing.T) args {
+				return args{
+					&SPIFFESource{
+						Bundle: `not json`,
+					},
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"empty bundle",
+			func(*testing.T) args {
+				return args{
+					&SPIFFESource{
+						Bundle: `{"keys":[]}`,
+					},
+				}
+			},
+			true,
+			func(err error, t *testing.T) {
+				wanted := "error 422 (a3s): Validation Error: The bundle must contain at least one key"
+				if err.Error() != wanted {
+					t.Logf("wanted %s but got %s", wanted, err)
+					t.Fail()
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tArgs := tt.args(t)
+
+			err := ValidateSPIFFESource(tArgs.src)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateSPIFFESource error = %v, wantErr: %t", err, tt.wantErr)
+			}
+
+			if tt.inspectErr != nil {
+				tt.inspectErr(err, t)
+			}
+		})
+	}
+}
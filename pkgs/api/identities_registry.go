@@ -10,36 +10,66 @@ var (
 		"a3ssource":        A3SSourceIdentity,
 		"authorization":    AuthorizationIdentity,
 		"authz":            AuthzIdentity,
+		"awssource":        AWSSourceIdentity,
+		"azuresource":      AzureSourceIdentity,
+		"gcpsource":        GCPSourceIdentity,
 		"httpsource":       HTTPSourceIdentity,
 		"identitymodifier": IdentityModifierIdentity,
 		"import":           ImportIdentity,
 		"issue":            IssueIdentity,
 
+		"jwtsource":               JWTSourceIdentity,
+		"kubernetessource":        KubernetesSourceIdentity,
 		"ldapsource":              LDAPSourceIdentity,
+		"localsource":             LocalSourceIdentity,
+		"localuser":               LocalUserIdentity,
+		"mfacredential":           MFACredentialIdentity,
+		"mfaenrollment":           MFAEnrollmentIdentity,
 		"mtlssource":              MTLSSourceIdentity,
 		"namespace":               NamespaceIdentity,
 		"namespacedeletionrecord": NamespaceDeletionRecordIdentity,
 		"oidcsource":              OIDCSourceIdentity,
+		"passwordchange":          PasswordChangeIdentity,
+		"passwordreset":           PasswordResetIdentity,
 		"permissions":             PermissionsIdentity,
+		"revocation":              RevocationIdentity,
 		"root":                    RootIdentity,
+		"samlsource":              SAMLSourceIdentity,
+		"signingkey":              SigningKeyIdentity,
+		"spiffesource":            SPIFFESourceIdentity,
 	}
 
 	identitycategoriesMap = map[string]elemental.Identity{
 		"a3ssources":       A3SSourceIdentity,
 		"authorizations":   AuthorizationIdentity,
 		"authz":            AuthzIdentity,
+		"awssources":       AWSSourceIdentity,
+		"azuresources":     AzureSourceIdentity,
+		"gcpsources":       GCPSourceIdentity,
 		"httpsources":      HTTPSourceIdentity,
 		"identitymodifier": IdentityModifierIdentity,
 		"import":           ImportIdentity,
 		"issue":            IssueIdentity,
 
+		"jwtsources":               JWTSourceIdentity,
+		"kubernetessources":        KubernetesSourceIdentity,
 		"ldapsources":              LDAPSourceIdentity,
+		"localsources":             LocalSourceIdentity,
+		"localusers":               LocalUserIdentity,
+		"mfacredentials":           MFACredentialIdentity,
+		"mfaenrollments":           MFAEnrollmentIdentity,
 		"mtlssources":              MTLSSourceIdentity,
 		"namespaces":               NamespaceIdentity,
 		"namespacedeletionrecords": NamespaceDeletionRecordIdentity,
 		"oidcsources":              OIDCSourceIdentity,
+		"passwordchanges":          PasswordChangeIdentity,
+		"passwordresets":           PasswordResetIdentity,
 		"permissions":              PermissionsIdentity,
+		"revocations":              RevocationIdentity,
 		"root":                     RootIdentity,
+		"samlsources":              SAMLSourceIdentity,
+		"signingkeys":              SigningKeyIdentity,
+		"spiffesources":            SPIFFESourceIdentity,
 	}
 
 	aliasesMap = map[string]elemental.Identity{}
@@ -62,6 +92,27 @@ var (
 			{"namespace", "trustedIssuers"},
 		},
 		"authz": nil,
+		"awssource": {
+			{":shard", ":unique", "zone", "zHash"},
+			{"namespace"},
+			{"namespace", "ID"},
+			{"namespace", "importLabel"},
+			{"namespace", "name"},
+		},
+		"azuresource": {
+			{":shard", ":unique", "zone", "zHash"},
+			{"namespace"},
+			{"namespace", "ID"},
+			{"namespace", "importLabel"},
+			{"namespace", "name"},
+		},
+		"gcpsource": {
+			{":shard", ":unique", "zone", "zHash"},
+			{"namespace"},
+			{"namespace", "ID"},
+			{"namespace", "importLabel"},
+			{"namespace", "name"},
+		},
 		"httpsource": {
 			{":shard", ":unique", "zone", "zHash"},
 			{"namespace"},
@@ -72,6 +123,20 @@ var (
 		"identitymodifier": nil,
 		"import":           nil,
 		"issue":            nil,
+		"jwtsource": {
+			{":shard", ":unique", "zone", "zHash"},
+			{"namespace"},
+			{"namespace", "ID"},
+			{"namespace", "importLabel"},
+			{"namespace", "name"},
+		},
+		"kubernetessource": {
+			{":shard", ":unique", "zone", "zHash"},
+			{"namespace"},
+			{"namespace", "ID"},
+			{"namespace", "importLabel"},
+			{"namespace", "name"},
+		},
 		"ldapsource": {
 			{":shard", ":unique", "zone", "zHash"},
 			{"namespace"},
@@ -79,6 +144,27 @@ var (
 			{"namespace", "importLabel"},
 			{"namespace", "name"},
 		},
+		"localsource": {
+			{":shard", ":unique", "zone", "zHash"},
+			{"namespace"},
+			{"namespace", "ID"},
+			{"namespace", "importLabel"},
+			{"namespace", "name"},
+		},
+		"localuser": {
+			{":shard", ":unique", "zone", "zHash"},
+			{"namespace"},
+			{"namespace", "ID"},
+			{"namespace", "sourceName", "username"},
+		},
+		"mfacredential": {
+			{":shard", ":unique", "zone", "zHash"},
+			{"namespace"},
+			{"namespace", "ID"},
+			{"namespace", "subject"},
+			{"namespace", "webAuthnCredentialID"},
+		},
+		"mfaenrollment": nil,
 		"mtlssource": {
 			{":shard", ":unique", "zone", "zHash"},
 			{"fingerprints"},
@@ -107,8 +193,32 @@ var (
 			{"namespace", "importLabel"},
 			{"namespace", "name"},
 		},
-		"permissions": nil,
-		"root":        nil,
+		"passwordchange": nil,
+		"passwordreset":  nil,
+		"permissions":    nil,
+		"revocation": {
+			{":shard", ":unique", "zone", "zHash"},
+			{"namespace"},
+			{"namespace", "ID"},
+			{"namespace", "tokenFamily"},
+			{"namespace", "tokenID"},
+		},
+		"root": nil,
+		"samlsource": {
+			{":shard", ":unique", "zone", "zHash"},
+			{"namespace"},
+			{"namespace", "ID"},
+			{"namespace", "importLabel"},
+			{"namespace", "name"},
+		},
+		"signingkey": nil,
+		"spiffesource": {
+			{":shard", ":unique", "zone", "zHash"},
+			{"namespace"},
+			{"namespace", "ID"},
+			{"namespace", "importLabel"},
+			{"namespace", "name"},
+		},
 	}
 )
 
@@ -155,6 +265,12 @@ func (f modelManager) Identifiable(identity elemental.Identity) elemental.Identi
 		return NewAuthorization()
 	case AuthzIdentity:
 		return NewAuthz()
+	case AWSSourceIdentity:
+		return NewAWSSource()
+	case AzureSourceIdentity:
+		return NewAzureSource()
+	case GCPSourceIdentity:
+		return NewGCPSource()
 	case HTTPSourceIdentity:
 		return NewHTTPSource()
 	case IdentityModifierIdentity:
@@ -163,8 +279,20 @@ func (f modelManager) Identifiable(identity elemental.Identity) elemental.Identi
 		return NewImport()
 	case IssueIdentity:
 		return NewIssue()
+	case JWTSourceIdentity:
+		return NewJWTSource()
+	case KubernetesSourceIdentity:
+		return NewKubernetesSource()
 	case LDAPSourceIdentity:
 		return NewLDAPSource()
+	case LocalSourceIdentity:
+		return NewLocalSource()
+	case LocalUserIdentity:
+		return NewLocalUser()
+	case MFACredentialIdentity:
+		return NewMFACredential()
+	case MFAEnrollmentIdentity:
+		return NewMFAEnrollment()
 	case MTLSSourceIdentity:
 		return NewMTLSSource()
 	case NamespaceIdentity:
@@ -173,10 +301,22 @@ func (f modelManager) Identifiable(identity elemental.Identity) elemental.Identi
 		return NewNamespaceDeletionRecord()
 	case OIDCSourceIdentity:
 		return NewOIDCSource()
+	case PasswordChangeIdentity:
+		return NewPasswordChange()
+	case PasswordResetIdentity:
+		return NewPasswordReset()
 	case PermissionsIdentity:
 		return NewPermissions()
+	case RevocationIdentity:
+		return NewRevocation()
 	case RootIdentity:
 		return NewRoot()
+	case SAMLSourceIdentity:
+		return NewSAMLSource()
+	case SigningKeyIdentity:
+		return NewSigningKey()
+	case SPIFFESourceIdentity:
+		return NewSPIFFESource()
 	default:
 		return nil
 	}
@@ -192,6 +332,12 @@ func (f modelManager) SparseIdentifiable(identity elemental.Identity) elemental.
 		return NewSparseAuthorization()
 	case AuthzIdentity:
 		return NewSparseAuthz()
+	case AWSSourceIdentity:
+		return NewSparseAWSSource()
+	case AzureSourceIdentity:
+		return NewSparseAzureSource()
+	case GCPSourceIdentity:
+		return NewSparseGCPSource()
 	case HTTPSourceIdentity:
 		return NewSparseHTTPSource()
 	case IdentityModifierIdentity:
@@ -200,8 +346,20 @@ func (f modelManager) SparseIdentifiable(identity elemental.Identity) elemental.
 		return NewSparseImport()
 	case IssueIdentity:
 		return NewSparseIssue()
+	case JWTSourceIdentity:
+		return NewSparseJWTSource()
+	case KubernetesSourceIdentity:
+		return NewSparseKubernetesSource()
 	case LDAPSourceIdentity:
 		return NewSparseLDAPSource()
+	case LocalSourceIdentity:
+		return NewSparseLocalSource()
+	case LocalUserIdentity:
+		return NewSparseLocalUser()
+	case MFACredentialIdentity:
+		return NewSparseMFACredential()
+	case MFAEnrollmentIdentity:
+		return NewSparseMFAEnrollment()
 	case MTLSSourceIdentity:
 		return NewSparseMTLSSource()
 	case NamespaceIdentity:
@@ -210,8 +368,20 @@ func (f modelManager) SparseIdentifiable(identity elemental.Identity) elemental.
 		return NewSparseNamespaceDeletionRecord()
 	case OIDCSourceIdentity:
 		return NewSparseOIDCSource()
+	case PasswordChangeIdentity:
+		return NewSparsePasswordChange()
+	case PasswordResetIdentity:
+		return NewSparsePasswordReset()
 	case PermissionsIdentity:
 		return NewSparsePermissions()
+	case RevocationIdentity:
+		return NewSparseRevocation()
+	case SAMLSourceIdentity:
+		return NewSparseSAMLSource()
+	case SigningKeyIdentity:
+		return NewSparseSigningKey()
+	case SPIFFESourceIdentity:
+		return NewSparseSPIFFESource()
 	default:
 		return nil
 	}
@@ -237,6 +407,12 @@ func (f modelManager) Identifiables(identity elemental.Identity) elemental.Ident
 		return &AuthorizationsList{}
 	case AuthzIdentity:
 		return &AuthzsList{}
+	case AWSSourceIdentity:
+		return &AWSSourcesList{}
+	case AzureSourceIdentity:
+		return &AzureSourcesList{}
+	case GCPSourceIdentity:
+		return &GCPSourcesList{}
 	case HTTPSourceIdentity:
 		return &HTTPSourcesList{}
 	case IdentityModifierIdentity:
@@ -245,8 +421,20 @@ func (f modelManager) Identifiables(identity elemental.Identity) elemental.Ident
 		return &ImportsList{}
 	case IssueIdentity:
 		return &IssuesList{}
+	case JWTSourceIdentity:
+		return &JWTSourcesList{}
+	case KubernetesSourceIdentity:
+		return &KubernetesSourcesList{}
 	case LDAPSourceIdentity:
 		return &LDAPSourcesList{}
+	case LocalSourceIdentity:
+		return &LocalSourcesList{}
+	case LocalUserIdentity:
+		return &LocalUsersList{}
+	case MFACredentialIdentity:
+		return &MFACredentialsList{}
+	case MFAEnrollmentIdentity:
+		return &MFAEnrollmentsList{}
 	case MTLSSourceIdentity:
 		return &MTLSSourcesList{}
 	case NamespaceIdentity:
@@ -255,8 +443,20 @@ func (f modelManager) Identifiables(identity elemental.Identity) elemental.Ident
 		return &NamespaceDeletionRecordsList{}
 	case OIDCSourceIdentity:
 		return &OIDCSourcesList{}
+	case PasswordChangeIdentity:
+		return &PasswordChangesList{}
+	case PasswordResetIdentity:
+		return &PasswordResetsList{}
 	case PermissionsIdentity:
 		return &PermissionsList{}
+	case RevocationIdentity:
+		return &RevocationsList{}
+	case SAMLSourceIdentity:
+		return &SAMLSourcesList{}
+	case SigningKeyIdentity:
+		return &SigningKeysList{}
+	case SPIFFESourceIdentity:
+		return &SPIFFESourcesList{}
 	default:
 		return nil
 	}
@@ -272,6 +472,12 @@ func (f modelManager) SparseIdentifiables(identity elemental.Identity) elemental
 		return &SparseAuthorizationsList{}
 	case AuthzIdentity:
 		return &SparseAuthzsList{}
+	case AWSSourceIdentity:
+		return &SparseAWSSourcesList{}
+	case AzureSourceIdentity:
+		return &SparseAzureSourcesList{}
+	case GCPSourceIdentity:
+		return &SparseGCPSourcesList{}
 	case HTTPSourceIdentity:
 		return &SparseHTTPSourcesList{}
 	case IdentityModifierIdentity:
@@ -280,8 +486,20 @@ func (f modelManager) SparseIdentifiables(identity elemental.Identity) elemental
 		return &SparseImportsList{}
 	case IssueIdentity:
 		return &SparseIssuesList{}
+	case JWTSourceIdentity:
+		return &SparseJWTSourcesList{}
+	case KubernetesSourceIdentity:
+		return &SparseKubernetesSourcesList{}
 	case LDAPSourceIdentity:
 		return &SparseLDAPSourcesList{}
+	case LocalSourceIdentity:
+		return &SparseLocalSourcesList{}
+	case LocalUserIdentity:
+		return &SparseLocalUsersList{}
+	case MFACredentialIdentity:
+		return &SparseMFACredentialsList{}
+	case MFAEnrollmentIdentity:
+		return &SparseMFAEnrollmentsList{}
 	case MTLSSourceIdentity:
 		return &SparseMTLSSourcesList{}
 	case NamespaceIdentity:
@@ -290,8 +508,20 @@ func (f modelManager) SparseIdentifiables(identity elemental.Identity) elemental
 		return &SparseNamespaceDeletionRecordsList{}
 	case OIDCSourceIdentity:
 		return &SparseOIDCSourcesList{}
+	case PasswordChangeIdentity:
+		return &SparsePasswordChangesList{}
+	case PasswordResetIdentity:
+		return &SparsePasswordResetsList{}
 	case PermissionsIdentity:
 		return &SparsePermissionsList{}
+	case RevocationIdentity:
+		return &SparseRevocationsList{}
+	case SAMLSourceIdentity:
+		return &SparseSAMLSourcesList{}
+	case SigningKeyIdentity:
+		return &SparseSigningKeysList{}
+	case SPIFFESourceIdentity:
+		return &SparseSPIFFESourcesList{}
 	default:
 		return nil
 	}
@@ -323,17 +553,32 @@ func AllIdentities() []elemental.Identity {
 		A3SSourceIdentity,
 		AuthorizationIdentity,
 		AuthzIdentity,
+		AWSSourceIdentity,
+		AzureSourceIdentity,
+		GCPSourceIdentity,
 		HTTPSourceIdentity,
 		IdentityModifierIdentity,
 		ImportIdentity,
 		IssueIdentity,
+		JWTSourceIdentity,
+		KubernetesSourceIdentity,
 		LDAPSourceIdentity,
+		LocalSourceIdentity,
+		LocalUserIdentity,
+		MFACredentialIdentity,
+		MFAEnrollmentIdentity,
 		MTLSSourceIdentity,
 		NamespaceIdentity,
 		NamespaceDeletionRecordIdentity,
 		OIDCSourceIdentity,
+		PasswordChangeIdentity,
+		PasswordResetIdentity,
 		PermissionsIdentity,
+		RevocationIdentity,
 		RootIdentity,
+		SAMLSourceIdentity,
+		SigningKeyIdentity,
+		SPIFFESourceIdentity,
 	}
 }
 
@@ -347,6 +592,12 @@ func AliasesForIdentity(identity elemental.Identity) []string {
 		return []string{}
 	case AuthzIdentity:
 		return []string{}
+	case AWSSourceIdentity:
+		return []string{}
+	case AzureSourceIdentity:
+		return []string{}
+	case GCPSourceIdentity:
+		return []string{}
 	case HTTPSourceIdentity:
 		return []string{}
 	case IdentityModifierIdentity:
@@ -355,8 +606,20 @@ func AliasesForIdentity(identity elemental.Identity) []string {
 		return []string{}
 	case IssueIdentity:
 		return []string{}
+	case JWTSourceIdentity:
+		return []string{}
+	case KubernetesSourceIdentity:
+		return []string{}
 	case LDAPSourceIdentity:
 		return []string{}
+	case LocalSourceIdentity:
+		return []string{}
+	case LocalUserIdentity:
+		return []string{}
+	case MFACredentialIdentity:
+		return []string{}
+	case MFAEnrollmentIdentity:
+		return []string{}
 	case MTLSSourceIdentity:
 		return []string{}
 	case NamespaceIdentity:
@@ -365,10 +628,22 @@ func AliasesForIdentity(identity elemental.Identity) []string {
 		return []string{}
 	case OIDCSourceIdentity:
 		return []string{}
+	case PasswordChangeIdentity:
+		return []string{}
+	case PasswordResetIdentity:
+		return []string{}
 	case PermissionsIdentity:
 		return []string{}
+	case RevocationIdentity:
+		return []string{}
 	case RootIdentity:
 		return []string{}
+	case SAMLSourceIdentity:
+		return []string{}
+	case SigningKeyIdentity:
+		return []string{}
+	case SPIFFESourceIdentity:
+		return []string{}
 	}
 
 	return nil
@@ -86,18 +86,42 @@ type Import struct {
 	// A3S sources to import.
 	A3SSources A3SSourcesList `json:"A3SSources,omitempty" msgpack:"A3SSources,omitempty" bson:"-" mapstructure:"A3SSources,omitempty"`
 
+	// AWS sources to import.
+	AWSSources AWSSourcesList `json:"AWSSources,omitempty" msgpack:"AWSSources,omitempty" bson:"-" mapstructure:"AWSSources,omitempty"`
+
+	// Azure sources to import.
+	AzureSources AzureSourcesList `json:"AzureSources,omitempty" msgpack:"AzureSources,omitempty" bson:"-" mapstructure:"AzureSources,omitempty"`
+
+	// GCP sources to import.
+	GCPSources GCPSourcesList `json:"GCPSources,omitempty" msgpack:"GCPSources,omitempty" bson:"-" mapstructure:"GCPSources,omitempty"`
+
 	// HTTP sources to import.
 	HTTPSources HTTPSourcesList `json:"HTTPSources,omitempty" msgpack:"HTTPSources,omitempty" bson:"-" mapstructure:"HTTPSources,omitempty"`
 
+	// JWT sources to import.
+	JWTSources JWTSourcesList `json:"JWTSources,omitempty" msgpack:"JWTSources,omitempty" bson:"-" mapstructure:"JWTSources,omitempty"`
+
+	// Kubernetes sources to import.
+	KubernetesSources KubernetesSourcesList `json:"KubernetesSources,omitempty" msgpack:"KubernetesSources,omitempty" bson:"-" mapstructure:"KubernetesSources,omitempty"`
+
 	// LDAP sources to import.
 	LDAPSources LDAPSourcesList `json:"LDAPSources,omitempty" msgpack:"LDAPSources,omitempty" bson:"-" mapstructure:"LDAPSources,omitempty"`
 
+	// Local sources to import.
+	LocalSources LocalSourcesList `json:"LocalSources,omitempty" msgpack:"LocalSources,omitempty" bson:"-" mapstructure:"LocalSources,omitempty"`
+
 	// MTLS sources to import.
 	MTLSSources MTLSSourcesList `json:"MTLSSources,omitempty" msgpack:"MTLSSources,omitempty" bson:"-" mapstructure:"MTLSSources,omitempty"`
 
 	// OIDC sources to import.
 	OIDCSources OIDCSourcesList `json:"OIDCSources,omitempty" msgpack:"OIDCSources,omitempty" bson:"-" mapstructure:"OIDCSources,omitempty"`
 
+	// SAML sources to import.
+	SAMLSources SAMLSourcesList `json:"SAMLSources,omitempty" msgpack:"SAMLSources,omitempty" bson:"-" mapstructure:"SAMLSources,omitempty"`
+
+	// SPIFFE sources to import.
+	SPIFFESources SPIFFESourcesList `json:"SPIFFESources,omitempty" msgpack:"SPIFFESources,omitempty" bson:"-" mapstructure:"SPIFFESources,omitempty"`
+
 	// Authorizations to import.
 	Authorizations AuthorizationsList `json:"authorizations,omitempty" msgpack:"authorizations,omitempty" bson:"-" mapstructure:"authorizations,omitempty"`
 
@@ -112,13 +136,21 @@ type Import struct {
 func NewImport() *Import {
 
 	return &Import{
-		ModelVersion:   1,
-		A3SSources:     A3SSourcesList{},
-		HTTPSources:    HTTPSourcesList{},
-		LDAPSources:    LDAPSourcesList{},
-		MTLSSources:    MTLSSourcesList{},
-		OIDCSources:    OIDCSourcesList{},
-		Authorizations: AuthorizationsList{},
+		ModelVersion:      1,
+		A3SSources:        A3SSourcesList{},
+		AWSSources:        AWSSourcesList{},
+		AzureSources:      AzureSourcesList{},
+		GCPSources:        GCPSourcesList{},
+		HTTPSources:       HTTPSourcesList{},
+		JWTSources:        JWTSourcesList{},
+		KubernetesSources: KubernetesSourcesList{},
+		LDAPSources:       LDAPSourcesList{},
+		LocalSources:      LocalSourcesList{},
+		MTLSSources:       MTLSSourcesList{},
+		OIDCSources:       OIDCSourcesList{},
+		SAMLSources:       SAMLSourcesList{},
+		SPIFFESources:     SPIFFESourcesList{},
+		Authorizations:    AuthorizationsList{},
 	}
 }
 
@@ -204,13 +236,21 @@ func (o *Import) ToSparse(fields ...string) elemental.SparseIdentifiable {
 	if len(fields) == 0 {
 		// nolint: goimports
 		return &SparseImport{
-			A3SSources:     &o.A3SSources,
-			HTTPSources:    &o.HTTPSources,
-			LDAPSources:    &o.LDAPSources,
-			MTLSSources:    &o.MTLSSources,
-			OIDCSources:    &o.OIDCSources,
-			Authorizations: &o.Authorizations,
-			Label:          &o.Label,
+			A3SSources:        &o.A3SSources,
+			AWSSources:        &o.AWSSources,
+			AzureSources:      &o.AzureSources,
+			GCPSources:        &o.GCPSources,
+			HTTPSources:       &o.HTTPSources,
+			JWTSources:        &o.JWTSources,
+			KubernetesSources: &o.KubernetesSources,
+			LDAPSources:       &o.LDAPSources,
+			LocalSources:      &o.LocalSources,
+			MTLSSources:       &o.MTLSSources,
+			OIDCSources:       &o.OIDCSources,
+			SAMLSources:       &o.SAMLSources,
+			SPIFFESources:     &o.SPIFFESources,
+			Authorizations:    &o.Authorizations,
+			Label:             &o.Label,
 		}
 	}
 
@@ -219,14 +259,30 @@ func (o *Import) ToSparse(fields ...string) elemental.SparseIdentifiable {
 		switch f {
 		case "A3SSources":
 			sp.A3SSources = &(o.A3SSources)
+		case "AWSSources":
+			sp.AWSSources = &(o.AWSSources)
+		case "AzureSources":
+			sp.AzureSources = &(o.AzureSources)
+		case "GCPSources":
+			sp.GCPSources = &(o.GCPSources)
 		case "HTTPSources":
 			sp.HTTPSources = &(o.HTTPSources)
+		case "JWTSources":
+			sp.JWTSources = &(o.JWTSources)
+		case "KubernetesSources":
+			sp.KubernetesSources = &(o.KubernetesSources)
 		case "LDAPSources":
 			sp.LDAPSources = &(o.LDAPSources)
+		case "LocalSources":
+			sp.LocalSources = &(o.LocalSources)
 		case "MTLSSources":
 			sp.MTLSSources = &(o.MTLSSources)
 		case "OIDCSources":
 			sp.OIDCSources = &(o.OIDCSources)
+		case "SAMLSources":
+			sp.SAMLSources = &(o.SAMLSources)
+		case "SPIFFESources":
+			sp.SPIFFESources = &(o.SPIFFESources)
 		case "authorizations":
 			sp.Authorizations = &(o.Authorizations)
 		case "label":
@@ -247,18 +303,42 @@ func (o *Import) Patch(sparse elemental.SparseIdentifiable) {
 	if so.A3SSources != nil {
 		o.A3SSources = *so.A3SSources
 	}
+	if so.AWSSources != nil {
+		o.AWSSources = *so.AWSSources
+	}
+	if so.AzureSources != nil {
+		o.AzureSources = *so.AzureSources
+	}
+	if so.GCPSources != nil {
+		o.GCPSources = *so.GCPSources
+	}
 	if so.HTTPSources != nil {
 		o.HTTPSources = *so.HTTPSources
 	}
+	if so.JWTSources != nil {
+		o.JWTSources = *so.JWTSources
+	}
+	if so.KubernetesSources != nil {
+		o.KubernetesSources = *so.KubernetesSources
+	}
 	if so.LDAPSources != nil {
 		o.LDAPSources = *so.LDAPSources
 	}
+	if so.LocalSources != nil {
+		o.LocalSources = *so.LocalSources
+	}
 	if so.MTLSSources != nil {
 		o.MTLSSources = *so.MTLSSources
 	}
 	if so.OIDCSources != nil {
 		o.OIDCSources = *so.OIDCSources
 	}
+	if so.SAMLSources != nil {
+		o.SAMLSources = *so.SAMLSources
+	}
+	if so.SPIFFESources != nil {
+		o.SPIFFESources = *so.SPIFFESources
+	}
 	if so.Authorizations != nil {
 		o.Authorizations = *so.Authorizations
 	}
@@ -307,6 +387,36 @@ func (o *Import) Validate() error {
 		}
 	}
 
+	for _, sub := range o.AWSSources {
+		if sub == nil {
+			continue
+		}
+		elemental.ResetDefaultForZeroValues(sub)
+		if err := sub.Validate(); err != nil {
+			errors = errors.Append(err)
+		}
+	}
+
+	for _, sub := range o.AzureSources {
+		if sub == nil {
+			continue
+		}
+		elemental.ResetDefaultForZeroValues(sub)
+		if err := sub.Validate(); err != nil {
+			errors = errors.Append(err)
+		}
+	}
+
+	for _, sub := range o.GCPSources {
+		if sub == nil {
+			continue
+		}
+		elemental.ResetDefaultForZeroValues(sub)
+		if err := sub.Validate(); err != nil {
+			errors = errors.Append(err)
+		}
+	}
+
 	for _, sub := range o.HTTPSources {
 		if sub == nil {
 			continue
@@ -317,6 +427,26 @@ func (o *Import) Validate() error {
 		}
 	}
 
+	for _, sub := range o.JWTSources {
+		if sub == nil {
+			continue
+		}
+		elemental.ResetDefaultForZeroValues(sub)
+		if err := sub.Validate(); err != nil {
+			errors = errors.Append(err)
+		}
+	}
+
+	for _, sub := range o.KubernetesSources {
+		if sub == nil {
+			continue
+		}
+		elemental.ResetDefaultForZeroValues(sub)
+		if err := sub.Validate(); err != nil {
+			errors = errors.Append(err)
+		}
+	}
+
 	for _, sub := range o.LDAPSources {
 		if sub == nil {
 			continue
@@ -327,6 +457,16 @@ func (o *Import) Validate() error {
 		}
 	}
 
+	for _, sub := range o.LocalSources {
+		if sub == nil {
+			continue
+		}
+		elemental.ResetDefaultForZeroValues(sub)
+		if err := sub.Validate(); err != nil {
+			errors = errors.Append(err)
+		}
+	}
+
 	for _, sub := range o.MTLSSources {
 		if sub == nil {
 			continue
@@ -347,6 +487,26 @@ func (o *Import) Validate() error {
 		}
 	}
 
+	for _, sub := range o.SAMLSources {
+		if sub == nil {
+			continue
+		}
+		elemental.ResetDefaultForZeroValues(sub)
+		if err := sub.Validate(); err != nil {
+			errors = errors.Append(err)
+		}
+	}
+
+	for _, sub := range o.SPIFFESources {
+		if sub == nil {
+			continue
+		}
+		elemental.ResetDefaultForZeroValues(sub)
+		if err := sub.Validate(); err != nil {
+			errors = errors.Append(err)
+		}
+	}
+
 	for _, sub := range o.Authorizations {
 		if sub == nil {
 			continue
@@ -397,14 +557,30 @@ func (o *Import) ValueForAttribute(name string) any {
 	switch name {
 	case "A3SSources":
 		return o.A3SSources
+	case "AWSSources":
+		return o.AWSSources
+	case "AzureSources":
+		return o.AzureSources
+	case "GCPSources":
+		return o.GCPSources
 	case "HTTPSources":
 		return o.HTTPSources
+	case "JWTSources":
+		return o.JWTSources
+	case "KubernetesSources":
+		return o.KubernetesSources
 	case "LDAPSources":
 		return o.LDAPSources
+	case "LocalSources":
+		return o.LocalSources
 	case "MTLSSources":
 		return o.MTLSSources
 	case "OIDCSources":
 		return o.OIDCSources
+	case "SAMLSources":
+		return o.SAMLSources
+	case "SPIFFESources":
+		return o.SPIFFESources
 	case "authorizations":
 		return o.Authorizations
 	case "label":
@@ -425,6 +601,33 @@ var ImportAttributesMap = map[string]elemental.AttributeSpecification{
 		SubType:        "a3ssource",
 		Type:           "refList",
 	},
+	"AWSSources": {
+		AllowedChoices: []string{},
+		ConvertedName:  "AWSSources",
+		Description:    `AWS sources to import.`,
+		Exposed:        true,
+		Name:           "AWSSources",
+		SubType:        "awssource",
+		Type:           "refList",
+	},
+	"AzureSources": {
+		AllowedChoices: []string{},
+		ConvertedName:  "AzureSources",
+		Description:    `Azure sources to import.`,
+		Exposed:        true,
+		Name:           "AzureSources",
+		SubType:        "azuresource",
+		Type:           "refList",
+	},
+	"GCPSources": {
+		AllowedChoices: []string{},
+		ConvertedName:  "GCPSources",
+		Description:    `GCP sources to import.`,
+		Exposed:        true,
+		Name:           "GCPSources",
+		SubType:        "gcpsource",
+		Type:           "refList",
+	},
 	"HTTPSources": {
 		AllowedChoices: []string{},
 		ConvertedName:  "HTTPSources",
@@ -434,6 +637,24 @@ var ImportAttributesMap = map[string]elemental.AttributeSpecification{
 		SubType:        "httpsource",
 		Type:           "refList",
 	},
+	"JWTSources": {
+		AllowedChoices: []string{},
+		ConvertedName:  "JWTSources",
+		Description:    `JWT sources to import.`,
+		Exposed:        true,
+		Name:           "JWTSources",
+		SubType:        "jwtsource",
+		Type:           "refList",
+	},
+	"KubernetesSources": {
+		AllowedChoices: []string{},
+		ConvertedName:  "KubernetesSources",
+		Description:    `Kubernetes sources to import.`,
+		Exposed:        true,
+		Name:           "KubernetesSources",
+		SubType:        "kubernetessource",
+		Type:           "refList",
+	},
 	"LDAPSources": {
 		AllowedChoices: []string{},
 		ConvertedName:  "LDAPSources",
@@ -443,6 +664,15 @@ var ImportAttributesMap = map[string]elemental.AttributeSpecification{
 		SubType:        "ldapsource",
 		Type:           "refList",
 	},
+	"LocalSources": {
+		AllowedChoices: []string{},
+		ConvertedName:  "LocalSources",
+		Description:    `Local sources to import.`,
+		Exposed:        true,
+		Name:           "LocalSources",
+		SubType:        "localsource",
+		Type:           "refList",
+	},
 	"MTLSSources": {
 		AllowedChoices: []string{},
 		ConvertedName:  "MTLSSources",
@@ -461,6 +691,24 @@ var ImportAttributesMap = map[string]elemental.AttributeSpecification{
 		SubType:        "oidcsource",
 		Type:           "refList",
 	},
+	"SAMLSources": {
+		AllowedChoices: []string{},
+		ConvertedName:  "SAMLSources",
+		Description:    `SAML sources to import.`,
+		Exposed:        true,
+		Name:           "SAMLSources",
+		SubType:        "samlsource",
+		Type:           "refList",
+	},
+	"SPIFFESources": {
+		AllowedChoices: []string{},
+		ConvertedName:  "SPIFFESources",
+		Description:    `SPIFFE sources to import.`,
+		Exposed:        true,
+		Name:           "SPIFFESources",
+		SubType:        "spiffesource",
+		Type:           "refList",
+	},
 	"Authorizations": {
 		AllowedChoices: []string{},
 		ConvertedName:  "Authorizations",
@@ -493,6 +741,33 @@ var ImportLowerCaseAttributesMap = map[string]elemental.AttributeSpecification{
 		SubType:        "a3ssource",
 		Type:           "refList",
 	},
+	"awssources": {
+		AllowedChoices: []string{},
+		ConvertedName:  "AWSSources",
+		Description:    `AWS sources to import.`,
+		Exposed:        true,
+		Name:           "AWSSources",
+		SubType:        "awssource",
+		Type:           "refList",
+	},
+	"azuresources": {
+		AllowedChoices: []string{},
+		ConvertedName:  "AzureSources",
+		Description:    `Azure sources to import.`,
+		Exposed:        true,
+		Name:           "AzureSources",
+		SubType:        "azuresource",
+		Type:           "refList",
+	},
+	"gcpsources": {
+		AllowedChoices: []string{},
+		ConvertedName:  "GCPSources",
+		Description:    `GCP sources to import.`,
+		Exposed:        true,
+		Name:           "GCPSources",
+		SubType:        "gcpsource",
+		Type:           "refList",
+	},
 	"httpsources": {
 		AllowedChoices: []string{},
 		ConvertedName:  "HTTPSources",
@@ -502,6 +777,24 @@ var ImportLowerCaseAttributesMap = map[string]elemental.AttributeSpecification{
 		SubType:        "httpsource",
 		Type:           "refList",
 	},
+	"jwtsources": {
+		AllowedChoices: []string{},
+		ConvertedName:  "JWTSources",
+		Description:    `JWT sources to import.`,
+		Exposed:        true,
+		Name:           "JWTSources",
+		SubType:        "jwtsource",
+		Type:           "refList",
+	},
+	"kubernetessources": {
+		AllowedChoices: []string{},
+		ConvertedName:  "KubernetesSources",
+		Description:    `Kubernetes sources to import.`,
+		Exposed:        true,
+		Name:           "KubernetesSources",
+		SubType:        "kubernetessource",
+		Type:           "refList",
+	},
 	"ldapsources": {
 		AllowedChoices: []string{},
 		ConvertedName:  "LDAPSources",
@@ -511,6 +804,15 @@ var ImportLowerCaseAttributesMap = map[string]elemental.AttributeSpecification{
 		SubType:        "ldapsource",
 		Type:           "refList",
 	},
+	"localsources": {
+		AllowedChoices: []string{},
+		ConvertedName:  "LocalSources",
+		Description:    `Local sources to import.`,
+		Exposed:        true,
+		Name:           "LocalSources",
+		SubType:        "localsource",
+		Type:           "refList",
+	},
 	"mtlssources": {
 		AllowedChoices: []string{},
 		ConvertedName:  "MTLSSources",
@@ -529,6 +831,24 @@ var ImportLowerCaseAttributesMap = map[string]elemental.AttributeSpecification{
 		SubType:        "oidcsource",
 		Type:           "refList",
 	},
+	"samlsources": {
+		AllowedChoices: []string{},
+		ConvertedName:  "SAMLSources",
+		Description:    `SAML sources to import.`,
+		Exposed:        true,
+		Name:           "SAMLSources",
+		SubType:        "samlsource",
+		Type:           "refList",
+	},
+	"spiffesources": {
+		AllowedChoices: []string{},
+		ConvertedName:  "SPIFFESources",
+		Description:    `SPIFFE sources to import.`,
+		Exposed:        true,
+		Name:           "SPIFFESources",
+		SubType:        "spiffesource",
+		Type:           "refList",
+	},
 	"authorizations": {
 		AllowedChoices: []string{},
 		ConvertedName:  "Authorizations",
@@ -616,18 +936,42 @@ type SparseImport struct {
 	// A3S sources to import.
 	A3SSources *A3SSourcesList `json:"A3SSources,omitempty" msgpack:"A3SSources,omitempty" bson:"-" mapstructure:"A3SSources,omitempty"`
 
+	// AWS sources to import.
+	AWSSources *AWSSourcesList `json:"AWSSources,omitempty" msgpack:"AWSSources,omitempty" bson:"-" mapstructure:"AWSSources,omitempty"`
+
+	// Azure sources to import.
+	AzureSources *AzureSourcesList `json:"AzureSources,omitempty" msgpack:"AzureSources,omitempty" bson:"-" mapstructure:"AzureSources,omitempty"`
+
+	// GCP sources to import.
+	GCPSources *GCPSourcesList `json:"GCPSources,omitempty" msgpack:"GCPSources,omitempty" bson:"-" mapstructure:"GCPSources,omitempty"`
+
 	// HTTP sources to import.
 	HTTPSources *HTTPSourcesList `json:"HTTPSources,omitempty" msgpack:"HTTPSources,omitempty" bson:"-" mapstructure:"HTTPSources,omitempty"`
 
+	// JWT sources to import.
+	JWTSources *JWTSourcesList `json:"JWTSources,omitempty" msgpack:"JWTSources,omitempty" bson:"-" mapstructure:"JWTSources,omitempty"`
+
+	// Kubernetes sources to import.
+	KubernetesSources *KubernetesSourcesList `json:"KubernetesSources,omitempty" msgpack:"KubernetesSources,omitempty" bson:"-" mapstructure:"KubernetesSources,omitempty"`
+
 	// LDAP sources to import.
 	LDAPSources *LDAPSourcesList `json:"LDAPSources,omitempty" msgpack:"LDAPSources,omitempty" bson:"-" mapstructure:"LDAPSources,omitempty"`
 
+	// Local sources to import.
+	LocalSources *LocalSourcesList `json:"LocalSources,omitempty" msgpack:"LocalSources,omitempty" bson:"-" mapstructure:"LocalSources,omitempty"`
+
 	// MTLS sources to import.
 	MTLSSources *MTLSSourcesList `json:"MTLSSources,omitempty" msgpack:"MTLSSources,omitempty" bson:"-" mapstructure:"MTLSSources,omitempty"`
 
 	// OIDC sources to import.
 	OIDCSources *OIDCSourcesList `json:"OIDCSources,omitempty" msgpack:"OIDCSources,omitempty" bson:"-" mapstructure:"OIDCSources,omitempty"`
 
+	// SAML sources to import.
+	SAMLSources *SAMLSourcesList `json:"SAMLSources,omitempty" msgpack:"SAMLSources,omitempty" bson:"-" mapstructure:"SAMLSources,omitempty"`
+
+	// SPIFFE sources to import.
+	SPIFFESources *SPIFFESourcesList `json:"SPIFFESources,omitempty" msgpack:"SPIFFESources,omitempty" bson:"-" mapstructure:"SPIFFESources,omitempty"`
+
 	// Authorizations to import.
 	Authorizations *AuthorizationsList `json:"authorizations,omitempty" msgpack:"authorizations,omitempty" bson:"-" mapstructure:"authorizations,omitempty"`
 
@@ -702,18 +1046,42 @@ func (o *SparseImport) ToPlain() elemental.PlainIdentifiable {
 	if o.A3SSources != nil {
 		out.A3SSources = *o.A3SSources
 	}
+	if o.AWSSources != nil {
+		out.AWSSources = *o.AWSSources
+	}
+	if o.AzureSources != nil {
+		out.AzureSources = *o.AzureSources
+	}
+	if o.GCPSources != nil {
+		out.GCPSources = *o.GCPSources
+	}
 	if o.HTTPSources != nil {
 		out.HTTPSources = *o.HTTPSources
 	}
+	if o.JWTSources != nil {
+		out.JWTSources = *o.JWTSources
+	}
+	if o.KubernetesSources != nil {
+		out.KubernetesSources = *o.KubernetesSources
+	}
 	if o.LDAPSources != nil {
 		out.LDAPSources = *o.LDAPSources
 	}
+	if o.LocalSources != nil {
+		out.LocalSources = *o.LocalSources
+	}
 	if o.MTLSSources != nil {
 		out.MTLSSources = *o.MTLSSources
 	}
 	if o.OIDCSources != nil {
 		out.OIDCSources = *o.OIDCSources
 	}
+	if o.SAMLSources != nil {
+		out.SAMLSources = *o.SAMLSources
+	}
+	if o.SPIFFESources != nil {
+		out.SPIFFESources = *o.SPIFFESources
+	}
 	if o.Authorizations != nil {
 		out.Authorizations = *o.Authorizations
 	}
@@ -30,9 +30,21 @@ const (
 	// IssueSourceTypeHTTP represents the value HTTP.
 	IssueSourceTypeHTTP IssueSourceTypeValue = "HTTP"
 
+	// IssueSourceTypeJWT represents the value JWT.
+	IssueSourceTypeJWT IssueSourceTypeValue = "JWT"
+
+	// IssueSourceTypeKubernetes represents the value Kubernetes.
+	IssueSourceTypeKubernetes IssueSourceTypeValue = "Kubernetes"
+
 	// IssueSourceTypeLDAP represents the value LDAP.
 	IssueSourceTypeLDAP IssueSourceTypeValue = "LDAP"
 
+	// IssueSourceTypeLocal represents the value Local.
+	IssueSourceTypeLocal IssueSourceTypeValue = "Local"
+
+	// IssueSourceTypeMFA represents the value MFA.
+	IssueSourceTypeMFA IssueSourceTypeValue = "MFA"
+
 	// IssueSourceTypeMTLS represents the value MTLS.
 	IssueSourceTypeMTLS IssueSourceTypeValue = "MTLS"
 
@@ -44,6 +56,12 @@ const (
 
 	// IssueSourceTypeSAML represents the value SAML.
 	IssueSourceTypeSAML IssueSourceTypeValue = "SAML"
+
+	// IssueSourceTypeSPIFFE represents the value SPIFFE.
+	IssueSourceTypeSPIFFE IssueSourceTypeValue = "SPIFFE"
+
+	// IssueSourceTypeTokenExchange represents the value TokenExchange.
+	IssueSourceTypeTokenExchange IssueSourceTypeValue = "TokenExchange"
 )
 
 // IssueTokenTypeValue represents the possible values for attribute "tokenType".
@@ -53,6 +71,9 @@ const (
 	// IssueTokenTypeIdentity represents the value Identity.
 	IssueTokenTypeIdentity IssueTokenTypeValue = "Identity"
 
+	// IssueTokenTypeReference represents the value Reference.
+	IssueTokenTypeReference IssueTokenTypeValue = "Reference"
+
 	// IssueTokenTypeRefresh represents the value Refresh.
 	IssueTokenTypeRefresh IssueTokenTypeValue = "Refresh"
 )
@@ -132,6 +153,12 @@ type Issue struct {
 	// Requested audience for the delivered token.
 	Audience []string `json:"audience,omitempty" msgpack:"audience,omitempty" bson:"-" mapstructure:"audience,omitempty"`
 
+	// If set, the delivered token will be bound to the client certificate used to
+	// authenticate, as described by RFC 8705. A bound token can only be used by a
+	// client presenting the same certificate. This is only supported by the MTLS
+	// source type.
+	CertificateBound bool `json:"certificateBound,omitempty" msgpack:"certificateBound,omitempty" bson:"-" mapstructure:"certificateBound,omitempty"`
+
 	// Sets a list of identity claim prefix to allow in the final token. This can be
 	// used to hide some information when asking for a token as not all systems need to
 	// know all of the claims.
@@ -158,18 +185,44 @@ type Issue struct {
 	// Contains additional information for an HTTP source.
 	InputHTTP *IssueHTTP `json:"inputHTTP,omitempty" msgpack:"inputHTTP,omitempty" bson:"-" mapstructure:"inputHTTP,omitempty"`
 
+	// Contains additional information for a JWT source.
+	InputJWT *IssueJWT `json:"inputJWT,omitempty" msgpack:"inputJWT,omitempty" bson:"-" mapstructure:"inputJWT,omitempty"`
+
+	// Contains additional information for a Kubernetes service account token source.
+	InputKubernetes *IssueKubernetes `json:"inputKubernetes,omitempty" msgpack:"inputKubernetes,omitempty" bson:"-" mapstructure:"inputKubernetes,omitempty"`
+
 	// Contains additional information for an LDAP source.
 	InputLDAP *IssueLDAP `json:"inputLDAP,omitempty" msgpack:"inputLDAP,omitempty" bson:"-" mapstructure:"inputLDAP,omitempty"`
 
+	// Contains additional information for a local source.
+	InputLocal *IssueLocal `json:"inputLocal,omitempty" msgpack:"inputLocal,omitempty" bson:"-" mapstructure:"inputLocal,omitempty"`
+
+	// Contains additional information to upgrade a token using a second factor.
+	InputMFA *IssueMFA `json:"inputMFA,omitempty" msgpack:"inputMFA,omitempty" bson:"-" mapstructure:"inputMFA,omitempty"`
+
 	// Contains additional information for an OIDC source.
 	InputOIDC *IssueOIDC `json:"inputOIDC,omitempty" msgpack:"inputOIDC,omitempty" bson:"-" mapstructure:"inputOIDC,omitempty"`
 
 	// Contains additional information for a remote A3S token source.
 	InputRemoteA3S *IssueRemoteA3S `json:"inputRemoteA3S,omitempty" msgpack:"inputRemoteA3S,omitempty" bson:"-" mapstructure:"inputRemoteA3S,omitempty"`
 
+	// Contains additional information for a SAML source.
+	InputSAML *IssueSAML `json:"inputSAML,omitempty" msgpack:"inputSAML,omitempty" bson:"-" mapstructure:"inputSAML,omitempty"`
+
+	// Contains additional information for a SPIFFE source.
+	InputSPIFFE *IssueSPIFFE `json:"inputSPIFFE,omitempty" msgpack:"inputSPIFFE,omitempty" bson:"-" mapstructure:"inputSPIFFE,omitempty"`
+
+	// Contains additional information for a token exchange.
+	InputTokenExchange *IssueTokenExchange `json:"inputTokenExchange,omitempty" msgpack:"inputTokenExchange,omitempty" bson:"-" mapstructure:"inputTokenExchange,omitempty"`
+
 	// Opaque data that will be included in the issued token.
 	Opaque map[string]string `json:"opaque,omitempty" msgpack:"opaque,omitempty" bson:"-" mapstructure:"opaque,omitempty"`
 
+	// The next refresh token, issued when the input A3S token is a refresh token.
+	// A refresh token can only be used once, and using it again revokes all the
+	// refresh tokens of its family and all the tokens issued from them.
+	RefreshToken string `json:"refreshToken,omitempty" msgpack:"refreshToken,omitempty" bson:"-" mapstructure:"refreshToken,omitempty"`
+
 	// Restricts the namespace where the token can be used.
 	//
 	// For instance, if you have have access to `/namespace` and below, you can
@@ -217,7 +270,8 @@ type Issue struct {
 	// Issued token.
 	Token string `json:"token,omitempty" msgpack:"token,omitempty" bson:"-" mapstructure:"token,omitempty"`
 
-	// The type of token to issue.
+	// The type of token to issue. A Reference token is a short opaque token
+	// referring to an identity token stored by a3s.
 	TokenType IssueTokenTypeValue `json:"tokenType,omitempty" msgpack:"tokenType,omitempty" bson:"-" mapstructure:"tokenType,omitempty"`
 
 	// Configures the maximum length of validity for a token, using
@@ -324,6 +378,7 @@ func (o *Issue) ToSparse(fields ...string) elemental.SparseIdentifiable {
 		// nolint: goimports
 		return &SparseIssue{
 			Audience:              &o.Audience,
+			CertificateBound:      &o.CertificateBound,
 			Cloak:                 &o.Cloak,
 			Cookie:                &o.Cookie,
 			CookieDomain:          &o.CookieDomain,
@@ -332,10 +387,18 @@ func (o *Issue) ToSparse(fields ...string) elemental.SparseIdentifiable {
 			InputAzure:            o.InputAzure,
 			InputGCP:              o.InputGCP,
 			InputHTTP:             o.InputHTTP,
+			InputJWT:              o.InputJWT,
+			InputKubernetes:       o.InputKubernetes,
 			InputLDAP:             o.InputLDAP,
+			InputLocal:            o.InputLocal,
+			InputMFA:              o.InputMFA,
 			InputOIDC:             o.InputOIDC,
 			InputRemoteA3S:        o.InputRemoteA3S,
+			InputSAML:             o.InputSAML,
+			InputSPIFFE:           o.InputSPIFFE,
+			InputTokenExchange:    o.InputTokenExchange,
 			Opaque:                &o.Opaque,
+			RefreshToken:          &o.RefreshToken,
 			RestrictedNamespace:   &o.RestrictedNamespace,
 			RestrictedNetworks:    &o.RestrictedNetworks,
 			RestrictedPermissions: &o.RestrictedPermissions,
@@ -353,6 +416,8 @@ func (o *Issue) ToSparse(fields ...string) elemental.SparseIdentifiable {
 		switch f {
 		case "audience":
 			sp.Audience = &(o.Audience)
+		case "certificateBound":
+			sp.CertificateBound = &(o.CertificateBound)
 		case "cloak":
 			sp.Cloak = &(o.Cloak)
 		case "cookie":
@@ -369,14 +434,30 @@ func (o *Issue) ToSparse(fields ...string) elemental.SparseIdentifiable {
 			sp.InputGCP = o.InputGCP
 		case "inputHTTP":
 			sp.InputHTTP = o.InputHTTP
+		case "inputJWT":
+			sp.InputJWT = o.InputJWT
+		case "inputKubernetes":
+			sp.InputKubernetes = o.InputKubernetes
 		case "inputLDAP":
 			sp.InputLDAP = o.InputLDAP
+		case "inputLocal":
+			sp.InputLocal = o.InputLocal
+		case "inputMFA":
+			sp.InputMFA = o.InputMFA
 		case "inputOIDC":
 			sp.InputOIDC = o.InputOIDC
 		case "inputRemoteA3S":
 			sp.InputRemoteA3S = o.InputRemoteA3S
+		case "inputSAML":
+			sp.InputSAML = o.InputSAML
+		case "inputSPIFFE":
+			sp.InputSPIFFE = o.InputSPIFFE
+		case "inputTokenExchange":
+			sp.InputTokenExchange = o.InputTokenExchange
 		case "opaque":
 			sp.Opaque = &(o.Opaque)
+		case "refreshToken":
+			sp.RefreshToken = &(o.RefreshToken)
 		case "restrictedNamespace":
 			sp.RestrictedNamespace = &(o.RestrictedNamespace)
 		case "restrictedNetworks":
@@ -411,6 +492,9 @@ func (o *Issue) Patch(sparse elemental.SparseIdentifiable) {
 	if so.Audience != nil {
 		o.Audience = *so.Audience
 	}
+	if so.CertificateBound != nil {
+		o.CertificateBound = *so.CertificateBound
+	}
 	if so.Cloak != nil {
 		o.Cloak = *so.Cloak
 	}
@@ -435,18 +519,42 @@ func (o *Issue) Patch(sparse elemental.SparseIdentifiable) {
 	if so.InputHTTP != nil {
 		o.InputHTTP = so.InputHTTP
 	}
+	if so.InputJWT != nil {
+		o.InputJWT = so.InputJWT
+	}
+	if so.InputKubernetes != nil {
+		o.InputKubernetes = so.InputKubernetes
+	}
 	if so.InputLDAP != nil {
 		o.InputLDAP = so.InputLDAP
 	}
+	if so.InputLocal != nil {
+		o.InputLocal = so.InputLocal
+	}
+	if so.InputMFA != nil {
+		o.InputMFA = so.InputMFA
+	}
 	if so.InputOIDC != nil {
 		o.InputOIDC = so.InputOIDC
 	}
 	if so.InputRemoteA3S != nil {
 		o.InputRemoteA3S = so.InputRemoteA3S
 	}
+	if so.InputSAML != nil {
+		o.InputSAML = so.InputSAML
+	}
+	if so.InputSPIFFE != nil {
+		o.InputSPIFFE = so.InputSPIFFE
+	}
+	if so.InputTokenExchange != nil {
+		o.InputTokenExchange = so.InputTokenExchange
+	}
 	if so.Opaque != nil {
 		o.Opaque = *so.Opaque
 	}
+	if so.RefreshToken != nil {
+		o.RefreshToken = *so.RefreshToken
+	}
 	if so.RestrictedNamespace != nil {
 		o.RestrictedNamespace = *so.RestrictedNamespace
 	}
@@ -541,6 +649,20 @@ func (o *Issue) Validate() error {
 		}
 	}
 
+	if o.InputJWT != nil {
+		elemental.ResetDefaultForZeroValues(o.InputJWT)
+		if err := o.InputJWT.Validate(); err != nil {
+			errors = errors.Append(err)
+		}
+	}
+
+	if o.InputKubernetes != nil {
+		elemental.ResetDefaultForZeroValues(o.InputKubernetes)
+		if err := o.InputKubernetes.Validate(); err != nil {
+			errors = errors.Append(err)
+		}
+	}
+
 	if o.InputLDAP != nil {
 		elemental.ResetDefaultForZeroValues(o.InputLDAP)
 		if err := o.InputLDAP.Validate(); err != nil {
@@ -548,6 +670,20 @@ func (o *Issue) Validate() error {
 		}
 	}
 
+	if o.InputLocal != nil {
+		elemental.ResetDefaultForZeroValues(o.InputLocal)
+		if err := o.InputLocal.Validate(); err != nil {
+			errors = errors.Append(err)
+		}
+	}
+
+	if o.InputMFA != nil {
+		elemental.ResetDefaultForZeroValues(o.InputMFA)
+		if err := o.InputMFA.Validate(); err != nil {
+			errors = errors.Append(err)
+		}
+	}
+
 	if o.InputOIDC != nil {
 		elemental.ResetDefaultForZeroValues(o.InputOIDC)
 		if err := o.InputOIDC.Validate(); err != nil {
@@ -562,6 +698,27 @@ func (o *Issue) Validate() error {
 		}
 	}
 
+	if o.InputSAML != nil {
+		elemental.ResetDefaultForZeroValues(o.InputSAML)
+		if err := o.InputSAML.Validate(); err != nil {
+			errors = errors.Append(err)
+		}
+	}
+
+	if o.InputSPIFFE != nil {
+		elemental.ResetDefaultForZeroValues(o.InputSPIFFE)
+		if err := o.InputSPIFFE.Validate(); err != nil {
+			errors = errors.Append(err)
+		}
+	}
+
+	if o.InputTokenExchange != nil {
+		elemental.ResetDefaultForZeroValues(o.InputTokenExchange)
+		if err := o.InputTokenExchange.Validate(); err != nil {
+			errors = errors.Append(err)
+		}
+	}
+
 	if err := ValidateCIDRListOptional("restrictedNetworks", o.RestrictedNetworks); err != nil {
 		errors = errors.Append(err)
 	}
@@ -570,11 +727,11 @@ func (o *Issue) Validate() error {
 		requiredErrors = requiredErrors.Append(err)
 	}
 
-	if err := elemental.ValidateStringInList("sourceType", string(o.SourceType), []string{"A3S", "AWS", "Azure", "GCP", "HTTP", "LDAP", "MTLS", "OIDC", "RemoteA3S", "SAML"}, false); err != nil {
+	if err := elemental.ValidateStringInList("sourceType", string(o.SourceType), []string{"A3S", "AWS", "Azure", "GCP", "HTTP", "JWT", "Kubernetes", "LDAP", "Local", "MFA", "MTLS", "OIDC", "RemoteA3S", "SAML", "SPIFFE", "TokenExchange"}, false); err != nil {
 		errors = errors.Append(err)
 	}
 
-	if err := elemental.ValidateStringInList("tokenType", string(o.TokenType), []string{"Identity", "Refresh"}, false); err != nil {
+	if err := elemental.ValidateStringInList("tokenType", string(o.TokenType), []string{"Identity", "Refresh", "Reference"}, false); err != nil {
 		errors = errors.Append(err)
 	}
 
@@ -623,6 +780,8 @@ func (o *Issue) ValueForAttribute(name string) any {
 	switch name {
 	case "audience":
 		return o.Audience
+	case "certificateBound":
+		return o.CertificateBound
 	case "cloak":
 		return o.Cloak
 	case "cookie":
@@ -639,14 +798,30 @@ func (o *Issue) ValueForAttribute(name string) any {
 		return o.InputGCP
 	case "inputHTTP":
 		return o.InputHTTP
+	case "inputJWT":
+		return o.InputJWT
+	case "inputKubernetes":
+		return o.InputKubernetes
 	case "inputLDAP":
 		return o.InputLDAP
+	case "inputLocal":
+		return o.InputLocal
+	case "inputMFA":
+		return o.InputMFA
 	case "inputOIDC":
 		return o.InputOIDC
 	case "inputRemoteA3S":
 		return o.InputRemoteA3S
+	case "inputSAML":
+		return o.InputSAML
+	case "inputSPIFFE":
+		return o.InputSPIFFE
+	case "inputTokenExchange":
+		return o.InputTokenExchange
 	case "opaque":
 		return o.Opaque
+	case "refreshToken":
+		return o.RefreshToken
 	case "restrictedNamespace":
 		return o.RestrictedNamespace
 	case "restrictedNetworks":
@@ -681,6 +856,17 @@ var IssueAttributesMap = map[string]elemental.AttributeSpecification{
 		SubType:        "string",
 		Type:           "list",
 	},
+	"CertificateBound": {
+		AllowedChoices: []string{},
+		ConvertedName:  "CertificateBound",
+		Description: `If set, the delivered token will be bound to the client certificate used to
+authenticate, as described by RFC 8705. A bound token can only be used by a
+client presenting the same certificate. This is only supported by the MTLS
+source type.`,
+		Exposed: true,
+		Name:    "certificateBound",
+		Type:    "boolean",
+	},
 	"Cloak": {
 		AllowedChoices: []string{},
 		ConvertedName:  "Cloak",
@@ -753,6 +939,24 @@ know all of the claims.`,
 		SubType:        "issuehttp",
 		Type:           "ref",
 	},
+	"InputJWT": {
+		AllowedChoices: []string{},
+		ConvertedName:  "InputJWT",
+		Description:    `Contains additional information for a JWT source.`,
+		Exposed:        true,
+		Name:           "inputJWT",
+		SubType:        "issuejwt",
+		Type:           "ref",
+	},
+	"InputKubernetes": {
+		AllowedChoices: []string{},
+		ConvertedName:  "InputKubernetes",
+		Description:    `Contains additional information for a Kubernetes service account token source.`,
+		Exposed:        true,
+		Name:           "inputKubernetes",
+		SubType:        "issuekubernetes",
+		Type:           "ref",
+	},
 	"InputLDAP": {
 		AllowedChoices: []string{},
 		ConvertedName:  "InputLDAP",
@@ -762,6 +966,24 @@ know all of the claims.`,
 		SubType:        "issueldap",
 		Type:           "ref",
 	},
+	"InputLocal": {
+		AllowedChoices: []string{},
+		ConvertedName:  "InputLocal",
+		Description:    `Contains additional information for a local source.`,
+		Exposed:        true,
+		Name:           "inputLocal",
+		SubType:        "issuelocal",
+		Type:           "ref",
+	},
+	"InputMFA": {
+		AllowedChoices: []string{},
+		ConvertedName:  "InputMFA",
+		Description:    `Contains additional information to upgrade a token using a second factor.`,
+		Exposed:        true,
+		Name:           "inputMFA",
+		SubType:        "issuemfa",
+		Type:           "ref",
+	},
 	"InputOIDC": {
 		AllowedChoices: []string{},
 		ConvertedName:  "InputOIDC",
@@ -780,6 +1002,33 @@ know all of the claims.`,
 		SubType:        "issueremotea3s",
 		Type:           "ref",
 	},
+	"InputSAML": {
+		AllowedChoices: []string{},
+		ConvertedName:  "InputSAML",
+		Description:    `Contains additional information for a SAML source.`,
+		Exposed:        true,
+		Name:           "inputSAML",
+		SubType:        "issuesaml",
+		Type:           "ref",
+	},
+	"InputSPIFFE": {
+		AllowedChoices: []string{},
+		ConvertedName:  "InputSPIFFE",
+		Description:    `Contains additional information for a SPIFFE source.`,
+		Exposed:        true,
+		Name:           "inputSPIFFE",
+		SubType:        "issuespiffe",
+		Type:           "ref",
+	},
+	"InputTokenExchange": {
+		AllowedChoices: []string{},
+		ConvertedName:  "InputTokenExchange",
+		Description:    `Contains additional information for a token exchange.`,
+		Exposed:        true,
+		Name:           "inputTokenExchange",
+		SubType:        "issuetokenexchange",
+		Type:           "ref",
+	},
 	"Opaque": {
 		AllowedChoices: []string{},
 		ConvertedName:  "Opaque",
@@ -789,6 +1038,18 @@ know all of the claims.`,
 		SubType:        "map[string]string",
 		Type:           "external",
 	},
+	"RefreshToken": {
+		AllowedChoices: []string{},
+		Autogenerated:  true,
+		ConvertedName:  "RefreshToken",
+		Description: `The next refresh token, issued when the input A3S token is a refresh token.
+A refresh token can only be used once, and using it again revokes all the
+refresh tokens of its family and all the tokens issued from them.`,
+		Exposed:  true,
+		Name:     "refreshToken",
+		ReadOnly: true,
+		Type:     "string",
+	},
 	"RestrictedNamespace": {
 		AllowedChoices: []string{},
 		ConvertedName:  "RestrictedNamespace",
@@ -857,7 +1118,7 @@ engine has no effect and may end up making the token unusable.`,
 		Type:           "string",
 	},
 	"SourceType": {
-		AllowedChoices: []string{"A3S", "AWS", "Azure", "GCP", "HTTP", "LDAP", "MTLS", "OIDC", "RemoteA3S", "SAML"},
+		AllowedChoices: []string{"A3S", "AWS", "Azure", "GCP", "HTTP", "JWT", "Kubernetes", "LDAP", "Local", "MFA", "MTLS", "OIDC", "RemoteA3S", "SAML", "SPIFFE", "TokenExchange"},
 		ConvertedName:  "SourceType",
 		Description: `The authentication source. This will define how to verify
 credentials from internal or external source of authentication.`,
@@ -877,13 +1138,14 @@ credentials from internal or external source of authentication.`,
 		Type:           "string",
 	},
 	"TokenType": {
-		AllowedChoices: []string{"Identity", "Refresh"},
+		AllowedChoices: []string{"Identity", "Refresh", "Reference"},
 		ConvertedName:  "TokenType",
 		DefaultValue:   IssueTokenTypeIdentity,
-		Description:    `The type of token to issue.`,
-		Exposed:        true,
-		Name:           "tokenType",
-		Type:           "enum",
+		Description: `The type of token to issue. A Reference token is a short opaque token
+referring to an identity token stored by a3s.`,
+		Exposed: true,
+		Name:    "tokenType",
+		Type:    "enum",
 	},
 	"Validity": {
 		AllowedChoices: []string{},
@@ -907,6 +1169,17 @@ var IssueLowerCaseAttributesMap = map[string]elemental.AttributeSpecification{
 		SubType:        "string",
 		Type:           "list",
 	},
+	"certificatebound": {
+		AllowedChoices: []string{},
+		ConvertedName:  "CertificateBound",
+		Description: `If set, the delivered token will be bound to the client certificate used to
+authenticate, as described by RFC 8705. A bound token can only be used by a
+client presenting the same certificate. This is only supported by the MTLS
+source type.`,
+		Exposed: true,
+		Name:    "certificateBound",
+		Type:    "boolean",
+	},
 	"cloak": {
 		AllowedChoices: []string{},
 		ConvertedName:  "Cloak",
@@ -979,6 +1252,24 @@ know all of the claims.`,
 		SubType:        "issuehttp",
 		Type:           "ref",
 	},
+	"inputjwt": {
+		AllowedChoices: []string{},
+		ConvertedName:  "InputJWT",
+		Description:    `Contains additional information for a JWT source.`,
+		Exposed:        true,
+		Name:           "inputJWT",
+		SubType:        "issuejwt",
+		Type:           "ref",
+	},
+	"inputkubernetes": {
+		AllowedChoices: []string{},
+		ConvertedName:  "InputKubernetes",
+		Description:    `Contains additional information for a Kubernetes service account token source.`,
+		Exposed:        true,
+		Name:           "inputKubernetes",
+		SubType:        "issuekubernetes",
+		Type:           "ref",
+	},
 	"inputldap": {
 		AllowedChoices: []string{},
 		ConvertedName:  "InputLDAP",
@@ -988,6 +1279,24 @@ know all of the claims.`,
 		SubType:        "issueldap",
 		Type:           "ref",
 	},
+	"inputlocal": {
+		AllowedChoices: []string{},
+		ConvertedName:  "InputLocal",
+		Description:    `Contains additional information for a local source.`,
+		Exposed:        true,
+		Name:           "inputLocal",
+		SubType:        "issuelocal",
+		Type:           "ref",
+	},
+	"inputmfa": {
+		AllowedChoices: []string{},
+		ConvertedName:  "InputMFA",
+		Description:    `Contains additional information to upgrade a token using a second factor.`,
+		Exposed:        true,
+		Name:           "inputMFA",
+		SubType:        "issuemfa",
+		Type:           "ref",
+	},
 	"inputoidc": {
 		AllowedChoices: []string{},
 		ConvertedName:  "InputOIDC",
@@ -1006,6 +1315,33 @@ know all of the claims.`,
 		SubType:        "issueremotea3s",
 		Type:           "ref",
 	},
+	"inputsaml": {
+		AllowedChoices: []string{},
+		ConvertedName:  "InputSAML",
+		Description:    `Contains additional information for a SAML source.`,
+		Exposed:        true,
+		Name:           "inputSAML",
+		SubType:        "issuesaml",
+		Type:           "ref",
+	},
+	"inputspiffe": {
+		AllowedChoices: []string{},
+		ConvertedName:  "InputSPIFFE",
+		Description:    `Contains additional information for a SPIFFE source.`,
+		Exposed:        true,
+		Name:           "inputSPIFFE",
+		SubType:        "issuespiffe",
+		Type:           "ref",
+	},
+	"inputtokenexchange": {
+		AllowedChoices: []string{},
+		ConvertedName:  "InputTokenExchange",
+		Description:    `Contains additional information for a token exchange.`,
+		Exposed:        true,
+		Name:           "inputTokenExchange",
+		SubType:        "issuetokenexchange",
+		Type:           "ref",
+	},
 	"opaque": {
 		AllowedChoices: []string{},
 		ConvertedName:  "Opaque",
@@ -1015,6 +1351,18 @@ know all of the claims.`,
 		SubType:        "map[string]string",
 		Type:           "external",
 	},
+	"refreshtoken": {
+		AllowedChoices: []string{},
+		Autogenerated:  true,
+		ConvertedName:  "RefreshToken",
+		Description: `The next refresh token, issued when the input A3S token is a refresh token.
+A refresh token can only be used once, and using it again revokes all the
+refresh tokens of its family and all the tokens issued from them.`,
+		Exposed:  true,
+		Name:     "refreshToken",
+		ReadOnly: true,
+		Type:     "string",
+	},
 	"restrictednamespace": {
 		AllowedChoices: []string{},
 		ConvertedName:  "RestrictedNamespace",
@@ -1083,7 +1431,7 @@ engine has no effect and may end up making the token unusable.`,
 		Type:           "string",
 	},
 	"sourcetype": {
-		AllowedChoices: []string{"A3S", "AWS", "Azure", "GCP", "HTTP", "LDAP", "MTLS", "OIDC", "RemoteA3S", "SAML"},
+		AllowedChoices: []string{"A3S", "AWS", "Azure", "GCP", "HTTP", "JWT", "Kubernetes", "LDAP", "Local", "MFA", "MTLS", "OIDC", "RemoteA3S", "SAML", "SPIFFE", "TokenExchange"},
 		ConvertedName:  "SourceType",
 		Description: `The authentication source. This will define how to verify
 credentials from internal or external source of authentication.`,
@@ -1103,13 +1451,14 @@ credentials from internal or external source of authentication.`,
 		Type:           "string",
 	},
 	"tokentype": {
-		AllowedChoices: []string{"Identity", "Refresh"},
+		AllowedChoices: []string{"Identity", "Refresh", "Reference"},
 		ConvertedName:  "TokenType",
 		DefaultValue:   IssueTokenTypeIdentity,
-		Description:    `The type of token to issue.`,
-		Exposed:        true,
-		Name:           "tokenType",
-		Type:           "enum",
+		Description: `The type of token to issue. A Reference token is a short opaque token
+referring to an identity token stored by a3s.`,
+		Exposed: true,
+		Name:    "tokenType",
+		Type:    "enum",
 	},
 	"validity": {
 		AllowedChoices: []string{},
@@ -1188,6 +1537,12 @@ type SparseIssue struct {
 	// Requested audience for the delivered token.
 	Audience *[]string `json:"audience,omitempty" msgpack:"audience,omitempty" bson:"-" mapstructure:"audience,omitempty"`
 
+	// If set, the delivered token will be bound to the client certificate used to
+	// authenticate, as described by RFC 8705. A bound token can only be used by a
+	// client presenting the same certificate. This is only supported by the MTLS
+	// source type.
+	CertificateBound *bool `json:"certificateBound,omitempty" msgpack:"certificateBound,omitempty" bson:"-" mapstructure:"certificateBound,omitempty"`
+
 	// Sets a list of identity claim prefix to allow in the final token. This can be
 	// used to hide some information when asking for a token as not all systems need to
 	// know all of the claims.
@@ -1214,18 +1569,44 @@ type SparseIssue struct {
 	// Contains additional information for an HTTP source.
 	InputHTTP *IssueHTTP `json:"inputHTTP,omitempty" msgpack:"inputHTTP,omitempty" bson:"-" mapstructure:"inputHTTP,omitempty"`
 
+	// Contains additional information for a JWT source.
+	InputJWT *IssueJWT `json:"inputJWT,omitempty" msgpack:"inputJWT,omitempty" bson:"-" mapstructure:"inputJWT,omitempty"`
+
+	// Contains additional information for a Kubernetes service account token source.
+	InputKubernetes *IssueKubernetes `json:"inputKubernetes,omitempty" msgpack:"inputKubernetes,omitempty" bson:"-" mapstructure:"inputKubernetes,omitempty"`
+
 	// Contains additional information for an LDAP source.
 	InputLDAP *IssueLDAP `json:"inputLDAP,omitempty" msgpack:"inputLDAP,omitempty" bson:"-" mapstructure:"inputLDAP,omitempty"`
 
+	// Contains additional information for a local source.
+	InputLocal *IssueLocal `json:"inputLocal,omitempty" msgpack:"inputLocal,omitempty" bson:"-" mapstructure:"inputLocal,omitempty"`
+
+	// Contains additional information to upgrade a token using a second factor.
+	InputMFA *IssueMFA `json:"inputMFA,omitempty" msgpack:"inputMFA,omitempty" bson:"-" mapstructure:"inputMFA,omitempty"`
+
 	// Contains additional information for an OIDC source.
 	InputOIDC *IssueOIDC `json:"inputOIDC,omitempty" msgpack:"inputOIDC,omitempty" bson:"-" mapstructure:"inputOIDC,omitempty"`
 
 	// Contains additional information for a remote A3S token source.
 	InputRemoteA3S *IssueRemoteA3S `json:"inputRemoteA3S,omitempty" msgpack:"inputRemoteA3S,omitempty" bson:"-" mapstructure:"inputRemoteA3S,omitempty"`
 
+	// Contains additional information for a SAML source.
+	InputSAML *IssueSAML `json:"inputSAML,omitempty" msgpack:"inputSAML,omitempty" bson:"-" mapstructure:"inputSAML,omitempty"`
+
+	// Contains additional information for a SPIFFE source.
+	InputSPIFFE *IssueSPIFFE `json:"inputSPIFFE,omitempty" msgpack:"inputSPIFFE,omitempty" bson:"-" mapstructure:"inputSPIFFE,omitempty"`
+
+	// Contains additional information for a token exchange.
+	InputTokenExchange *IssueTokenExchange `json:"inputTokenExchange,omitempty" msgpack:"inputTokenExchange,omitempty" bson:"-" mapstructure:"inputTokenExchange,omitempty"`
+
 	// Opaque data that will be included in the issued token.
 	Opaque *map[string]string `json:"opaque,omitempty" msgpack:"opaque,omitempty" bson:"-" mapstructure:"opaque,omitempty"`
 
+	// The next refresh token, issued when the input A3S token is a refresh token.
+	// A refresh token can only be used once, and using it again revokes all the
+	// refresh tokens of its family and all the tokens issued from them.
+	RefreshToken *string `json:"refreshToken,omitempty" msgpack:"refreshToken,omitempty" bson:"-" mapstructure:"refreshToken,omitempty"`
+
 	// Restricts the namespace where the token can be used.
 	//
 	// For instance, if you have have access to `/namespace` and below, you can
@@ -1273,7 +1654,8 @@ type SparseIssue struct {
 	// Issued token.
 	Token *string `json:"token,omitempty" msgpack:"token,omitempty" bson:"-" mapstructure:"token,omitempty"`
 
-	// The type of token to issue.
+	// The type of token to issue. A Reference token is a short opaque token
+	// referring to an identity token stored by a3s.
 	TokenType *IssueTokenTypeValue `json:"tokenType,omitempty" msgpack:"tokenType,omitempty" bson:"-" mapstructure:"tokenType,omitempty"`
 
 	// Configures the maximum length of validity for a token, using
@@ -1347,6 +1729,9 @@ func (o *SparseIssue) ToPlain() elemental.PlainIdentifiable {
 	if o.Audience != nil {
 		out.Audience = *o.Audience
 	}
+	if o.CertificateBound != nil {
+		out.CertificateBound = *o.CertificateBound
+	}
 	if o.Cloak != nil {
 		out.Cloak = *o.Cloak
 	}
@@ -1371,18 +1756,42 @@ func (o *SparseIssue) ToPlain() elemental.PlainIdentifiable {
 	if o.InputHTTP != nil {
 		out.InputHTTP = o.InputHTTP
 	}
+	if o.InputJWT != nil {
+		out.InputJWT = o.InputJWT
+	}
+	if o.InputKubernetes != nil {
+		out.InputKubernetes = o.InputKubernetes
+	}
 	if o.InputLDAP != nil {
 		out.InputLDAP = o.InputLDAP
 	}
+	if o.InputLocal != nil {
+		out.InputLocal = o.InputLocal
+	}
+	if o.InputMFA != nil {
+		out.InputMFA = o.InputMFA
+	}
 	if o.InputOIDC != nil {
 		out.InputOIDC = o.InputOIDC
 	}
 	if o.InputRemoteA3S != nil {
 		out.InputRemoteA3S = o.InputRemoteA3S
 	}
+	if o.InputSAML != nil {
+		out.InputSAML = o.InputSAML
+	}
+	if o.InputSPIFFE != nil {
+		out.InputSPIFFE = o.InputSPIFFE
+	}
+	if o.InputTokenExchange != nil {
+		out.InputTokenExchange = o.InputTokenExchange
+	}
 	if o.Opaque != nil {
 		out.Opaque = *o.Opaque
 	}
+	if o.RefreshToken != nil {
+		out.RefreshToken = *o.RefreshToken
+	}
 	if o.RestrictedNamespace != nil {
 		out.RestrictedNamespace = *o.RestrictedNamespace
 	}
@@ -16,9 +16,22 @@ type IssueOIDC struct {
 	// Contains the auth URL is noAuthRedirect is set to true.
 	AuthURL string `json:"authURL,omitempty" msgpack:"authURL,omitempty" bson:"-" mapstructure:"authURL,omitempty"`
 
+	// Set by the server when the user has not yet approved the device
+	// authorization request. The request must be sent again after the interval.
+	AuthorizationPending bool `json:"authorizationPending,omitempty" msgpack:"authorizationPending,omitempty" bson:"-" mapstructure:"authorizationPending,omitempty"`
+
 	// OIDC ceremony code.
 	Code string `json:"code" msgpack:"code" bson:"-" mapstructure:"code,omitempty"`
 
+	// If set, use the device authorization flow instead of the authorization code
+	// flow. This allows to authenticate from a device that cannot receive the
+	// redirection of the OIDC provider.
+	DeviceAuth bool `json:"deviceAuth" msgpack:"deviceAuth" bson:"-" mapstructure:"deviceAuth,omitempty"`
+
+	// Contains the minimum number of seconds to wait between two requests while
+	// the device authorization request is pending.
+	Interval int `json:"interval,omitempty" msgpack:"interval,omitempty" bson:"-" mapstructure:"interval,omitempty"`
+
 	// If set, instruct the server to return the OIDC auth url in authURL instead of
 	// performing an HTTP redirection.
 	NoAuthRedirect bool `json:"noAuthRedirect" msgpack:"noAuthRedirect" bson:"-" mapstructure:"noAuthRedirect,omitempty"`
@@ -32,6 +45,18 @@ type IssueOIDC struct {
 	// OIDC ceremony state.
 	State string `json:"state" msgpack:"state" bson:"-" mapstructure:"state,omitempty"`
 
+	// Contains the code the user must enter at the verification URI during the
+	// device authorization flow.
+	UserCode string `json:"userCode,omitempty" msgpack:"userCode,omitempty" bson:"-" mapstructure:"userCode,omitempty"`
+
+	// Contains the URI where the user must enter the user code during the device
+	// authorization flow.
+	VerificationURI string `json:"verificationURI,omitempty" msgpack:"verificationURI,omitempty" bson:"-" mapstructure:"verificationURI,omitempty"`
+
+	// Contains the verification URI including the user code, if the OIDC provider
+	// supports it.
+	VerificationURIComplete string `json:"verificationURIComplete,omitempty" msgpack:"verificationURIComplete,omitempty" bson:"-" mapstructure:"verificationURIComplete,omitempty"`
+
 	ModelVersion int `json:"-" msgpack:"-" bson:"_modelversion"`
 }
 
@@ -144,8 +169,14 @@ func (o *IssueOIDC) ValueForAttribute(name string) any {
 	switch name {
 	case "authURL":
 		return o.AuthURL
+	case "authorizationPending":
+		return o.AuthorizationPending
 	case "code":
 		return o.Code
+	case "deviceAuth":
+		return o.DeviceAuth
+	case "interval":
+		return o.Interval
 	case "noAuthRedirect":
 		return o.NoAuthRedirect
 	case "redirectErrorURL":
@@ -154,6 +185,12 @@ func (o *IssueOIDC) ValueForAttribute(name string) any {
 		return o.RedirectURL
 	case "state":
 		return o.State
+	case "userCode":
+		return o.UserCode
+	case "verificationURI":
+		return o.VerificationURI
+	case "verificationURIComplete":
+		return o.VerificationURIComplete
 	}
 
 	return nil
@@ -170,6 +207,16 @@ var IssueOIDCAttributesMap = map[string]elemental.AttributeSpecification{
 		ReadOnly:       true,
 		Type:           "string",
 	},
+	"AuthorizationPending": {
+		AllowedChoices: []string{},
+		ConvertedName:  "AuthorizationPending",
+		Description: `Set by the server when the user has not yet approved the device
+authorization request. The request must be sent again after the interval.`,
+		Exposed:  true,
+		Name:     "authorizationPending",
+		ReadOnly: true,
+		Type:     "boolean",
+	},
 	"Code": {
 		AllowedChoices: []string{},
 		ConvertedName:  "Code",
@@ -178,6 +225,26 @@ var IssueOIDCAttributesMap = map[string]elemental.AttributeSpecification{
 		Name:           "code",
 		Type:           "string",
 	},
+	"DeviceAuth": {
+		AllowedChoices: []string{},
+		ConvertedName:  "DeviceAuth",
+		Description: `If set, use the device authorization flow instead of the authorization code
+flow. This allows to authenticate from a device that cannot receive the
+redirection of the OIDC provider.`,
+		Exposed: true,
+		Name:    "deviceAuth",
+		Type:    "boolean",
+	},
+	"Interval": {
+		AllowedChoices: []string{},
+		ConvertedName:  "Interval",
+		Description: `Contains the minimum number of seconds to wait between two requests while
+the device authorization request is pending.`,
+		Exposed:  true,
+		Name:     "interval",
+		ReadOnly: true,
+		Type:     "integer",
+	},
 	"NoAuthRedirect": {
 		AllowedChoices: []string{},
 		ConvertedName:  "NoAuthRedirect",
@@ -211,6 +278,36 @@ performing an HTTP redirection.`,
 		Name:           "state",
 		Type:           "string",
 	},
+	"UserCode": {
+		AllowedChoices: []string{},
+		ConvertedName:  "UserCode",
+		Description: `Contains the code the user must enter at the verification URI during the
+device authorization flow.`,
+		Exposed:  true,
+		Name:     "userCode",
+		ReadOnly: true,
+		Type:     "string",
+	},
+	"VerificationURI": {
+		AllowedChoices: []string{},
+		ConvertedName:  "VerificationURI",
+		Description: `Contains the URI where the user must enter the user code during the device
+authorization flow.`,
+		Exposed:  true,
+		Name:     "verificationURI",
+		ReadOnly: true,
+		Type:     "string",
+	},
+	"VerificationURIComplete": {
+		AllowedChoices: []string{},
+		ConvertedName:  "VerificationURIComplete",
+		Description: `Contains the verification URI including the user code, if the OIDC provider
+supports it.`,
+		Exposed:  true,
+		Name:     "verificationURIComplete",
+		ReadOnly: true,
+		Type:     "string",
+	},
 }
 
 // IssueOIDCLowerCaseAttributesMap represents the map of attribute for IssueOIDC.
@@ -224,6 +321,16 @@ var IssueOIDCLowerCaseAttributesMap = map[string]elemental.AttributeSpecificatio
 		ReadOnly:       true,
 		Type:           "string",
 	},
+	"authorizationpending": {
+		AllowedChoices: []string{},
+		ConvertedName:  "AuthorizationPending",
+		Description: `Set by the server when the user has not yet approved the device
+authorization request. The request must be sent again after the interval.`,
+		Exposed:  true,
+		Name:     "authorizationPending",
+		ReadOnly: true,
+		Type:     "boolean",
+	},
 	"code": {
 		AllowedChoices: []string{},
 		ConvertedName:  "Code",
@@ -232,6 +339,26 @@ var IssueOIDCLowerCaseAttributesMap = map[string]elemental.AttributeSpecificatio
 		Name:           "code",
 		Type:           "string",
 	},
+	"deviceauth": {
+		AllowedChoices: []string{},
+		ConvertedName:  "DeviceAuth",
+		Description: `If set, use the device authorization flow instead of the authorization code
+flow. This allows to authenticate from a device that cannot receive the
+redirection of the OIDC provider.`,
+		Exposed: true,
+		Name:    "deviceAuth",
+		Type:    "boolean",
+	},
+	"interval": {
+		AllowedChoices: []string{},
+		ConvertedName:  "Interval",
+		Description: `Contains the minimum number of seconds to wait between two requests while
+the device authorization request is pending.`,
+		Exposed:  true,
+		Name:     "interval",
+		ReadOnly: true,
+		Type:     "integer",
+	},
 	"noauthredirect": {
 		AllowedChoices: []string{},
 		ConvertedName:  "NoAuthRedirect",
@@ -265,6 +392,36 @@ performing an HTTP redirection.`,
 		Name:           "state",
 		Type:           "string",
 	},
+	"usercode": {
+		AllowedChoices: []string{},
+		ConvertedName:  "UserCode",
+		Description: `Contains the code the user must enter at the verification URI during the
+device authorization flow.`,
+		Exposed:  true,
+		Name:     "userCode",
+		ReadOnly: true,
+		Type:     "string",
+	},
+	"verificationuri": {
+		AllowedChoices: []string{},
+		ConvertedName:  "VerificationURI",
+		Description: `Contains the URI where the user must enter the user code during the device
+authorization flow.`,
+		Exposed:  true,
+		Name:     "verificationURI",
+		ReadOnly: true,
+		Type:     "string",
+	},
+	"verificationuricomplete": {
+		AllowedChoices: []string{},
+		ConvertedName:  "VerificationURIComplete",
+		Description: `Contains the verification URI including the user code, if the OIDC provider
+supports it.`,
+		Exposed:  true,
+		Name:     "verificationURIComplete",
+		ReadOnly: true,
+		Type:     "string",
+	},
 }
 
 type mongoAttributesIssueOIDC struct {
@@ -130,6 +130,26 @@ type LDAPSource struct {
 	// The description of the object.
 	Description string `json:"description" msgpack:"description" bson:"description" mapstructure:"description,omitempty"`
 
+	// Additional IP addresses or FQDNs of LDAP servers. They are tried in order
+	// when the server at `address` cannot be reached.
+	FailoverAddresses []string `json:"failoverAddresses,omitempty" msgpack:"failoverAddresses,omitempty" bson:"failoveraddresses,omitempty" mapstructure:"failoverAddresses,omitempty"`
+
+	// The base distinguished name (DN) to use to search the groups of the user. If
+	// empty, the groups are not retrieved. Each group adds a `group=<cn>` claim.
+	GroupBaseDN string `json:"groupBaseDN,omitempty" msgpack:"groupBaseDN,omitempty" bson:"groupbasedn,omitempty" mapstructure:"groupBaseDN,omitempty"`
+
+	// The attribute of the group entries containing the DN of their members. For
+	// `groupOfUniqueNames` entries, the value should be `uniqueMember`.
+	GroupMemberAttribute string `json:"groupMemberAttribute" msgpack:"groupMemberAttribute" bson:"groupmemberattribute" mapstructure:"groupMemberAttribute,omitempty"`
+
+	// The maximum depth of nested groups to resolve. If 0, only the groups the user
+	// is a direct member of are retrieved.
+	GroupNestingDepth int `json:"groupNestingDepth" msgpack:"groupNestingDepth" bson:"groupnestingdepth" mapstructure:"groupNestingDepth,omitempty"`
+
+	// The filter to use to locate the group entries. The entries must also have the
+	// DN of the member in their `groupMemberAttribute`.
+	GroupSearchFilter string `json:"groupSearchFilter" msgpack:"groupSearchFilter" bson:"groupsearchfilter" mapstructure:"groupSearchFilter,omitempty"`
+
 	// A list of keys that must not be imported into the identity token. If
 	// `includedKeys` is also set, and a key is in both lists, the key will be ignored.
 	IgnoredKeys []string `json:"ignoredKeys,omitempty" msgpack:"ignoredKeys,omitempty" bson:"ignoredkeys,omitempty" mapstructure:"ignoredKeys,omitempty"`
@@ -174,11 +194,14 @@ type LDAPSource struct {
 func NewLDAPSource() *LDAPSource {
 
 	return &LDAPSource{
-		ModelVersion:     1,
-		BindSearchFilter: "uid={USERNAME}",
-		IgnoredKeys:      []string{},
-		IncludedKeys:     []string{},
-		SecurityProtocol: LDAPSourceSecurityProtocolTLS,
+		ModelVersion:         1,
+		BindSearchFilter:     "uid={USERNAME}",
+		FailoverAddresses:    []string{},
+		GroupMemberAttribute: "member",
+		GroupSearchFilter:    "objectClass=groupOfNames",
+		IgnoredKeys:          []string{},
+		IncludedKeys:         []string{},
+		SecurityProtocol:     LDAPSourceSecurityProtocolTLS,
 	}
 }
 
@@ -225,6 +248,11 @@ func (o *LDAPSource) MarshalBSON() ([]byte, error) {
 	s.BindSearchFilter = o.BindSearchFilter
 	s.CreateTime = o.CreateTime
 	s.Description = o.Description
+	s.FailoverAddresses = o.FailoverAddresses
+	s.GroupBaseDN = o.GroupBaseDN
+	s.GroupMemberAttribute = o.GroupMemberAttribute
+	s.GroupNestingDepth = o.GroupNestingDepth
+	s.GroupSearchFilter = o.GroupSearchFilter
 	s.IgnoredKeys = o.IgnoredKeys
 	s.ImportHash = o.ImportHash
 	s.ImportLabel = o.ImportLabel
@@ -262,6 +290,11 @@ func (o *LDAPSource) UnmarshalBSON(raw []byte) error {
 	o.BindSearchFilter = s.BindSearchFilter
 	o.CreateTime = s.CreateTime
 	o.Description = s.Description
+	o.FailoverAddresses = s.FailoverAddresses
+	o.GroupBaseDN = s.GroupBaseDN
+	o.GroupMemberAttribute = s.GroupMemberAttribute
+	o.GroupNestingDepth = s.GroupNestingDepth
+	o.GroupSearchFilter = s.GroupSearchFilter
 	o.IgnoredKeys = s.IgnoredKeys
 	o.ImportHash = s.ImportHash
 	o.ImportLabel = s.ImportLabel
@@ -409,26 +442,31 @@ func (o *LDAPSource) ToSparse(fields ...string) elemental.SparseIdentifiable {
 	if len(fields) == 0 {
 		// nolint: goimports
 		return &SparseLDAPSource{
-			CA:               &o.CA,
-			ID:               &o.ID,
-			Address:          &o.Address,
-			BaseDN:           &o.BaseDN,
-			BindDN:           &o.BindDN,
-			BindPassword:     &o.BindPassword,
-			BindSearchFilter: &o.BindSearchFilter,
-			CreateTime:       &o.CreateTime,
-			Description:      &o.Description,
-			IgnoredKeys:      &o.IgnoredKeys,
-			ImportHash:       &o.ImportHash,
-			ImportLabel:      &o.ImportLabel,
-			IncludedKeys:     &o.IncludedKeys,
-			Modifier:         o.Modifier,
-			Name:             &o.Name,
-			Namespace:        &o.Namespace,
-			SecurityProtocol: &o.SecurityProtocol,
-			UpdateTime:       &o.UpdateTime,
-			ZHash:            &o.ZHash,
-			Zone:             &o.Zone,
+			CA:                   &o.CA,
+			ID:                   &o.ID,
+			Address:              &o.Address,
+			BaseDN:               &o.BaseDN,
+			BindDN:               &o.BindDN,
+			BindPassword:         &o.BindPassword,
+			BindSearchFilter:     &o.BindSearchFilter,
+			CreateTime:           &o.CreateTime,
+			Description:          &o.Description,
+			FailoverAddresses:    &o.FailoverAddresses,
+			GroupBaseDN:          &o.GroupBaseDN,
+			GroupMemberAttribute: &o.GroupMemberAttribute,
+			GroupNestingDepth:    &o.GroupNestingDepth,
+			GroupSearchFilter:    &o.GroupSearchFilter,
+			IgnoredKeys:          &o.IgnoredKeys,
+			ImportHash:           &o.ImportHash,
+			ImportLabel:          &o.ImportLabel,
+			IncludedKeys:         &o.IncludedKeys,
+			Modifier:             o.Modifier,
+			Name:                 &o.Name,
+			Namespace:            &o.Namespace,
+			SecurityProtocol:     &o.SecurityProtocol,
+			UpdateTime:           &o.UpdateTime,
+			ZHash:                &o.ZHash,
+			Zone:                 &o.Zone,
 		}
 	}
 
@@ -453,6 +491,16 @@ func (o *LDAPSource) ToSparse(fields ...string) elemental.SparseIdentifiable {
 			sp.CreateTime = &(o.CreateTime)
 		case "description":
 			sp.Description = &(o.Description)
+		case "failoverAddresses":
+			sp.FailoverAddresses = &(o.FailoverAddresses)
+		case "groupBaseDN":
+			sp.GroupBaseDN = &(o.GroupBaseDN)
+		case "groupMemberAttribute":
+			sp.GroupMemberAttribute = &(o.GroupMemberAttribute)
+		case "groupNestingDepth":
+			sp.GroupNestingDepth = &(o.GroupNestingDepth)
+		case "groupSearchFilter":
+			sp.GroupSearchFilter = &(o.GroupSearchFilter)
 		case "ignoredKeys":
 			sp.IgnoredKeys = &(o.IgnoredKeys)
 		case "importHash":
@@ -535,6 +583,21 @@ func (o *LDAPSource) Patch(sparse elemental.SparseIdentifiable) {
 	if so.Description != nil {
 		o.Description = *so.Description
 	}
+	if so.FailoverAddresses != nil {
+		o.FailoverAddresses = *so.FailoverAddresses
+	}
+	if so.GroupBaseDN != nil {
+		o.GroupBaseDN = *so.GroupBaseDN
+	}
+	if so.GroupMemberAttribute != nil {
+		o.GroupMemberAttribute = *so.GroupMemberAttribute
+	}
+	if so.GroupNestingDepth != nil {
+		o.GroupNestingDepth = *so.GroupNestingDepth
+	}
+	if so.GroupSearchFilter != nil {
+		o.GroupSearchFilter = *so.GroupSearchFilter
+	}
 	if so.IgnoredKeys != nil {
 		o.IgnoredKeys = *so.IgnoredKeys
 	}
@@ -612,6 +675,14 @@ func (o *LDAPSource) Validate() error {
 		requiredErrors = requiredErrors.Append(err)
 	}
 
+	if err := elemental.ValidateMinimumInt("groupNestingDepth", o.GroupNestingDepth, 0, false); err != nil {
+		errors = errors.Append(err)
+	}
+
+	if err := elemental.ValidateMaximumInt("groupNestingDepth", o.GroupNestingDepth, 10, false); err != nil {
+		errors = errors.Append(err)
+	}
+
 	if o.Modifier != nil {
 		elemental.ResetDefaultForZeroValues(o.Modifier)
 		if err := o.Modifier.Validate(); err != nil {
@@ -679,6 +750,16 @@ func (o *LDAPSource) ValueForAttribute(name string) any {
 		return o.CreateTime
 	case "description":
 		return o.Description
+	case "failoverAddresses":
+		return o.FailoverAddresses
+	case "groupBaseDN":
+		return o.GroupBaseDN
+	case "groupMemberAttribute":
+		return o.GroupMemberAttribute
+	case "groupNestingDepth":
+		return o.GroupNestingDepth
+	case "groupSearchFilter":
+		return o.GroupSearchFilter
 	case "ignoredKeys":
 		return o.IgnoredKeys
 	case "importHash":
@@ -822,6 +903,66 @@ systems, the value may be ` + "`" + `uid={USERNAME}` + "`" + `.`,
 		Stored:         true,
 		Type:           "string",
 	},
+	"FailoverAddresses": {
+		AllowedChoices: []string{},
+		BSONFieldName:  "failoveraddresses",
+		ConvertedName:  "FailoverAddresses",
+		Description: `Additional IP addresses or FQDNs of LDAP servers. They are tried in order
+when the server at ` + "`" + `address` + "`" + ` cannot be reached.`,
+		Exposed: true,
+		Name:    "failoverAddresses",
+		Stored:  true,
+		SubType: "string",
+		Type:    "list",
+	},
+	"GroupBaseDN": {
+		AllowedChoices: []string{},
+		BSONFieldName:  "groupbasedn",
+		ConvertedName:  "GroupBaseDN",
+		Description: `The base distinguished name (DN) to use to search the groups of the user. If
+empty, the groups are not retrieved. Each group adds a ` + "`" + `group=<cn>` + "`" + ` claim.`,
+		Exposed: true,
+		Name:    "groupBaseDN",
+		Stored:  true,
+		Type:    "string",
+	},
+	"GroupMemberAttribute": {
+		AllowedChoices: []string{},
+		BSONFieldName:  "groupmemberattribute",
+		ConvertedName:  "GroupMemberAttribute",
+		DefaultValue:   "member",
+		Description: `The attribute of the group entries containing the DN of their members. For
+` + "`" + `groupOfUniqueNames` + "`" + ` entries, the value should be ` + "`" + `uniqueMember` + "`" + `.`,
+		Exposed: true,
+		Name:    "groupMemberAttribute",
+		Stored:  true,
+		Type:    "string",
+	},
+	"GroupNestingDepth": {
+		AllowedChoices: []string{},
+		BSONFieldName:  "groupnestingdepth",
+		ConvertedName:  "GroupNestingDepth",
+		Description: `The maximum depth of nested groups to resolve. If 0, only the groups the user
+is a direct member of are retrieved.`,
+		Exposed:  true,
+		MaxValue: 10,
+		MinValue: 0,
+		Name:     "groupNestingDepth",
+		Stored:   true,
+		Type:     "integer",
+	},
+	"GroupSearchFilter": {
+		AllowedChoices: []string{},
+		BSONFieldName:  "groupsearchfilter",
+		ConvertedName:  "GroupSearchFilter",
+		DefaultValue:   "objectClass=groupOfNames",
+		Description: `The filter to use to locate the group entries. The entries must also have the
+DN of the member in their ` + "`" + `groupMemberAttribute` + "`" + `.`,
+		Exposed: true,
+		Name:    "groupSearchFilter",
+		Stored:  true,
+		Type:    "string",
+	},
 	"IgnoredKeys": {
 		AllowedChoices: []string{},
 		BSONFieldName:  "ignoredkeys",
@@ -1083,6 +1224,66 @@ systems, the value may be ` + "`" + `uid={USERNAME}` + "`" + `.`,
 		Stored:         true,
 		Type:           "string",
 	},
+	"failoveraddresses": {
+		AllowedChoices: []string{},
+		BSONFieldName:  "failoveraddresses",
+		ConvertedName:  "FailoverAddresses",
+		Description: `Additional IP addresses or FQDNs of LDAP servers. They are tried in order
+when the server at ` + "`" + `address` + "`" + ` cannot be reached.`,
+		Exposed: true,
+		Name:    "failoverAddresses",
+		Stored:  true,
+		SubType: "string",
+		Type:    "list",
+	},
+	"groupbasedn": {
+		AllowedChoices: []string{},
+		BSONFieldName:  "groupbasedn",
+		ConvertedName:  "GroupBaseDN",
+		Description: `The base distinguished name (DN) to use to search the groups of the user. If
+empty, the groups are not retrieved. Each group adds a ` + "`" + `group=<cn>` + "`" + ` claim.`,
+		Exposed: true,
+		Name:    "groupBaseDN",
+		Stored:  true,
+		Type:    "string",
+	},
+	"groupmemberattribute": {
+		AllowedChoices: []string{},
+		BSONFieldName:  "groupmemberattribute",
+		ConvertedName:  "GroupMemberAttribute",
+		DefaultValue:   "member",
+		Description: `The attribute of the group entries containing the DN of their members. For
+` + "`" + `groupOfUniqueNames` + "`" + ` entries, the value should be ` + "`" + `uniqueMember` + "`" + `.`,
+		Exposed: true,
+		Name:    "groupMemberAttribute",
+		Stored:  true,
+		Type:    "string",
+	},
+	"groupnestingdepth": {
+		AllowedChoices: []string{},
+		BSONFieldName:  "groupnestingdepth",
+		ConvertedName:  "GroupNestingDepth",
+		Description: `The maximum depth of nested groups to resolve. If 0, only the groups the user
+is a direct member of are retrieved.`,
+		Exposed:  true,
+		MaxValue: 10,
+		MinValue: 0,
+		Name:     "groupNestingDepth",
+		Stored:   true,
+		Type:     "integer",
+	},
+	"groupsearchfilter": {
+		AllowedChoices: []string{},
+		BSONFieldName:  "groupsearchfilter",
+		ConvertedName:  "GroupSearchFilter",
+		DefaultValue:   "objectClass=groupOfNames",
+		Description: `The filter to use to locate the group entries. The entries must also have the
+DN of the member in their ` + "`" + `groupMemberAttribute` + "`" + `.`,
+		Exposed: true,
+		Name:    "groupSearchFilter",
+		Stored:  true,
+		Type:    "string",
+	},
 	"ignoredkeys": {
 		AllowedChoices: []string{},
 		BSONFieldName:  "ignoredkeys",
@@ -1322,6 +1523,26 @@ type SparseLDAPSource struct {
 	// The description of the object.
 	Description *string `json:"description,omitempty" msgpack:"description,omitempty" bson:"description,omitempty" mapstructure:"description,omitempty"`
 
+	// Additional IP addresses or FQDNs of LDAP servers. They are tried in order
+	// when the server at `address` cannot be reached.
+	FailoverAddresses *[]string `json:"failoverAddresses,omitempty" msgpack:"failoverAddresses,omitempty" bson:"failoveraddresses,omitempty" mapstructure:"failoverAddresses,omitempty"`
+
+	// The base distinguished name (DN) to use to search the groups of the user. If
+	// empty, the groups are not retrieved. Each group adds a `group=<cn>` claim.
+	GroupBaseDN *string `json:"groupBaseDN,omitempty" msgpack:"groupBaseDN,omitempty" bson:"groupbasedn,omitempty" mapstructure:"groupBaseDN,omitempty"`
+
+	// The attribute of the group entries containing the DN of their members. For
+	// `groupOfUniqueNames` entries, the value should be `uniqueMember`.
+	GroupMemberAttribute *string `json:"groupMemberAttribute,omitempty" msgpack:"groupMemberAttribute,omitempty" bson:"groupmemberattribute,omitempty" mapstructure:"groupMemberAttribute,omitempty"`
+
+	// The maximum depth of nested groups to resolve. If 0, only the groups the user
+	// is a direct member of are retrieved.
+	GroupNestingDepth *int `json:"groupNestingDepth,omitempty" msgpack:"groupNestingDepth,omitempty" bson:"groupnestingdepth,omitempty" mapstructure:"groupNestingDepth,omitempty"`
+
+	// The filter to use to locate the group entries. The entries must also have the
+	// DN of the member in their `groupMemberAttribute`.
+	GroupSearchFilter *string `json:"groupSearchFilter,omitempty" msgpack:"groupSearchFilter,omitempty" bson:"groupsearchfilter,omitempty" mapstructure:"groupSearchFilter,omitempty"`
+
 	// A list of keys that must not be imported into the identity token. If
 	// `includedKeys` is also set, and a key is in both lists, the key will be ignored.
 	IgnoredKeys *[]string `json:"ignoredKeys,omitempty" msgpack:"ignoredKeys,omitempty" bson:"ignoredkeys,omitempty" mapstructure:"ignoredKeys,omitempty"`
@@ -1433,6 +1654,21 @@ func (o *SparseLDAPSource) MarshalBSON() ([]byte, error) {
 	if o.Description != nil {
 		s.Description = o.Description
 	}
+	if o.FailoverAddresses != nil {
+		s.FailoverAddresses = o.FailoverAddresses
+	}
+	if o.GroupBaseDN != nil {
+		s.GroupBaseDN = o.GroupBaseDN
+	}
+	if o.GroupMemberAttribute != nil {
+		s.GroupMemberAttribute = o.GroupMemberAttribute
+	}
+	if o.GroupNestingDepth != nil {
+		s.GroupNestingDepth = o.GroupNestingDepth
+	}
+	if o.GroupSearchFilter != nil {
+		s.GroupSearchFilter = o.GroupSearchFilter
+	}
 	if o.IgnoredKeys != nil {
 		s.IgnoredKeys = o.IgnoredKeys
 	}
@@ -1509,6 +1745,21 @@ func (o *SparseLDAPSource) UnmarshalBSON(raw []byte) error {
 	if s.Description != nil {
 		o.Description = s.Description
 	}
+	if s.FailoverAddresses != nil {
+		o.FailoverAddresses = s.FailoverAddresses
+	}
+	if s.GroupBaseDN != nil {
+		o.GroupBaseDN = s.GroupBaseDN
+	}
+	if s.GroupMemberAttribute != nil {
+		o.GroupMemberAttribute = s.GroupMemberAttribute
+	}
+	if s.GroupNestingDepth != nil {
+		o.GroupNestingDepth = s.GroupNestingDepth
+	}
+	if s.GroupSearchFilter != nil {
+		o.GroupSearchFilter = s.GroupSearchFilter
+	}
 	if s.IgnoredKeys != nil {
 		o.IgnoredKeys = s.IgnoredKeys
 	}
@@ -1583,6 +1834,21 @@ func (o *SparseLDAPSource) ToPlain() elemental.PlainIdentifiable {
 	if o.Description != nil {
 		out.Description = *o.Description
 	}
+	if o.FailoverAddresses != nil {
+		out.FailoverAddresses = *o.FailoverAddresses
+	}
+	if o.GroupBaseDN != nil {
+		out.GroupBaseDN = *o.GroupBaseDN
+	}
+	if o.GroupMemberAttribute != nil {
+		out.GroupMemberAttribute = *o.GroupMemberAttribute
+	}
+	if o.GroupNestingDepth != nil {
+		out.GroupNestingDepth = *o.GroupNestingDepth
+	}
+	if o.GroupSearchFilter != nil {
+		out.GroupSearchFilter = *o.GroupSearchFilter
+	}
 	if o.IgnoredKeys != nil {
 		out.IgnoredKeys = *o.IgnoredKeys
 	}
@@ -1793,46 +2059,56 @@ func (o *SparseLDAPSource) DeepCopyInto(out *SparseLDAPSource) {
 }
 
 type mongoAttributesLDAPSource struct {
-	CA               string                          `bson:"ca,omitempty"`
-	ID               primitive.ObjectID              `bson:"_id,omitempty"`
-	Address          string                          `bson:"address"`
-	BaseDN           string                          `bson:"basedn"`
-	BindDN           string                          `bson:"binddn"`
-	BindPassword     string                          `bson:"bindpassword"`
-	BindSearchFilter string                          `bson:"bindsearchfilter"`
-	CreateTime       time.Time                       `bson:"createtime"`
-	Description      string                          `bson:"description"`
-	IgnoredKeys      []string                        `bson:"ignoredkeys,omitempty"`
-	ImportHash       string                          `bson:"importhash,omitempty"`
-	ImportLabel      string                          `bson:"importlabel,omitempty"`
-	IncludedKeys     []string                        `bson:"includedkeys,omitempty"`
-	Modifier         *IdentityModifier               `bson:"modifier,omitempty"`
-	Name             string                          `bson:"name"`
-	Namespace        string                          `bson:"namespace"`
-	SecurityProtocol LDAPSourceSecurityProtocolValue `bson:"securityprotocol"`
-	UpdateTime       time.Time                       `bson:"updatetime"`
-	ZHash            int                             `bson:"zhash"`
-	Zone             int                             `bson:"zone"`
+	CA                   string                          `bson:"ca,omitempty"`
+	ID                   primitive.ObjectID              `bson:"_id,omitempty"`
+	Address              string                          `bson:"address"`
+	BaseDN               string                          `bson:"basedn"`
+	BindDN               string                          `bson:"binddn"`
+	BindPassword         string                          `bson:"bindpassword"`
+	BindSearchFilter     string                          `bson:"bindsearchfilter"`
+	CreateTime           time.Time                       `bson:"createtime"`
+	Description          string                          `bson:"description"`
+	FailoverAddresses    []string                        `bson:"failoveraddresses,omitempty"`
+	GroupBaseDN          string                          `bson:"groupbasedn,omitempty"`
+	GroupMemberAttribute string                          `bson:"groupmemberattribute"`
+	GroupNestingDepth    int                             `bson:"groupnestingdepth"`
+	GroupSearchFilter    string                          `bson:"groupsearchfilter"`
+	IgnoredKeys          []string                        `bson:"ignoredkeys,omitempty"`
+	ImportHash           string                          `bson:"importhash,omitempty"`
+	ImportLabel          string                          `bson:"importlabel,omitempty"`
+	IncludedKeys         []string                        `bson:"includedkeys,omitempty"`
+	Modifier             *IdentityModifier               `bson:"modifier,omitempty"`
+	Name                 string                          `bson:"name"`
+	Namespace            string                          `bson:"namespace"`
+	SecurityProtocol     LDAPSourceSecurityProtocolValue `bson:"securityprotocol"`
+	UpdateTime           time.Time                       `bson:"updatetime"`
+	ZHash                int                             `bson:"zhash"`
+	Zone                 int                             `bson:"zone"`
 }
 type mongoAttributesSparseLDAPSource struct {
-	CA               *string                          `bson:"ca,omitempty"`
-	ID               primitive.ObjectID               `bson:"_id,omitempty"`
-	Address          *string                          `bson:"address,omitempty"`
-	BaseDN           *string                          `bson:"basedn,omitempty"`
-	BindDN           *string                          `bson:"binddn,omitempty"`
-	BindPassword     *string                          `bson:"bindpassword,omitempty"`
-	BindSearchFilter *string                          `bson:"bindsearchfilter,omitempty"`
-	CreateTime       *time.Time                       `bson:"createtime,omitempty"`
-	Description      *string                          `bson:"description,omitempty"`
-	IgnoredKeys      *[]string                        `bson:"ignoredkeys,omitempty"`
-	ImportHash       *string                          `bson:"importhash,omitempty"`
-	ImportLabel      *string                          `bson:"importlabel,omitempty"`
-	IncludedKeys     *[]string                        `bson:"includedkeys,omitempty"`
-	Modifier         *IdentityModifier                `bson:"modifier,omitempty"`
-	Name             *string                          `bson:"name,omitempty"`
-	Namespace        *string                          `bson:"namespace,omitempty"`
-	SecurityProtocol *LDAPSourceSecurityProtocolValue `bson:"securityprotocol,omitempty"`
-	UpdateTime       *time.Time                       `bson:"updatetime,omitempty"`
-	ZHash            *int                             `bson:"zhash,omitempty"`
-	Zone             *int                             `bson:"zone,omitempty"`
+	CA                   *string                          `bson:"ca,omitempty"`
+	ID                   primitive.ObjectID               `bson:"_id,omitempty"`
+	Address              *string                          `bson:"address,omitempty"`
+	BaseDN               *string                          `bson:"basedn,omitempty"`
+	BindDN               *string                          `bson:"binddn,omitempty"`
+	BindPassword         *string                          `bson:"bindpassword,omitempty"`
+	BindSearchFilter     *string                          `bson:"bindsearchfilter,omitempty"`
+	CreateTime           *time.Time                       `bson:"createtime,omitempty"`
+	Description          *string                          `bson:"description,omitempty"`
+	FailoverAddresses    *[]string                        `bson:"failoveraddresses,omitempty"`
+	GroupBaseDN          *string                          `bson:"groupbasedn,omitempty"`
+	GroupMemberAttribute *string                          `bson:"groupmemberattribute,omitempty"`
+	GroupNestingDepth    *int                             `bson:"groupnestingdepth,omitempty"`
+	GroupSearchFilter    *string                          `bson:"groupsearchfilter,omitempty"`
+	IgnoredKeys          *[]string                        `bson:"ignoredkeys,omitempty"`
+	ImportHash           *string                          `bson:"importhash,omitempty"`
+	ImportLabel          *string                          `bson:"importlabel,omitempty"`
+	IncludedKeys         *[]string                        `bson:"includedkeys,omitempty"`
+	Modifier             *IdentityModifier                `bson:"modifier,omitempty"`
+	Name                 *string                          `bson:"name,omitempty"`
+	Namespace            *string                          `bson:"namespace,omitempty"`
+	SecurityProtocol     *LDAPSourceSecurityProtocolValue `bson:"securityprotocol,omitempty"`
+	UpdateTime           *time.Time                       `bson:"updatetime,omitempty"`
+	ZHash                *int                             `bson:"zhash,omitempty"`
+	Zone                 *int                             `bson:"zone,omitempty"`
 }
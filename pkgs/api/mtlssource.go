@@ -88,15 +88,29 @@ type MTLSSource struct {
 	// The Certificate authority to use to validate user certificates in PEM format.
 	CA string `json:"CA" msgpack:"CA" bson:"ca" mapstructure:"CA,omitempty"`
 
+	// Optional certificate revocation lists in PEM format. User certificates that
+	// have been revoked by one of these lists will be rejected.
+	CRL string `json:"CRL" msgpack:"CRL" bson:"crl" mapstructure:"CRL,omitempty"`
+
 	// ID is the identifier of the object.
 	ID string `json:"ID" msgpack:"ID" bson:"-" mapstructure:"ID,omitempty"`
 
+	// If set, the OCSP responders listed in the user certificates will be queried
+	// and the certificates will be rejected if they have been revoked, or if their
+	// status cannot be determined.
+	CheckOCSP bool `json:"checkOCSP" msgpack:"checkOCSP" bson:"checkocsp" mapstructure:"checkOCSP,omitempty"`
+
 	// Creation date of the object.
 	CreateTime time.Time `json:"createTime" msgpack:"createTime" bson:"createtime" mapstructure:"createTime,omitempty"`
 
 	// The description of the object.
 	Description string `json:"description" msgpack:"description" bson:"description" mapstructure:"description,omitempty"`
 
+	// If set, the certificate revocation lists published at the distribution points
+	// listed in the user certificates will be retrieved and checked. They are cached
+	// until their next update.
+	FetchCRL bool `json:"fetchCRL" msgpack:"fetchCRL" bson:"fetchcrl" mapstructure:"fetchCRL,omitempty"`
+
 	// The fingerprint of the CAs in the chain.
 	Fingerprints []string `json:"fingerprints" msgpack:"fingerprints" bson:"fingerprints" mapstructure:"fingerprints,omitempty"`
 
@@ -120,6 +134,10 @@ type MTLSSource struct {
 	// Value of the CAs X.509 SubjectKeyIDs in the chain.
 	SubjectKeyIDs []string `json:"subjectKeyIDs" msgpack:"subjectKeyIDs" bson:"subjectkeyids" mapstructure:"subjectKeyIDs,omitempty"`
 
+	// If set, the user certificates must contain a SPIFFE ID in their URI SANs
+	// belonging to the given trust domain.
+	TrustDomain string `json:"trustDomain" msgpack:"trustDomain" bson:"trustdomain" mapstructure:"trustDomain,omitempty"`
+
 	// Last update date of the object.
 	UpdateTime time.Time `json:"updateTime" msgpack:"updateTime" bson:"updatetime" mapstructure:"updateTime,omitempty"`
 
@@ -171,6 +189,7 @@ func (o *MTLSSource) MarshalBSON() ([]byte, error) {
 	s := mongoAttributesMTLSSource{}
 
 	s.CA = o.CA
+	s.CRL = o.CRL
 	if o.ID != "" {
 		objectID, err := primitive.ObjectIDFromHex(o.ID)
 		if err != nil {
@@ -178,8 +197,10 @@ func (o *MTLSSource) MarshalBSON() ([]byte, error) {
 		}
 		s.ID = objectID
 	}
+	s.CheckOCSP = o.CheckOCSP
 	s.CreateTime = o.CreateTime
 	s.Description = o.Description
+	s.FetchCRL = o.FetchCRL
 	s.Fingerprints = o.Fingerprints
 	s.ImportHash = o.ImportHash
 	s.ImportLabel = o.ImportLabel
@@ -187,6 +208,7 @@ func (o *MTLSSource) MarshalBSON() ([]byte, error) {
 	s.Name = o.Name
 	s.Namespace = o.Namespace
 	s.SubjectKeyIDs = o.SubjectKeyIDs
+	s.TrustDomain = o.TrustDomain
 	s.UpdateTime = o.UpdateTime
 	s.ZHash = o.ZHash
 	s.Zone = o.Zone
@@ -208,9 +230,12 @@ func (o *MTLSSource) UnmarshalBSON(raw []byte) error {
 	}
 
 	o.CA = s.CA
+	o.CRL = s.CRL
 	o.ID = s.ID.Hex()
+	o.CheckOCSP = s.CheckOCSP
 	o.CreateTime = s.CreateTime
 	o.Description = s.Description
+	o.FetchCRL = s.FetchCRL
 	o.Fingerprints = s.Fingerprints
 	o.ImportHash = s.ImportHash
 	o.ImportLabel = s.ImportLabel
@@ -218,6 +243,7 @@ func (o *MTLSSource) UnmarshalBSON(raw []byte) error {
 	o.Name = s.Name
 	o.Namespace = s.Namespace
 	o.SubjectKeyIDs = s.SubjectKeyIDs
+	o.TrustDomain = s.TrustDomain
 	o.UpdateTime = s.UpdateTime
 	o.ZHash = s.ZHash
 	o.Zone = s.Zone
@@ -358,9 +384,12 @@ func (o *MTLSSource) ToSparse(fields ...string) elemental.SparseIdentifiable {
 		// nolint: goimports
 		return &SparseMTLSSource{
 			CA:            &o.CA,
+			CRL:           &o.CRL,
 			ID:            &o.ID,
+			CheckOCSP:     &o.CheckOCSP,
 			CreateTime:    &o.CreateTime,
 			Description:   &o.Description,
+			FetchCRL:      &o.FetchCRL,
 			Fingerprints:  &o.Fingerprints,
 			ImportHash:    &o.ImportHash,
 			ImportLabel:   &o.ImportLabel,
@@ -368,6 +397,7 @@ func (o *MTLSSource) ToSparse(fields ...string) elemental.SparseIdentifiable {
 			Name:          &o.Name,
 			Namespace:     &o.Namespace,
 			SubjectKeyIDs: &o.SubjectKeyIDs,
+			TrustDomain:   &o.TrustDomain,
 			UpdateTime:    &o.UpdateTime,
 			ZHash:         &o.ZHash,
 			Zone:          &o.Zone,
@@ -379,12 +409,18 @@ func (o *MTLSSource) ToSparse(fields ...string) elemental.SparseIdentifiable {
 		switch f {
 		case "CA":
 			sp.CA = &(o.CA)
+		case "CRL":
+			sp.CRL = &(o.CRL)
 		case "ID":
 			sp.ID = &(o.ID)
+		case "checkOCSP":
+			sp.CheckOCSP = &(o.CheckOCSP)
 		case "createTime":
 			sp.CreateTime = &(o.CreateTime)
 		case "description":
 			sp.Description = &(o.Description)
+		case "fetchCRL":
+			sp.FetchCRL = &(o.FetchCRL)
 		case "fingerprints":
 			sp.Fingerprints = &(o.Fingerprints)
 		case "importHash":
@@ -399,6 +435,8 @@ func (o *MTLSSource) ToSparse(fields ...string) elemental.SparseIdentifiable {
 			sp.Namespace = &(o.Namespace)
 		case "subjectKeyIDs":
 			sp.SubjectKeyIDs = &(o.SubjectKeyIDs)
+		case "trustDomain":
+			sp.TrustDomain = &(o.TrustDomain)
 		case "updateTime":
 			sp.UpdateTime = &(o.UpdateTime)
 		case "zHash":
@@ -421,15 +459,24 @@ func (o *MTLSSource) Patch(sparse elemental.SparseIdentifiable) {
 	if so.CA != nil {
 		o.CA = *so.CA
 	}
+	if so.CRL != nil {
+		o.CRL = *so.CRL
+	}
 	if so.ID != nil {
 		o.ID = *so.ID
 	}
+	if so.CheckOCSP != nil {
+		o.CheckOCSP = *so.CheckOCSP
+	}
 	if so.CreateTime != nil {
 		o.CreateTime = *so.CreateTime
 	}
 	if so.Description != nil {
 		o.Description = *so.Description
 	}
+	if so.FetchCRL != nil {
+		o.FetchCRL = *so.FetchCRL
+	}
 	if so.Fingerprints != nil {
 		o.Fingerprints = *so.Fingerprints
 	}
@@ -451,6 +498,9 @@ func (o *MTLSSource) Patch(sparse elemental.SparseIdentifiable) {
 	if so.SubjectKeyIDs != nil {
 		o.SubjectKeyIDs = *so.SubjectKeyIDs
 	}
+	if so.TrustDomain != nil {
+		o.TrustDomain = *so.TrustDomain
+	}
 	if so.UpdateTime != nil {
 		o.UpdateTime = *so.UpdateTime
 	}
@@ -500,6 +550,10 @@ func (o *MTLSSource) Validate() error {
 		errors = errors.Append(err)
 	}
 
+	if err := ValidateCRL("CRL", o.CRL); err != nil {
+		errors = errors.Append(err)
+	}
+
 	if o.Modifier != nil {
 		elemental.ResetDefaultForZeroValues(o.Modifier)
 		if err := o.Modifier.Validate(); err != nil {
@@ -511,6 +565,10 @@ func (o *MTLSSource) Validate() error {
 		requiredErrors = requiredErrors.Append(err)
 	}
 
+	if err := elemental.ValidatePattern("trustDomain", o.TrustDomain, `^[a-z0-9._-]+$`, `must only contain lower case letters, digits, '.', '-' or '_'`, false); err != nil {
+		errors = errors.Append(err)
+	}
+
 	if len(requiredErrors) > 0 {
 		return requiredErrors
 	}
@@ -547,12 +605,18 @@ func (o *MTLSSource) ValueForAttribute(name string) any {
 	switch name {
 	case "CA":
 		return o.CA
+	case "CRL":
+		return o.CRL
 	case "ID":
 		return o.ID
+	case "checkOCSP":
+		return o.CheckOCSP
 	case "createTime":
 		return o.CreateTime
 	case "description":
 		return o.Description
+	case "fetchCRL":
+		return o.FetchCRL
 	case "fingerprints":
 		return o.Fingerprints
 	case "importHash":
@@ -567,6 +631,8 @@ func (o *MTLSSource) ValueForAttribute(name string) any {
 		return o.Namespace
 	case "subjectKeyIDs":
 		return o.SubjectKeyIDs
+	case "trustDomain":
+		return o.TrustDomain
 	case "updateTime":
 		return o.UpdateTime
 	case "zHash":
@@ -591,6 +657,17 @@ var MTLSSourceAttributesMap = map[string]elemental.AttributeSpecification{
 		Stored:         true,
 		Type:           "string",
 	},
+	"CRL": {
+		AllowedChoices: []string{},
+		BSONFieldName:  "crl",
+		ConvertedName:  "CRL",
+		Description: `Optional certificate revocation lists in PEM format. User certificates that
+have been revoked by one of these lists will be rejected.`,
+		Exposed: true,
+		Name:    "CRL",
+		Stored:  true,
+		Type:    "string",
+	},
 	"ID": {
 		AllowedChoices: []string{},
 		Autogenerated:  true,
@@ -607,6 +684,18 @@ var MTLSSourceAttributesMap = map[string]elemental.AttributeSpecification{
 		Stored:         true,
 		Type:           "string",
 	},
+	"CheckOCSP": {
+		AllowedChoices: []string{},
+		BSONFieldName:  "checkocsp",
+		ConvertedName:  "CheckOCSP",
+		Description: `If set, the OCSP responders listed in the user certificates will be queried
+and the certificates will be rejected if they have been revoked, or if their
+status cannot be determined.`,
+		Exposed: true,
+		Name:    "checkOCSP",
+		Stored:  true,
+		Type:    "boolean",
+	},
 	"CreateTime": {
 		AllowedChoices: []string{},
 		Autogenerated:  true,
@@ -632,6 +721,18 @@ var MTLSSourceAttributesMap = map[string]elemental.AttributeSpecification{
 		Stored:         true,
 		Type:           "string",
 	},
+	"FetchCRL": {
+		AllowedChoices: []string{},
+		BSONFieldName:  "fetchcrl",
+		ConvertedName:  "FetchCRL",
+		Description: `If set, the certificate revocation lists published at the distribution points
+listed in the user certificates will be retrieved and checked. They are cached
+until their next update.`,
+		Exposed: true,
+		Name:    "fetchCRL",
+		Stored:  true,
+		Type:    "boolean",
+	},
 	"Fingerprints": {
 		AllowedChoices: []string{},
 		Autogenerated:  true,
@@ -724,6 +825,18 @@ the claims that are about to be delivered using this authentication source.`,
 		SubType:        "string",
 		Type:           "list",
 	},
+	"TrustDomain": {
+		AllowedChars:   `^[a-z0-9._-]+$`,
+		AllowedChoices: []string{},
+		BSONFieldName:  "trustdomain",
+		ConvertedName:  "TrustDomain",
+		Description: `If set, the user certificates must contain a SPIFFE ID in their URI SANs
+belonging to the given trust domain.`,
+		Exposed: true,
+		Name:    "trustDomain",
+		Stored:  true,
+		Type:    "string",
+	},
 	"UpdateTime": {
 		AllowedChoices: []string{},
 		Autogenerated:  true,
@@ -781,6 +894,17 @@ var MTLSSourceLowerCaseAttributesMap = map[string]elemental.AttributeSpecificati
 		Stored:         true,
 		Type:           "string",
 	},
+	"crl": {
+		AllowedChoices: []string{},
+		BSONFieldName:  "crl",
+		ConvertedName:  "CRL",
+		Description: `Optional certificate revocation lists in PEM format. User certificates that
+have been revoked by one of these lists will be rejected.`,
+		Exposed: true,
+		Name:    "CRL",
+		Stored:  true,
+		Type:    "string",
+	},
 	"id": {
 		AllowedChoices: []string{},
 		Autogenerated:  true,
@@ -797,6 +921,18 @@ var MTLSSourceLowerCaseAttributesMap = map[string]elemental.AttributeSpecificati
 		Stored:         true,
 		Type:           "string",
 	},
+	"checkocsp": {
+		AllowedChoices: []string{},
+		BSONFieldName:  "checkocsp",
+		ConvertedName:  "CheckOCSP",
+		Description: `If set, the OCSP responders listed in the user certificates will be queried
+and the certificates will be rejected if they have been revoked, or if their
+status cannot be determined.`,
+		Exposed: true,
+		Name:    "checkOCSP",
+		Stored:  true,
+		Type:    "boolean",
+	},
 	"createtime": {
 		AllowedChoices: []string{},
 		Autogenerated:  true,
@@ -822,6 +958,18 @@ var MTLSSourceLowerCaseAttributesMap = map[string]elemental.AttributeSpecificati
 		Stored:         true,
 		Type:           "string",
 	},
+	"fetchcrl": {
+		AllowedChoices: []string{},
+		BSONFieldName:  "fetchcrl",
+		ConvertedName:  "FetchCRL",
+		Description: `If set, the certificate revocation lists published at the distribution points
+listed in the user certificates will be retrieved and checked. They are cached
+until their next update.`,
+		Exposed: true,
+		Name:    "fetchCRL",
+		Stored:  true,
+		Type:    "boolean",
+	},
 	"fingerprints": {
 		AllowedChoices: []string{},
 		Autogenerated:  true,
@@ -914,6 +1062,18 @@ the claims that are about to be delivered using this authentication source.`,
 		SubType:        "string",
 		Type:           "list",
 	},
+	"trustdomain": {
+		AllowedChars:   `^[a-z0-9._-]+$`,
+		AllowedChoices: []string{},
+		BSONFieldName:  "trustdomain",
+		ConvertedName:  "TrustDomain",
+		Description: `If set, the user certificates must contain a SPIFFE ID in their URI SANs
+belonging to the given trust domain.`,
+		Exposed: true,
+		Name:    "trustDomain",
+		Stored:  true,
+		Type:    "string",
+	},
 	"updatetime": {
 		AllowedChoices: []string{},
 		Autogenerated:  true,
@@ -1024,15 +1184,29 @@ type SparseMTLSSource struct {
 	// The Certificate authority to use to validate user certificates in PEM format.
 	CA *string `json:"CA,omitempty" msgpack:"CA,omitempty" bson:"ca,omitempty" mapstructure:"CA,omitempty"`
 
+	// Optional certificate revocation lists in PEM format. User certificates that
+	// have been revoked by one of these lists will be rejected.
+	CRL *string `json:"CRL,omitempty" msgpack:"CRL,omitempty" bson:"crl,omitempty" mapstructure:"CRL,omitempty"`
+
 	// ID is the identifier of the object.
 	ID *string `json:"ID,omitempty" msgpack:"ID,omitempty" bson:"-" mapstructure:"ID,omitempty"`
 
+	// If set, the OCSP responders listed in the user certificates will be queried
+	// and the certificates will be rejected if they have been revoked, or if their
+	// status cannot be determined.
+	CheckOCSP *bool `json:"checkOCSP,omitempty" msgpack:"checkOCSP,omitempty" bson:"checkocsp,omitempty" mapstructure:"checkOCSP,omitempty"`
+
 	// Creation date of the object.
 	CreateTime *time.Time `json:"createTime,omitempty" msgpack:"createTime,omitempty" bson:"createtime,omitempty" mapstructure:"createTime,omitempty"`
 
 	// The description of the object.
 	Description *string `json:"description,omitempty" msgpack:"description,omitempty" bson:"description,omitempty" mapstructure:"description,omitempty"`
 
+	// If set, the certificate revocation lists published at the distribution points
+	// listed in the user certificates will be retrieved and checked. They are cached
+	// until their next update.
+	FetchCRL *bool `json:"fetchCRL,omitempty" msgpack:"fetchCRL,omitempty" bson:"fetchcrl,omitempty" mapstructure:"fetchCRL,omitempty"`
+
 	// The fingerprint of the CAs in the chain.
 	Fingerprints *[]string `json:"fingerprints,omitempty" msgpack:"fingerprints,omitempty" bson:"fingerprints,omitempty" mapstructure:"fingerprints,omitempty"`
 
@@ -1056,6 +1230,10 @@ type SparseMTLSSource struct {
 	// Value of the CAs X.509 SubjectKeyIDs in the chain.
 	SubjectKeyIDs *[]string `json:"subjectKeyIDs,omitempty" msgpack:"subjectKeyIDs,omitempty" bson:"subjectkeyids,omitempty" mapstructure:"subjectKeyIDs,omitempty"`
 
+	// If set, the user certificates must contain a SPIFFE ID in their URI SANs
+	// belonging to the given trust domain.
+	TrustDomain *string `json:"trustDomain,omitempty" msgpack:"trustDomain,omitempty" bson:"trustdomain,omitempty" mapstructure:"trustDomain,omitempty"`
+
 	// Last update date of the object.
 	UpdateTime *time.Time `json:"updateTime,omitempty" msgpack:"updateTime,omitempty" bson:"updatetime,omitempty" mapstructure:"updateTime,omitempty"`
 
@@ -1111,6 +1289,9 @@ func (o *SparseMTLSSource) MarshalBSON() ([]byte, error) {
 	if o.CA != nil {
 		s.CA = o.CA
 	}
+	if o.CRL != nil {
+		s.CRL = o.CRL
+	}
 	if o.ID != nil {
 		objectID, err := primitive.ObjectIDFromHex(*o.ID)
 		if err != nil {
@@ -1118,12 +1299,18 @@ func (o *SparseMTLSSource) MarshalBSON() ([]byte, error) {
 		}
 		s.ID = objectID
 	}
+	if o.CheckOCSP != nil {
+		s.CheckOCSP = o.CheckOCSP
+	}
 	if o.CreateTime != nil {
 		s.CreateTime = o.CreateTime
 	}
 	if o.Description != nil {
 		s.Description = o.Description
 	}
+	if o.FetchCRL != nil {
+		s.FetchCRL = o.FetchCRL
+	}
 	if o.Fingerprints != nil {
 		s.Fingerprints = o.Fingerprints
 	}
@@ -1145,6 +1332,9 @@ func (o *SparseMTLSSource) MarshalBSON() ([]byte, error) {
 	if o.SubjectKeyIDs != nil {
 		s.SubjectKeyIDs = o.SubjectKeyIDs
 	}
+	if o.TrustDomain != nil {
+		s.TrustDomain = o.TrustDomain
+	}
 	if o.UpdateTime != nil {
 		s.UpdateTime = o.UpdateTime
 	}
@@ -1174,14 +1364,23 @@ func (o *SparseMTLSSource) UnmarshalBSON(raw []byte) error {
 	if s.CA != nil {
 		o.CA = s.CA
 	}
+	if s.CRL != nil {
+		o.CRL = s.CRL
+	}
 	id := s.ID.Hex()
 	o.ID = &id
+	if s.CheckOCSP != nil {
+		o.CheckOCSP = s.CheckOCSP
+	}
 	if s.CreateTime != nil {
 		o.CreateTime = s.CreateTime
 	}
 	if s.Description != nil {
 		o.Description = s.Description
 	}
+	if s.FetchCRL != nil {
+		o.FetchCRL = s.FetchCRL
+	}
 	if s.Fingerprints != nil {
 		o.Fingerprints = s.Fingerprints
 	}
@@ -1203,6 +1402,9 @@ func (o *SparseMTLSSource) UnmarshalBSON(raw []byte) error {
 	if s.SubjectKeyIDs != nil {
 		o.SubjectKeyIDs = s.SubjectKeyIDs
 	}
+	if s.TrustDomain != nil {
+		o.TrustDomain = s.TrustDomain
+	}
 	if s.UpdateTime != nil {
 		o.UpdateTime = s.UpdateTime
 	}
@@ -1229,15 +1431,24 @@ func (o *SparseMTLSSource) ToPlain() elemental.PlainIdentifiable {
 	if o.CA != nil {
 		out.CA = *o.CA
 	}
+	if o.CRL != nil {
+		out.CRL = *o.CRL
+	}
 	if o.ID != nil {
 		out.ID = *o.ID
 	}
+	if o.CheckOCSP != nil {
+		out.CheckOCSP = *o.CheckOCSP
+	}
 	if o.CreateTime != nil {
 		out.CreateTime = *o.CreateTime
 	}
 	if o.Description != nil {
 		out.Description = *o.Description
 	}
+	if o.FetchCRL != nil {
+		out.FetchCRL = *o.FetchCRL
+	}
 	if o.Fingerprints != nil {
 		out.Fingerprints = *o.Fingerprints
 	}
@@ -1259,6 +1470,9 @@ func (o *SparseMTLSSource) ToPlain() elemental.PlainIdentifiable {
 	if o.SubjectKeyIDs != nil {
 		out.SubjectKeyIDs = *o.SubjectKeyIDs
 	}
+	if o.TrustDomain != nil {
+		out.TrustDomain = *o.TrustDomain
+	}
 	if o.UpdateTime != nil {
 		out.UpdateTime = *o.UpdateTime
 	}
@@ -1426,9 +1640,12 @@ func (o *SparseMTLSSource) DeepCopyInto(out *SparseMTLSSource) {
 
 type mongoAttributesMTLSSource struct {
 	CA            string             `bson:"ca"`
+	CRL           string             `bson:"crl"`
 	ID            primitive.ObjectID `bson:"_id,omitempty"`
+	CheckOCSP     bool               `bson:"checkocsp"`
 	CreateTime    time.Time          `bson:"createtime"`
 	Description   string             `bson:"description"`
+	FetchCRL      bool               `bson:"fetchcrl"`
 	Fingerprints  []string           `bson:"fingerprints"`
 	ImportHash    string             `bson:"importhash,omitempty"`
 	ImportLabel   string             `bson:"importlabel,omitempty"`
@@ -1436,15 +1653,19 @@ type mongoAttributesMTLSSource struct {
 	Name          string             `bson:"name"`
 	Namespace     string             `bson:"namespace"`
 	SubjectKeyIDs []string           `bson:"subjectkeyids"`
+	TrustDomain   string             `bson:"trustdomain"`
 	UpdateTime    time.Time          `bson:"updatetime"`
 	ZHash         int                `bson:"zhash"`
 	Zone          int                `bson:"zone"`
 }
 type mongoAttributesSparseMTLSSource struct {
 	CA            *string            `bson:"ca,omitempty"`
+	CRL           *string            `bson:"crl,omitempty"`
 	ID            primitive.ObjectID `bson:"_id,omitempty"`
+	CheckOCSP     *bool              `bson:"checkocsp,omitempty"`
 	CreateTime    *time.Time         `bson:"createtime,omitempty"`
 	Description   *string            `bson:"description,omitempty"`
+	FetchCRL      *bool              `bson:"fetchcrl,omitempty"`
 	Fingerprints  *[]string          `bson:"fingerprints,omitempty"`
 	ImportHash    *string            `bson:"importhash,omitempty"`
 	ImportLabel   *string            `bson:"importlabel,omitempty"`
@@ -1452,6 +1673,7 @@ type mongoAttributesSparseMTLSSource struct {
 	Name          *string            `bson:"name,omitempty"`
 	Namespace     *string            `bson:"namespace,omitempty"`
 	SubjectKeyIDs *[]string          `bson:"subjectkeyids,omitempty"`
+	TrustDomain   *string            `bson:"trustdomain,omitempty"`
 	UpdateTime    *time.Time         `bson:"updatetime,omitempty"`
 	ZHash         *int               `bson:"zhash,omitempty"`
 	Zone          *int               `bson:"zone,omitempty"`
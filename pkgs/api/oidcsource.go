@@ -93,10 +93,17 @@ type OIDCSource struct {
 	// ID is the identifier of the object.
 	ID string `json:"ID" msgpack:"ID" bson:"-" mapstructure:"ID,omitempty"`
 
+	// Renames the keys of the claims. Nested claims are flattened into dotted keys,
+	// and filtered by `includedKeys` and `ignoredKeys` before being renamed. Like
+	// for the filters, keys are matched case insensitively. The new keys cannot start
+	// with `@`, as it is reserved.
+	ClaimMapping map[string]string `json:"claimMapping,omitempty" msgpack:"claimMapping,omitempty" bson:"claimmapping,omitempty" mapstructure:"claimMapping,omitempty"`
+
 	// Unique client ID.
 	ClientID string `json:"clientID" msgpack:"clientID" bson:"clientid" mapstructure:"clientID,omitempty"`
 
-	// Client secret associated with the client ID.
+	// Client secret associated with the client ID. If empty, the source is used
+	// as a public client and the ceremony relies on PKCE only.
 	ClientSecret string `json:"clientSecret" msgpack:"clientSecret" bson:"clientsecret" mapstructure:"clientSecret,omitempty"`
 
 	// Creation date of the object.
@@ -109,6 +116,15 @@ type OIDCSource struct {
 	// endpoint](https://openid.net/specs/openid-connect-discovery-1_0.html#IssuerDiscovery).
 	Endpoint string `json:"endpoint" msgpack:"endpoint" bson:"endpoint" mapstructure:"endpoint,omitempty"`
 
+	// If set, the claims returned by the userinfo endpoint of the OIDC provider are
+	// merged with the claims of the ID token. The claims of the ID token take
+	// precedence.
+	FetchUserInfo bool `json:"fetchUserInfo" msgpack:"fetchUserInfo" bson:"fetchuserinfo" mapstructure:"fetchUserInfo,omitempty"`
+
+	// A list of keys that must not be imported into the identity token. If
+	// `includedKeys` is also set, and a key is in both lists, the key will be ignored.
+	IgnoredKeys []string `json:"ignoredKeys,omitempty" msgpack:"ignoredKeys,omitempty" bson:"ignoredkeys,omitempty" mapstructure:"ignoredKeys,omitempty"`
+
 	// The hash of the structure used to compare with new import version.
 	ImportHash string `json:"importHash,omitempty" msgpack:"importHash,omitempty" bson:"importhash,omitempty" mapstructure:"importHash,omitempty"`
 
@@ -116,6 +132,10 @@ type OIDCSource struct {
 	// same import operation.
 	ImportLabel string `json:"importLabel,omitempty" msgpack:"importLabel,omitempty" bson:"importlabel,omitempty" mapstructure:"importLabel,omitempty"`
 
+	// A list of keys that must be imported into the identity token. If `ignoredKeys`
+	// is also set, and a key is in both lists, the key will be ignored.
+	IncludedKeys []string `json:"includedKeys,omitempty" msgpack:"includedKeys,omitempty" bson:"includedkeys,omitempty" mapstructure:"includedKeys,omitempty"`
+
 	// Contains optional information about a remote service that can be used to modify
 	// the claims that are about to be delivered using this authentication source.
 	Modifier *IdentityModifier `json:"modifier,omitempty" msgpack:"modifier,omitempty" bson:"modifier,omitempty" mapstructure:"modifier,omitempty"`
@@ -146,6 +166,9 @@ func NewOIDCSource() *OIDCSource {
 
 	return &OIDCSource{
 		ModelVersion: 1,
+		ClaimMapping: map[string]string{},
+		IgnoredKeys:  []string{},
+		IncludedKeys: []string{},
 		Scopes:       []string{},
 	}
 }
@@ -186,13 +209,17 @@ func (o *OIDCSource) MarshalBSON() ([]byte, error) {
 		}
 		s.ID = objectID
 	}
+	s.ClaimMapping = o.ClaimMapping
 	s.ClientID = o.ClientID
 	s.ClientSecret = o.ClientSecret
 	s.CreateTime = o.CreateTime
 	s.Description = o.Description
 	s.Endpoint = o.Endpoint
+	s.FetchUserInfo = o.FetchUserInfo
+	s.IgnoredKeys = o.IgnoredKeys
 	s.ImportHash = o.ImportHash
 	s.ImportLabel = o.ImportLabel
+	s.IncludedKeys = o.IncludedKeys
 	s.Modifier = o.Modifier
 	s.Name = o.Name
 	s.Namespace = o.Namespace
@@ -219,13 +246,17 @@ func (o *OIDCSource) UnmarshalBSON(raw []byte) error {
 
 	o.CA = s.CA
 	o.ID = s.ID.Hex()
+	o.ClaimMapping = s.ClaimMapping
 	o.ClientID = s.ClientID
 	o.ClientSecret = s.ClientSecret
 	o.CreateTime = s.CreateTime
 	o.Description = s.Description
 	o.Endpoint = s.Endpoint
+	o.FetchUserInfo = s.FetchUserInfo
+	o.IgnoredKeys = s.IgnoredKeys
 	o.ImportHash = s.ImportHash
 	o.ImportLabel = s.ImportLabel
+	o.IncludedKeys = s.IncludedKeys
 	o.Modifier = s.Modifier
 	o.Name = s.Name
 	o.Namespace = s.Namespace
@@ -369,22 +400,26 @@ func (o *OIDCSource) ToSparse(fields ...string) elemental.SparseIdentifiable {
 	if len(fields) == 0 {
 		// nolint: goimports
 		return &SparseOIDCSource{
-			CA:           &o.CA,
-			ID:           &o.ID,
-			ClientID:     &o.ClientID,
-			ClientSecret: &o.ClientSecret,
-			CreateTime:   &o.CreateTime,
-			Description:  &o.Description,
-			Endpoint:     &o.Endpoint,
-			ImportHash:   &o.ImportHash,
-			ImportLabel:  &o.ImportLabel,
-			Modifier:     o.Modifier,
-			Name:         &o.Name,
-			Namespace:    &o.Namespace,
-			Scopes:       &o.Scopes,
-			UpdateTime:   &o.UpdateTime,
-			ZHash:        &o.ZHash,
-			Zone:         &o.Zone,
+			CA:            &o.CA,
+			ID:            &o.ID,
+			ClaimMapping:  &o.ClaimMapping,
+			ClientID:      &o.ClientID,
+			ClientSecret:  &o.ClientSecret,
+			CreateTime:    &o.CreateTime,
+			Description:   &o.Description,
+			Endpoint:      &o.Endpoint,
+			FetchUserInfo: &o.FetchUserInfo,
+			IgnoredKeys:   &o.IgnoredKeys,
+			ImportHash:    &o.ImportHash,
+			ImportLabel:   &o.ImportLabel,
+			IncludedKeys:  &o.IncludedKeys,
+			Modifier:      o.Modifier,
+			Name:          &o.Name,
+			Namespace:     &o.Namespace,
+			Scopes:        &o.Scopes,
+			UpdateTime:    &o.UpdateTime,
+			ZHash:         &o.ZHash,
+			Zone:          &o.Zone,
 		}
 	}
 
@@ -395,6 +430,8 @@ func (o *OIDCSource) ToSparse(fields ...string) elemental.SparseIdentifiable {
 			sp.CA = &(o.CA)
 		case "ID":
 			sp.ID = &(o.ID)
+		case "claimMapping":
+			sp.ClaimMapping = &(o.ClaimMapping)
 		case "clientID":
 			sp.ClientID = &(o.ClientID)
 		case "clientSecret":
@@ -405,10 +442,16 @@ func (o *OIDCSource) ToSparse(fields ...string) elemental.SparseIdentifiable {
 			sp.Description = &(o.Description)
 		case "endpoint":
 			sp.Endpoint = &(o.Endpoint)
+		case "fetchUserInfo":
+			sp.FetchUserInfo = &(o.FetchUserInfo)
+		case "ignoredKeys":
+			sp.IgnoredKeys = &(o.IgnoredKeys)
 		case "importHash":
 			sp.ImportHash = &(o.ImportHash)
 		case "importLabel":
 			sp.ImportLabel = &(o.ImportLabel)
+		case "includedKeys":
+			sp.IncludedKeys = &(o.IncludedKeys)
 		case "modifier":
 			sp.Modifier = o.Modifier
 		case "name":
@@ -462,6 +505,9 @@ func (o *OIDCSource) Patch(sparse elemental.SparseIdentifiable) {
 	if so.ID != nil {
 		o.ID = *so.ID
 	}
+	if so.ClaimMapping != nil {
+		o.ClaimMapping = *so.ClaimMapping
+	}
 	if so.ClientID != nil {
 		o.ClientID = *so.ClientID
 	}
@@ -477,12 +523,21 @@ func (o *OIDCSource) Patch(sparse elemental.SparseIdentifiable) {
 	if so.Endpoint != nil {
 		o.Endpoint = *so.Endpoint
 	}
+	if so.FetchUserInfo != nil {
+		o.FetchUserInfo = *so.FetchUserInfo
+	}
+	if so.IgnoredKeys != nil {
+		o.IgnoredKeys = *so.IgnoredKeys
+	}
 	if so.ImportHash != nil {
 		o.ImportHash = *so.ImportHash
 	}
 	if so.ImportLabel != nil {
 		o.ImportLabel = *so.ImportLabel
 	}
+	if so.IncludedKeys != nil {
+		o.IncludedKeys = *so.IncludedKeys
+	}
 	if so.Modifier != nil {
 		o.Modifier = so.Modifier
 	}
@@ -544,10 +599,6 @@ func (o *OIDCSource) Validate() error {
 		requiredErrors = requiredErrors.Append(err)
 	}
 
-	if err := elemental.ValidateRequiredString("clientSecret", o.ClientSecret); err != nil {
-		requiredErrors = requiredErrors.Append(err)
-	}
-
 	if err := elemental.ValidateRequiredString("endpoint", o.Endpoint); err != nil {
 		requiredErrors = requiredErrors.Append(err)
 	}
@@ -563,6 +614,11 @@ func (o *OIDCSource) Validate() error {
 		requiredErrors = requiredErrors.Append(err)
 	}
 
+	// Custom object validation.
+	if err := ValidateOIDCSource(o); err != nil {
+		errors = errors.Append(err)
+	}
+
 	if len(requiredErrors) > 0 {
 		return requiredErrors
 	}
@@ -601,6 +657,8 @@ func (o *OIDCSource) ValueForAttribute(name string) any {
 		return o.CA
 	case "ID":
 		return o.ID
+	case "claimMapping":
+		return o.ClaimMapping
 	case "clientID":
 		return o.ClientID
 	case "clientSecret":
@@ -611,10 +669,16 @@ func (o *OIDCSource) ValueForAttribute(name string) any {
 		return o.Description
 	case "endpoint":
 		return o.Endpoint
+	case "fetchUserInfo":
+		return o.FetchUserInfo
+	case "ignoredKeys":
+		return o.IgnoredKeys
 	case "importHash":
 		return o.ImportHash
 	case "importLabel":
 		return o.ImportLabel
+	case "includedKeys":
+		return o.IncludedKeys
 	case "modifier":
 		return o.Modifier
 	case "name":
@@ -664,6 +728,20 @@ cases, you don't need to set this.`,
 		Stored:         true,
 		Type:           "string",
 	},
+	"ClaimMapping": {
+		AllowedChoices: []string{},
+		BSONFieldName:  "claimmapping",
+		ConvertedName:  "ClaimMapping",
+		Description: `Renames the keys of the claims. Nested claims are flattened into dotted keys,
+and filtered by ` + "`" + `includedKeys` + "`" + ` and ` + "`" + `ignoredKeys` + "`" + ` before being renamed. Like
+for the filters, keys are matched case insensitively. The new keys cannot start
+with ` + "`" + `@` + "`" + `, as it is reserved.`,
+		Exposed: true,
+		Name:    "claimMapping",
+		Stored:  true,
+		SubType: "map[string]string",
+		Type:    "external",
+	},
 	"ClientID": {
 		AllowedChoices: []string{},
 		BSONFieldName:  "clientid",
@@ -679,13 +757,13 @@ cases, you don't need to set this.`,
 		AllowedChoices: []string{},
 		BSONFieldName:  "clientsecret",
 		ConvertedName:  "ClientSecret",
-		Description:    `Client secret associated with the client ID.`,
-		Encrypted:      true,
-		Exposed:        true,
-		Name:           "clientSecret",
-		Required:       true,
-		Stored:         true,
-		Type:           "string",
+		Description: `Client secret associated with the client ID. If empty, the source is used
+as a public client and the ceremony relies on PKCE only.`,
+		Encrypted: true,
+		Exposed:   true,
+		Name:      "clientSecret",
+		Stored:    true,
+		Type:      "string",
 	},
 	"CreateTime": {
 		AllowedChoices: []string{},
@@ -724,6 +802,30 @@ endpoint](https://openid.net/specs/openid-connect-discovery-1_0.html#IssuerDisco
 		Stored:   true,
 		Type:     "string",
 	},
+	"FetchUserInfo": {
+		AllowedChoices: []string{},
+		BSONFieldName:  "fetchuserinfo",
+		ConvertedName:  "FetchUserInfo",
+		Description: `If set, the claims returned by the userinfo endpoint of the OIDC provider are
+merged with the claims of the ID token. The claims of the ID token take
+precedence.`,
+		Exposed: true,
+		Name:    "fetchUserInfo",
+		Stored:  true,
+		Type:    "boolean",
+	},
+	"IgnoredKeys": {
+		AllowedChoices: []string{},
+		BSONFieldName:  "ignoredkeys",
+		ConvertedName:  "IgnoredKeys",
+		Description: `A list of keys that must not be imported into the identity token. If
+` + "`" + `includedKeys` + "`" + ` is also set, and a key is in both lists, the key will be ignored.`,
+		Exposed: true,
+		Name:    "ignoredKeys",
+		Stored:  true,
+		SubType: "string",
+		Type:    "list",
+	},
 	"ImportHash": {
 		AllowedChoices: []string{},
 		Autogenerated:  true,
@@ -752,6 +854,18 @@ same import operation.`,
 		Stored:  true,
 		Type:    "string",
 	},
+	"IncludedKeys": {
+		AllowedChoices: []string{},
+		BSONFieldName:  "includedkeys",
+		ConvertedName:  "IncludedKeys",
+		Description: `A list of keys that must be imported into the identity token. If ` + "`" + `ignoredKeys` + "`" + `
+is also set, and a key is in both lists, the key will be ignored.`,
+		Exposed: true,
+		Name:    "includedKeys",
+		Stored:  true,
+		SubType: "string",
+		Type:    "list",
+	},
 	"Modifier": {
 		AllowedChoices: []string{},
 		BSONFieldName:  "modifier",
@@ -875,6 +989,20 @@ cases, you don't need to set this.`,
 		Stored:         true,
 		Type:           "string",
 	},
+	"claimmapping": {
+		AllowedChoices: []string{},
+		BSONFieldName:  "claimmapping",
+		ConvertedName:  "ClaimMapping",
+		Description: `Renames the keys of the claims. Nested claims are flattened into dotted keys,
+and filtered by ` + "`" + `includedKeys` + "`" + ` and ` + "`" + `ignoredKeys` + "`" + ` before being renamed. Like
+for the filters, keys are matched case insensitively. The new keys cannot start
+with ` + "`" + `@` + "`" + `, as it is reserved.`,
+		Exposed: true,
+		Name:    "claimMapping",
+		Stored:  true,
+		SubType: "map[string]string",
+		Type:    "external",
+	},
 	"clientid": {
 		AllowedChoices: []string{},
 		BSONFieldName:  "clientid",
@@ -890,13 +1018,13 @@ cases, you don't need to set this.`,
 		AllowedChoices: []string{},
 		BSONFieldName:  "clientsecret",
 		ConvertedName:  "ClientSecret",
-		Description:    `Client secret associated with the client ID.`,
-		Encrypted:      true,
-		Exposed:        true,
-		Name:           "clientSecret",
-		Required:       true,
-		Stored:         true,
-		Type:           "string",
+		Description: `Client secret associated with the client ID. If empty, the source is used
+as a public client and the ceremony relies on PKCE only.`,
+		Encrypted: true,
+		Exposed:   true,
+		Name:      "clientSecret",
+		Stored:    true,
+		Type:      "string",
 	},
 	"createtime": {
 		AllowedChoices: []string{},
@@ -935,6 +1063,30 @@ endpoint](https://openid.net/specs/openid-connect-discovery-1_0.html#IssuerDisco
 		Stored:   true,
 		Type:     "string",
 	},
+	"fetchuserinfo": {
+		AllowedChoices: []string{},
+		BSONFieldName:  "fetchuserinfo",
+		ConvertedName:  "FetchUserInfo",
+		Description: `If set, the claims returned by the userinfo endpoint of the OIDC provider are
+merged with the claims of the ID token. The claims of the ID token take
+precedence.`,
+		Exposed: true,
+		Name:    "fetchUserInfo",
+		Stored:  true,
+		Type:    "boolean",
+	},
+	"ignoredkeys": {
+		AllowedChoices: []string{},
+		BSONFieldName:  "ignoredkeys",
+		ConvertedName:  "IgnoredKeys",
+		Description: `A list of keys that must not be imported into the identity token. If
+` + "`" + `includedKeys` + "`" + ` is also set, and a key is in both lists, the key will be ignored.`,
+		Exposed: true,
+		Name:    "ignoredKeys",
+		Stored:  true,
+		SubType: "string",
+		Type:    "list",
+	},
 	"importhash": {
 		AllowedChoices: []string{},
 		Autogenerated:  true,
@@ -963,6 +1115,18 @@ same import operation.`,
 		Stored:  true,
 		Type:    "string",
 	},
+	"includedkeys": {
+		AllowedChoices: []string{},
+		BSONFieldName:  "includedkeys",
+		ConvertedName:  "IncludedKeys",
+		Description: `A list of keys that must be imported into the identity token. If ` + "`" + `ignoredKeys` + "`" + `
+is also set, and a key is in both lists, the key will be ignored.`,
+		Exposed: true,
+		Name:    "includedKeys",
+		Stored:  true,
+		SubType: "string",
+		Type:    "list",
+	},
 	"modifier": {
 		AllowedChoices: []string{},
 		BSONFieldName:  "modifier",
@@ -1127,10 +1291,17 @@ type SparseOIDCSource struct {
 	// ID is the identifier of the object.
 	ID *string `json:"ID,omitempty" msgpack:"ID,omitempty" bson:"-" mapstructure:"ID,omitempty"`
 
+	// Renames the keys of the claims. Nested claims are flattened into dotted keys,
+	// and filtered by `includedKeys` and `ignoredKeys` before being renamed. Like
+	// for the filters, keys are matched case insensitively. The new keys cannot start
+	// with `@`, as it is reserved.
+	ClaimMapping *map[string]string `json:"claimMapping,omitempty" msgpack:"claimMapping,omitempty" bson:"claimmapping,omitempty" mapstructure:"claimMapping,omitempty"`
+
 	// Unique client ID.
 	ClientID *string `json:"clientID,omitempty" msgpack:"clientID,omitempty" bson:"clientid,omitempty" mapstructure:"clientID,omitempty"`
 
-	// Client secret associated with the client ID.
+	// Client secret associated with the client ID. If empty, the source is used
+	// as a public client and the ceremony relies on PKCE only.
 	ClientSecret *string `json:"clientSecret,omitempty" msgpack:"clientSecret,omitempty" bson:"clientsecret,omitempty" mapstructure:"clientSecret,omitempty"`
 
 	// Creation date of the object.
@@ -1143,6 +1314,15 @@ type SparseOIDCSource struct {
 	// endpoint](https://openid.net/specs/openid-connect-discovery-1_0.html#IssuerDiscovery).
 	Endpoint *string `json:"endpoint,omitempty" msgpack:"endpoint,omitempty" bson:"endpoint,omitempty" mapstructure:"endpoint,omitempty"`
 
+	// If set, the claims returned by the userinfo endpoint of the OIDC provider are
+	// merged with the claims of the ID token. The claims of the ID token take
+	// precedence.
+	FetchUserInfo *bool `json:"fetchUserInfo,omitempty" msgpack:"fetchUserInfo,omitempty" bson:"fetchuserinfo,omitempty" mapstructure:"fetchUserInfo,omitempty"`
+
+	// A list of keys that must not be imported into the identity token. If
+	// `includedKeys` is also set, and a key is in both lists, the key will be ignored.
+	IgnoredKeys *[]string `json:"ignoredKeys,omitempty" msgpack:"ignoredKeys,omitempty" bson:"ignoredkeys,omitempty" mapstructure:"ignoredKeys,omitempty"`
+
 	// The hash of the structure used to compare with new import version.
 	ImportHash *string `json:"importHash,omitempty" msgpack:"importHash,omitempty" bson:"importhash,omitempty" mapstructure:"importHash,omitempty"`
 
@@ -1150,6 +1330,10 @@ type SparseOIDCSource struct {
 	// same import operation.
 	ImportLabel *string `json:"importLabel,omitempty" msgpack:"importLabel,omitempty" bson:"importlabel,omitempty" mapstructure:"importLabel,omitempty"`
 
+	// A list of keys that must be imported into the identity token. If `ignoredKeys`
+	// is also set, and a key is in both lists, the key will be ignored.
+	IncludedKeys *[]string `json:"includedKeys,omitempty" msgpack:"includedKeys,omitempty" bson:"includedkeys,omitempty" mapstructure:"includedKeys,omitempty"`
+
 	// Contains optional information about a remote service that can be used to modify
 	// the claims that are about to be delivered using this authentication source.
 	Modifier *IdentityModifier `json:"modifier,omitempty" msgpack:"modifier,omitempty" bson:"modifier,omitempty" mapstructure:"modifier,omitempty"`
@@ -1225,6 +1409,9 @@ func (o *SparseOIDCSource) MarshalBSON() ([]byte, error) {
 		}
 		s.ID = objectID
 	}
+	if o.ClaimMapping != nil {
+		s.ClaimMapping = o.ClaimMapping
+	}
 	if o.ClientID != nil {
 		s.ClientID = o.ClientID
 	}
@@ -1240,12 +1427,21 @@ func (o *SparseOIDCSource) MarshalBSON() ([]byte, error) {
 	if o.Endpoint != nil {
 		s.Endpoint = o.Endpoint
 	}
+	if o.FetchUserInfo != nil {
+		s.FetchUserInfo = o.FetchUserInfo
+	}
+	if o.IgnoredKeys != nil {
+		s.IgnoredKeys = o.IgnoredKeys
+	}
 	if o.ImportHash != nil {
 		s.ImportHash = o.ImportHash
 	}
 	if o.ImportLabel != nil {
 		s.ImportLabel = o.ImportLabel
 	}
+	if o.IncludedKeys != nil {
+		s.IncludedKeys = o.IncludedKeys
+	}
 	if o.Modifier != nil {
 		s.Modifier = o.Modifier
 	}
@@ -1289,6 +1485,9 @@ func (o *SparseOIDCSource) UnmarshalBSON(raw []byte) error {
 	}
 	id := s.ID.Hex()
 	o.ID = &id
+	if s.ClaimMapping != nil {
+		o.ClaimMapping = s.ClaimMapping
+	}
 	if s.ClientID != nil {
 		o.ClientID = s.ClientID
 	}
@@ -1304,12 +1503,21 @@ func (o *SparseOIDCSource) UnmarshalBSON(raw []byte) error {
 	if s.Endpoint != nil {
 		o.Endpoint = s.Endpoint
 	}
+	if s.FetchUserInfo != nil {
+		o.FetchUserInfo = s.FetchUserInfo
+	}
+	if s.IgnoredKeys != nil {
+		o.IgnoredKeys = s.IgnoredKeys
+	}
 	if s.ImportHash != nil {
 		o.ImportHash = s.ImportHash
 	}
 	if s.ImportLabel != nil {
 		o.ImportLabel = s.ImportLabel
 	}
+	if s.IncludedKeys != nil {
+		o.IncludedKeys = s.IncludedKeys
+	}
 	if s.Modifier != nil {
 		o.Modifier = s.Modifier
 	}
@@ -1351,6 +1559,9 @@ func (o *SparseOIDCSource) ToPlain() elemental.PlainIdentifiable {
 	if o.ID != nil {
 		out.ID = *o.ID
 	}
+	if o.ClaimMapping != nil {
+		out.ClaimMapping = *o.ClaimMapping
+	}
 	if o.ClientID != nil {
 		out.ClientID = *o.ClientID
 	}
@@ -1366,12 +1577,21 @@ func (o *SparseOIDCSource) ToPlain() elemental.PlainIdentifiable {
 	if o.Endpoint != nil {
 		out.Endpoint = *o.Endpoint
 	}
+	if o.FetchUserInfo != nil {
+		out.FetchUserInfo = *o.FetchUserInfo
+	}
+	if o.IgnoredKeys != nil {
+		out.IgnoredKeys = *o.IgnoredKeys
+	}
 	if o.ImportHash != nil {
 		out.ImportHash = *o.ImportHash
 	}
 	if o.ImportLabel != nil {
 		out.ImportLabel = *o.ImportLabel
 	}
+	if o.IncludedKeys != nil {
+		out.IncludedKeys = *o.IncludedKeys
+	}
 	if o.Modifier != nil {
 		out.Modifier = o.Modifier
 	}
@@ -1570,38 +1790,46 @@ func (o *SparseOIDCSource) DeepCopyInto(out *SparseOIDCSource) {
 }
 
 type mongoAttributesOIDCSource struct {
-	CA           string             `bson:"ca"`
-	ID           primitive.ObjectID `bson:"_id,omitempty"`
-	ClientID     string             `bson:"clientid"`
-	ClientSecret string             `bson:"clientsecret"`
-	CreateTime   time.Time          `bson:"createtime"`
-	Description  string             `bson:"description"`
-	Endpoint     string             `bson:"endpoint"`
-	ImportHash   string             `bson:"importhash,omitempty"`
-	ImportLabel  string             `bson:"importlabel,omitempty"`
-	Modifier     *IdentityModifier  `bson:"modifier,omitempty"`
-	Name         string             `bson:"name"`
-	Namespace    string             `bson:"namespace"`
-	Scopes       []string           `bson:"scopes"`
-	UpdateTime   time.Time          `bson:"updatetime"`
-	ZHash        int                `bson:"zhash"`
-	Zone         int                `bson:"zone"`
+	CA            string             `bson:"ca"`
+	ID            primitive.ObjectID `bson:"_id,omitempty"`
+	ClaimMapping  map[string]string  `bson:"claimmapping,omitempty"`
+	ClientID      string             `bson:"clientid"`
+	ClientSecret  string             `bson:"clientsecret"`
+	CreateTime    time.Time          `bson:"createtime"`
+	Description   string             `bson:"description"`
+	Endpoint      string             `bson:"endpoint"`
+	FetchUserInfo bool               `bson:"fetchuserinfo"`
+	IgnoredKeys   []string           `bson:"ignoredkeys,omitempty"`
+	ImportHash    string             `bson:"importhash,omitempty"`
+	ImportLabel   string             `bson:"importlabel,omitempty"`
+	IncludedKeys  []string           `bson:"includedkeys,omitempty"`
+	Modifier      *IdentityModifier  `bson:"modifier,omitempty"`
+	Name          string             `bson:"name"`
+	Namespace     string             `bson:"namespace"`
+	Scopes        []string           `bson:"scopes"`
+	UpdateTime    time.Time          `bson:"updatetime"`
+	ZHash         int                `bson:"zhash"`
+	Zone          int                `bson:"zone"`
 }
 type mongoAttributesSparseOIDCSource struct {
-	CA           *string            `bson:"ca,omitempty"`
-	ID           primitive.ObjectID `bson:"_id,omitempty"`
-	ClientID     *string            `bson:"clientid,omitempty"`
-	ClientSecret *string            `bson:"clientsecret,omitempty"`
-	CreateTime   *time.Time         `bson:"createtime,omitempty"`
-	Description  *string            `bson:"description,omitempty"`
-	Endpoint     *string            `bson:"endpoint,omitempty"`
-	ImportHash   *string            `bson:"importhash,omitempty"`
-	ImportLabel  *string            `bson:"importlabel,omitempty"`
-	Modifier     *IdentityModifier  `bson:"modifier,omitempty"`
-	Name         *string            `bson:"name,omitempty"`
-	Namespace    *string            `bson:"namespace,omitempty"`
-	Scopes       *[]string          `bson:"scopes,omitempty"`
-	UpdateTime   *time.Time         `bson:"updatetime,omitempty"`
-	ZHash        *int               `bson:"zhash,omitempty"`
-	Zone         *int               `bson:"zone,omitempty"`
+	CA            *string            `bson:"ca,omitempty"`
+	ID            primitive.ObjectID `bson:"_id,omitempty"`
+	ClaimMapping  *map[string]string `bson:"claimmapping,omitempty"`
+	ClientID      *string            `bson:"clientid,omitempty"`
+	ClientSecret  *string            `bson:"clientsecret,omitempty"`
+	CreateTime    *time.Time         `bson:"createtime,omitempty"`
+	Description   *string            `bson:"description,omitempty"`
+	Endpoint      *string            `bson:"endpoint,omitempty"`
+	FetchUserInfo *bool              `bson:"fetchuserinfo,omitempty"`
+	IgnoredKeys   *[]string          `bson:"ignoredkeys,omitempty"`
+	ImportHash    *string            `bson:"importhash,omitempty"`
+	ImportLabel   *string            `bson:"importlabel,omitempty"`
+	IncludedKeys  *[]string          `bson:"includedkeys,omitempty"`
+	Modifier      *IdentityModifier  `bson:"modifier,omitempty"`
+	Name          *string            `bson:"name,omitempty"`
+	Namespace     *string            `bson:"namespace,omitempty"`
+	Scopes        *[]string          `bson:"scopes,omitempty"`
+	UpdateTime    *time.Time         `bson:"updatetime,omitempty"`
+	ZHash         *int               `bson:"zhash,omitempty"`
+	Zone          *int               `bson:"zone,omitempty"`
 }
@@ -0,0 +1,19 @@
+# Model
+model:
+  rest_name: issuejwt
+  resource_name: issuejwt
+  entity_name: IssueJWT
+  package: a3s
+  group: authn/issue
+  description: Additional issuing information for the JWT source.
+  detached: true
+
+# Attributes
+attributes:
+  v1:
+  - name: token
+    description: The JWT to verify.
+    type: string
+    exposed: true
+    required: true
+    example_value: valid.jwt.token
@@ -0,0 +1,19 @@
+# Model
+model:
+  rest_name: issuekubernetes
+  resource_name: issuekubernetes
+  entity_name: IssueKubernetes
+  package: a3s
+  group: authn/issue
+  description: Additional issuing information for the Kubernetes service account token source.
+  detached: true
+
+# Attributes
+attributes:
+  v1:
+  - name: token
+    description: The projected service account token.
+    type: string
+    exposed: true
+    required: true
+    example_value: valid.jwt.token
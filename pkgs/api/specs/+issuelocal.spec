@@ -0,0 +1,26 @@
+# Model
+model:
+  rest_name: issuelocal
+  resource_name: issuelocal
+  entity_name: IssueLocal
+  package: a3s
+  group: authn/issue
+  description: Additional issuing information for a local source.
+  detached: true
+
+# Attributes
+attributes:
+  v1:
+  - name: password
+    description: The password for the user.
+    type: string
+    exposed: true
+    required: true
+    example_value: secret
+
+  - name: username
+    description: The username.
+    type: string
+    exposed: true
+    required: true
+    example_value: joe
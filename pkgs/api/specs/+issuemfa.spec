@@ -0,0 +1,47 @@
+# Model
+model:
+  rest_name: issuemfa
+  resource_name: issuemfa
+  entity_name: IssueMFA
+  package: a3s
+  group: authn/issue
+  description: |-
+    Additional issuing information to upgrade a token using a second factor. To
+    use a WebAuthn credential, send the token alone to get the options to pass to
+    `navigator.credentials.get()`, then send the token again with the returned
+    credential.
+  detached: true
+
+# Attributes
+attributes:
+  v1:
+  - name: TOTP
+    description: A TOTP code.
+    type: string
+    exposed: true
+    example_value: "123456"
+    omit_empty: true
+
+  - name: token
+    description: The token to upgrade.
+    type: string
+    exposed: true
+    required: true
+    example_value: valid.jwt.token
+
+  - name: webAuthnOptions
+    description: |-
+      The JSON encoded options to pass to `navigator.credentials.get()`, with the
+      binary fields base64url encoded.
+    type: string
+    exposed: true
+    read_only: true
+    omit_empty: true
+
+  - name: webAuthnResponse
+    description: |-
+      The JSON encoded credential returned by `navigator.credentials.get()`, with
+      the binary fields base64url encoded.
+    type: string
+    exposed: true
+    omit_empty: true
@@ -18,11 +18,37 @@ attributes:
     read_only: true
     omit_empty: true
 
+  - name: authorizationPending
+    description: |-
+      Set by the server when the user has not yet approved the device
+      authorization request. The request must be sent again after the interval.
+    type: boolean
+    exposed: true
+    read_only: true
+    omit_empty: true
+
   - name: code
     description: OIDC ceremony code.
     type: string
     exposed: true
 
+  - name: deviceAuth
+    description: |-
+      If set, use the device authorization flow instead of the authorization code
+      flow. This allows to authenticate from a device that cannot receive the
+      redirection of the OIDC provider.
+    type: boolean
+    exposed: true
+
+  - name: interval
+    description: |-
+      Contains the minimum number of seconds to wait between two requests while
+      the device authorization request is pending.
+    type: integer
+    exposed: true
+    read_only: true
+    omit_empty: true
+
   - name: noAuthRedirect
     description: |-
       If set, instruct the server to return the OIDC auth url in authURL instead of
@@ -44,3 +70,30 @@ attributes:
     description: OIDC ceremony state.
     type: string
     exposed: true
+
+  - name: userCode
+    description: |-
+      Contains the code the user must enter at the verification URI during the
+      device authorization flow.
+    type: string
+    exposed: true
+    read_only: true
+    omit_empty: true
+
+  - name: verificationURI
+    description: |-
+      Contains the URI where the user must enter the user code during the device
+      authorization flow.
+    type: string
+    exposed: true
+    read_only: true
+    omit_empty: true
+
+  - name: verificationURIComplete
+    description: |-
+      Contains the verification URI including the user code, if the OIDC provider
+      supports it.
+    type: string
+    exposed: true
+    read_only: true
+    omit_empty: true
@@ -0,0 +1,55 @@
+# Model
+model:
+  rest_name: issuesaml
+  resource_name: issuesaml
+  entity_name: IssueSAML
+  package: a3s
+  group: authn/issue
+  description: Additional issuing information for the SAML source.
+  detached: true
+
+# Attributes
+attributes:
+  v1:
+  - name: authURL
+    description: Contains the auth URL is noAuthRedirect is set to true.
+    type: string
+    exposed: true
+    read_only: true
+    omit_empty: true
+
+  - name: noAuthRedirect
+    description: |-
+      If set, instruct the server to return the SAML auth url in authURL instead of
+      performing an HTTP redirection.
+    type: boolean
+    exposed: true
+
+  - name: redirectErrorURL
+    description: |-
+      SAML redirect url in case of error. It must be one of the allowed redirect
+      URLs of the source.
+    type: string
+    exposed: true
+
+  - name: redirectURL
+    description: |-
+      The URL the user is redirected to once the identity provider posted its
+      response to the assertion consumer service of A3S. The state is passed as a
+      query parameter. It must be one of the allowed redirect URLs of the source.
+    type: string
+    exposed: true
+
+  - name: state
+    description: SAML ceremony state.
+    type: string
+    exposed: true
+
+  - name: verifier
+    description: |-
+      The secret binding the state to the client that started the SAML ceremony.
+      It is returned with authURL if noAuthRedirect is set, and must be sent back
+      with the state. Otherwise, it is set in a cookie.
+    type: string
+    exposed: true
+    omit_empty: true
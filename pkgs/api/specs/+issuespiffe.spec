@@ -0,0 +1,19 @@
+# Model
+model:
+  rest_name: issuespiffe
+  resource_name: issuespiffe
+  entity_name: IssueSPIFFE
+  package: a3s
+  group: authn/issue
+  description: Additional issuing information for the SPIFFE source.
+  detached: true
+
+# Attributes
+attributes:
+  v1:
+  - name: token
+    description: The JWT-SVID to verify.
+    type: string
+    exposed: true
+    required: true
+    example_value: valid.jwt.svid
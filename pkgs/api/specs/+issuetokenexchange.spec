@@ -0,0 +1,31 @@
+# Model
+model:
+  rest_name: issuetokenexchange
+  resource_name: issuetokenexchange
+  entity_name: IssueTokenExchange
+  package: a3s
+  group: authn/issue
+  description: Additional issuing information for a token exchange.
+  detached: true
+
+# Attributes
+attributes:
+  v1:
+  - name: actorToken
+    description: |-
+      The token of the party acting on behalf of the subject. The bearer of the
+      token must be allowed to `delegate` on the `token` resource in the
+      restricted namespace of the requested token.
+    type: string
+    exposed: true
+    required: true
+    example_value: valid.jwt.token
+
+  - name: subjectToken
+    description: |-
+      The token of the subject the actor is acting on behalf of. The requested
+      audience must be declared in this token.
+    type: string
+    exposed: true
+    required: true
+    example_value: valid.jwt.token
@@ -0,0 +1,102 @@
+# Model
+model:
+  rest_name: awssource
+  resource_name: awssources
+  entity_name: AWSSource
+  package: a3s
+  group: authn/source
+  description: |-
+    A source allowing to trust AWS STS identities. Only the callers from the
+    allowed accounts, and using one of the allowed roles, can obtain a token.
+  get:
+    description: Get a particular awssource object.
+  update:
+    description: Update a particular awssource object.
+  delete:
+    description: Delete a particular awssource object.
+  extends:
+  - '@sharded'
+  - '@identifiable'
+  - '@importable'
+  - '@timed'
+  validations:
+  - $awssource
+
+# Indexes
+indexes:
+- - namespace
+  - name
+
+# Attributes
+attributes:
+  v1:
+  - name: allowedAccounts
+    description: |-
+      The list of AWS account IDs allowed to obtain a token. If empty, any account
+      is allowed, as long as allowedRoles is set.
+    type: list
+    exposed: true
+    subtype: string
+    stored: true
+    example_value:
+    - "123456789012"
+
+  - name: allowedRoles
+    description: |-
+      The list of patterns the ARN of the caller must match to obtain a token. The
+      character `*` matches any sequence of characters. For an assumed role, the
+      patterns are matched against both the assumed role ARN
+      (`arn:aws:sts::123456789012:assumed-role/admin/session`) and the ARN of the
+      role (`arn:aws:iam::123456789012:role/admin`). If empty, any role is
+      allowed, as long as allowedAccounts is set.
+    type: list
+    exposed: true
+    subtype: string
+    stored: true
+    example_value:
+    - arn:aws:iam::123456789012:role/admin
+
+  - name: description
+    description: The description of the object.
+    type: string
+    exposed: true
+    stored: true
+
+  - name: endpoint
+    description: |-
+      The URL of the STS endpoint to use, like a VPC endpoint. If left empty, the
+      regional endpoint of the region is used.
+    type: string
+    exposed: true
+    stored: true
+    example_value: https://sts.us-west-2.amazonaws.com
+
+  - name: modifier
+    description: |-
+      Contains optional information about a remote service that can be used to modify
+      the claims that are about to be delivered using this authentication source.
+    type: ref
+    exposed: true
+    subtype: identitymodifier
+    stored: true
+    omit_empty: true
+    extensions:
+      noInit: true
+      refMode: pointer
+
+  - name: name
+    description: The name of the source.
+    type: string
+    exposed: true
+    stored: true
+    required: true
+    example_value: myaws
+
+  - name: region
+    description: |-
+      The AWS region of the STS endpoint. If left empty, the global endpoint is
+      used.
+    type: string
+    exposed: true
+    stored: true
+    example_value: us-west-2
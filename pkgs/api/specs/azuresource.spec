@@ -0,0 +1,100 @@
+# Model
+model:
+  rest_name: azuresource
+  resource_name: azuresources
+  entity_name: AzureSource
+  package: a3s
+  group: authn/source
+  description: |-
+    A source allowing to trust Azure managed identity tokens. Only the tokens
+    issued by the allowed tenants, to identities of the allowed subscriptions,
+    can obtain a token.
+  get:
+    description: Get a particular azuresource object.
+  update:
+    description: Update a particular azuresource object.
+  delete:
+    description: Delete a particular azuresource object.
+  extends:
+  - '@sharded'
+  - '@identifiable'
+  - '@importable'
+  - '@timed'
+  validations:
+  - $azuresource
+
+# Indexes
+indexes:
+- - namespace
+  - name
+
+# Attributes
+attributes:
+  v1:
+  - name: allowedSubscriptions
+    description: |-
+      The list of Azure subscription IDs allowed to obtain a token. If empty, any
+      subscription is allowed, as long as allowedTenants is set.
+    type: list
+    exposed: true
+    subtype: string
+    stored: true
+    example_value:
+    - 00000000-0000-0000-0000-000000000000
+
+  - name: allowedTenants
+    description: |-
+      The list of Azure tenant IDs allowed to obtain a token. If empty, any tenant
+      is allowed, as long as allowedSubscriptions is set.
+    type: list
+    exposed: true
+    subtype: string
+    stored: true
+    example_value:
+    - 00000000-0000-0000-0000-000000000000
+
+  - name: audience
+    description: The audience the Azure token must have been issued for.
+    type: string
+    exposed: true
+    stored: true
+    required: true
+    default_value: https://management.azure.com/
+
+  - name: description
+    description: The description of the object.
+    type: string
+    exposed: true
+    stored: true
+
+  - name: keysURL
+    description: |-
+      The URL of the JWKS containing the keys used to verify the Azure tokens. The
+      keys are cached and refreshed when a token is signed by an unknown key.
+    type: string
+    exposed: true
+    stored: true
+    default_value: https://login.microsoftonline.com/common/discovery/keys
+    validations:
+    - $url
+
+  - name: modifier
+    description: |-
+      Contains optional information about a remote service that can be used to modify
+      the claims that are about to be delivered using this authentication source.
+    type: ref
+    exposed: true
+    subtype: identitymodifier
+    stored: true
+    omit_empty: true
+    extensions:
+      noInit: true
+      refMode: pointer
+
+  - name: name
+    description: The name of the source.
+    type: string
+    exposed: true
+    stored: true
+    required: true
+    example_value: myazure
@@ -0,0 +1,109 @@
+# Model
+model:
+  rest_name: gcpsource
+  resource_name: gcpsources
+  entity_name: GCPSource
+  package: a3s
+  group: authn/source
+  description: |-
+    A source allowing to trust GCP identity tokens. Only the tokens issued to
+    instances of the allowed projects can obtain a token.
+  get:
+    description: Get a particular gcpsource object.
+  update:
+    description: Update a particular gcpsource object.
+  delete:
+    description: Delete a particular gcpsource object.
+  extends:
+  - '@sharded'
+  - '@identifiable'
+  - '@importable'
+  - '@timed'
+  validations:
+  - $gcpsource
+
+# Indexes
+indexes:
+- - namespace
+  - name
+
+# Attributes
+attributes:
+  v1:
+  - name: allowedProjectIDs
+    description: |-
+      The list of GCP project IDs allowed to obtain a token. If empty, any project
+      ID is allowed, as long as allowedProjectNumbers is set.
+    type: list
+    exposed: true
+    subtype: string
+    stored: true
+    example_value:
+    - my-project
+
+  - name: allowedProjectNumbers
+    description: |-
+      The list of GCP project numbers allowed to obtain a token. If empty, any
+      project number is allowed, as long as allowedProjectIDs is set.
+    type: list
+    exposed: true
+    subtype: integer
+    stored: true
+    example_value:
+    - 123456789012
+
+  - name: audience
+    description: |-
+      The audience the GCP token must have been issued for. If left empty, the
+      audience passed by the caller is verified instead, if any.
+    type: string
+    exposed: true
+    stored: true
+    example_value: https://a3s.com
+
+  - name: description
+    description: The description of the object.
+    type: string
+    exposed: true
+    stored: true
+
+  - name: issuer
+    description: The issuer the GCP token must have been issued by.
+    type: string
+    exposed: true
+    stored: true
+    default_value: https://accounts.google.com
+    validations:
+    - $url
+
+  - name: keysURL
+    description: |-
+      The URL of the JWKS containing the keys used to verify the GCP tokens. The
+      keys are cached and refreshed when a token is signed by an unknown key.
+    type: string
+    exposed: true
+    stored: true
+    default_value: https://www.googleapis.com/oauth2/v3/certs
+    validations:
+    - $url
+
+  - name: modifier
+    description: |-
+      Contains optional information about a remote service that can be used to modify
+      the claims that are about to be delivered using this authentication source.
+    type: ref
+    exposed: true
+    subtype: identitymodifier
+    stored: true
+    omit_empty: true
+    extensions:
+      noInit: true
+      refMode: pointer
+
+  - name: name
+    description: The name of the source.
+    type: string
+    exposed: true
+    stored: true
+    required: true
+    example_value: mygcp
@@ -17,6 +17,27 @@ attributes:
     subtype: a3ssource
     omit_empty: true
 
+  - name: AWSSources
+    description: AWS sources to import.
+    type: refList
+    exposed: true
+    subtype: awssource
+    omit_empty: true
+
+  - name: AzureSources
+    description: Azure sources to import.
+    type: refList
+    exposed: true
+    subtype: azuresource
+    omit_empty: true
+
+  - name: GCPSources
+    description: GCP sources to import.
+    type: refList
+    exposed: true
+    subtype: gcpsource
+    omit_empty: true
+
   - name: HTTPSources
     description: HTTP sources to import.
     type: refList
@@ -24,6 +45,20 @@ attributes:
     subtype: httpsource
     omit_empty: true
 
+  - name: JWTSources
+    description: JWT sources to import.
+    type: refList
+    exposed: true
+    subtype: jwtsource
+    omit_empty: true
+
+  - name: KubernetesSources
+    description: Kubernetes sources to import.
+    type: refList
+    exposed: true
+    subtype: kubernetessource
+    omit_empty: true
+
   - name: LDAPSources
     description: LDAP sources to import.
     type: refList
@@ -31,6 +66,13 @@ attributes:
     subtype: ldapsource
     omit_empty: true
 
+  - name: LocalSources
+    description: Local sources to import.
+    type: refList
+    exposed: true
+    subtype: localsource
+    omit_empty: true
+
   - name: MTLSSources
     description: MTLS sources to import.
     type: refList
@@ -45,6 +87,20 @@ attributes:
     subtype: oidcsource
     omit_empty: true
 
+  - name: SAMLSources
+    description: SAML sources to import.
+    type: refList
+    exposed: true
+    subtype: samlsource
+    omit_empty: true
+
+  - name: SPIFFESources
+    description: SPIFFE sources to import.
+    type: refList
+    exposed: true
+    subtype: spiffesource
+    omit_empty: true
+
   - name: authorizations
     description: Authorizations to import.
     type: refList
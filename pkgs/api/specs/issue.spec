@@ -22,6 +22,16 @@ attributes:
     - https://mysecondapp
     omit_empty: true
 
+  - name: certificateBound
+    description: |-
+      If set, the delivered token will be bound to the client certificate used to
+      authenticate, as described by RFC 8705. A bound token can only be used by a
+      client presenting the same certificate. This is only supported by the MTLS
+      source type.
+    type: boolean
+    exposed: true
+    omit_empty: true
+
   - name: cloak
     description: |-
       Sets a list of identity claim prefix to allow in the final token. This can be
@@ -97,6 +107,26 @@ attributes:
       noInit: true
       refMode: pointer
 
+  - name: inputJWT
+    description: Contains additional information for a JWT source.
+    type: ref
+    exposed: true
+    subtype: issuejwt
+    omit_empty: true
+    extensions:
+      noInit: true
+      refMode: pointer
+
+  - name: inputKubernetes
+    description: Contains additional information for a Kubernetes service account token source.
+    type: ref
+    exposed: true
+    subtype: issuekubernetes
+    omit_empty: true
+    extensions:
+      noInit: true
+      refMode: pointer
+
   - name: inputLDAP
     description: Contains additional information for an LDAP source.
     type: ref
@@ -107,6 +137,26 @@ attributes:
       noInit: true
       refMode: pointer
 
+  - name: inputLocal
+    description: Contains additional information for a local source.
+    type: ref
+    exposed: true
+    subtype: issuelocal
+    omit_empty: true
+    extensions:
+      noInit: true
+      refMode: pointer
+
+  - name: inputMFA
+    description: Contains additional information to upgrade a token using a second factor.
+    type: ref
+    exposed: true
+    subtype: issuemfa
+    omit_empty: true
+    extensions:
+      noInit: true
+      refMode: pointer
+
   - name: inputOIDC
     description: Contains additional information for an OIDC source.
     type: ref
@@ -127,6 +177,36 @@ attributes:
       noInit: true
       refMode: pointer
 
+  - name: inputSAML
+    description: Contains additional information for a SAML source.
+    type: ref
+    exposed: true
+    subtype: issuesaml
+    omit_empty: true
+    extensions:
+      noInit: true
+      refMode: pointer
+
+  - name: inputSPIFFE
+    description: Contains additional information for a SPIFFE source.
+    type: ref
+    exposed: true
+    subtype: issuespiffe
+    omit_empty: true
+    extensions:
+      noInit: true
+      refMode: pointer
+
+  - name: inputTokenExchange
+    description: Contains additional information for a token exchange.
+    type: ref
+    exposed: true
+    subtype: issuetokenexchange
+    omit_empty: true
+    extensions:
+      noInit: true
+      refMode: pointer
+
   - name: opaque
     description: Opaque data that will be included in the issued token.
     type: external
@@ -134,6 +214,17 @@ attributes:
     subtype: map[string]string
     omit_empty: true
 
+  - name: refreshToken
+    description: |-
+      The next refresh token, issued when the input A3S token is a refresh token.
+      A refresh token can only be used once, and using it again revokes all the
+      refresh tokens of its family and all the tokens issued from them.
+    type: string
+    exposed: true
+    read_only: true
+    autogenerated: true
+    omit_empty: true
+
   - name: restrictedNamespace
     description: |-
       Restricts the namespace where the token can be used.
@@ -217,11 +308,17 @@ attributes:
     - Azure
     - GCP
     - HTTP
+    - JWT
+    - Kubernetes
     - LDAP
+    - Local
+    - MFA
     - MTLS
     - OIDC
     - RemoteA3S
     - SAML
+    - SPIFFE
+    - TokenExchange
     example_value: OIDC
 
   - name: token
@@ -233,12 +330,15 @@ attributes:
     omit_empty: true
 
   - name: tokenType
-    description: The type of token to issue.
+    description: |-
+      The type of token to issue. A Reference token is a short opaque token
+      referring to an identity token stored by a3s.
     type: enum
     exposed: true
     allowed_choices:
     - Identity
     - Refresh
+    - Reference
     default_value: Identity
     omit_empty: true
 
@@ -0,0 +1,135 @@
+# Model
+model:
+  rest_name: jwtsource
+  resource_name: jwtsources
+  entity_name: JWTSource
+  package: a3s
+  group: authn/source
+  description: |-
+    A source allowing to trust JWTs issued by an external system, like a CI
+    system or an identity provider. The tokens are verified using either the keys
+    published by the issuer, or a static JWKS.
+  get:
+    description: Get a particular jwtsource object.
+  update:
+    description: Update a particular jwtsource object.
+  delete:
+    description: Delete a particular jwtsource object.
+  extends:
+  - '@sharded'
+  - '@identifiable'
+  - '@importable'
+  - '@timed'
+  validations:
+  - $jwtsource
+
+# Indexes
+indexes:
+- - namespace
+  - name
+
+# Attributes
+attributes:
+  v1:
+  - name: CA
+    description: |-
+      The Certificate authority to use to validate the authenticity of the
+      JWKS server. If left empty, the system trust store will be used.
+    type: string
+    exposed: true
+    stored: true
+    validations:
+    - $pem
+
+  - name: JWKS
+    description: |-
+      A static JWKS containing the keys used to verify the tokens. If set,
+      `keysURL` is ignored and no key is retrieved from the issuer.
+    type: string
+    exposed: true
+    stored: true
+
+  - name: audience
+    description: The audience the tokens must have been issued for.
+    type: string
+    exposed: true
+    stored: true
+    required: true
+    example_value: a3s
+
+  - name: description
+    description: The description of the object.
+    type: string
+    exposed: true
+    stored: true
+
+  - name: ignoredKeys
+    description: |-
+      A list of claim keys that must not be imported into the identity token. If
+      `includedKeys` is also set, and a key is in both lists, the key will be ignored.
+    type: list
+    exposed: true
+    subtype: string
+    stored: true
+    omit_empty: true
+
+  - name: includedKeys
+    description: |-
+      A list of claim keys that must be imported into the identity token. If
+      `ignoredKeys` is also set, and a key is in both lists, the key will be ignored.
+    type: list
+    exposed: true
+    subtype: string
+    stored: true
+    omit_empty: true
+
+  - name: issuer
+    description: The issuer the tokens must have been issued by.
+    type: string
+    exposed: true
+    stored: true
+    required: true
+    example_value: https://token.actions.githubusercontent.com
+
+  - name: keysURL
+    description: |-
+      The URL of the JWKS containing the keys used to verify the tokens. If left
+      empty, it is discovered from the OpenID configuration of the issuer. The keys
+      are cached and refreshed when a token is signed by an unknown key.
+    type: string
+    exposed: true
+    stored: true
+    example_value: https://token.actions.githubusercontent.com/.well-known/jwks
+
+  - name: modifier
+    description: |-
+      Contains optional information about a remote service that can be used to modify
+      the claims that are about to be delivered using this authentication source.
+    type: ref
+    exposed: true
+    subtype: identitymodifier
+    stored: true
+    omit_empty: true
+    extensions:
+      noInit: true
+      refMode: pointer
+
+  - name: name
+    description: The name of the source.
+    type: string
+    exposed: true
+    stored: true
+    required: true
+    example_value: myjwt
+
+  - name: requiredClaims
+    description: |-
+      A list of claims the tokens must contain, in the form `key=value`. All of
+      them must be present for the token to be accepted.
+    type: list
+    exposed: true
+    subtype: string
+    stored: true
+    example_value:
+    - repository=org/repo
+    omit_empty: true
@@ -0,0 +1,136 @@
+# Model
+model:
+  rest_name: kubernetessource
+  resource_name: kubernetessources
+  entity_name: KubernetesSource
+  package: a3s
+  group: authn/source
+  description: |-
+    A source allowing to trust Kubernetes projected service account tokens. The
+    tokens are either verified using the keys published by the cluster, or sent
+    to the Kubernetes API server using a TokenReview.
+  get:
+    description: Get a particular kubernetessource object.
+  update:
+    description: Update a particular kubernetessource object.
+  delete:
+    description: Delete a particular kubernetessource object.
+  extends:
+  - '@sharded'
+  - '@identifiable'
+  - '@importable'
+  - '@timed'
+  validations:
+  - $kubernetessource
+
+# Indexes
+indexes:
+- - namespace
+  - name
+
+# Attributes
+attributes:
+  v1:
+  - name: CA
+    description: |-
+      The Certificate authority to use to validate the authenticity of the
+      Kubernetes API server or of the JWKS server. If left empty, the system trust
+      store will be used.
+    type: string
+    exposed: true
+    stored: true
+    validations:
+    - $pem
+
+  - name: audience
+    description: |-
+      The audience the service account token must have been issued for. Tokens
+      issued for any other audience, like the one of the Kubernetes API server,
+      are rejected.
+    type: string
+    exposed: true
+    stored: true
+    required: true
+    example_value: a3s
+
+  - name: description
+    description: The description of the object.
+    type: string
+    exposed: true
+    stored: true
+
+  - name: endpoint
+    description: |-
+      The URL of the Kubernetes API server. This is required when the mode is
+      TokenReview.
+    type: string
+    exposed: true
+    stored: true
+    example_value: https://kubernetes.default.svc
+
+  - name: issuer
+    description: |-
+      The issuer of the service account tokens, as configured on the Kubernetes
+      API server. This is required when the mode is JWKS.
+    type: string
+    exposed: true
+    stored: true
+    example_value: https://kubernetes.default.svc.cluster.local
+
+  - name: keysURL
+    description: |-
+      The URL of the JWKS containing the keys used to verify the service account
+      tokens. This is required when the mode is JWKS. The keys are cached and
+      refreshed when a token is signed by an unknown key.
+    type: string
+    exposed: true
+    stored: true
+    example_value: https://kubernetes.default.svc/openid/v1/jwks
+
+  - name: mode
+    description: |-
+      Defines how the service account tokens are verified. JWKS verifies the
+      signature locally using the keys published by the cluster. TokenReview asks
+      the Kubernetes API server to verify the token, which also rejects the tokens
+      of deleted pods or service accounts.
+    type: enum
+    exposed: true
+    stored: true
+    allowed_choices:
+    - JWKS
+    - TokenReview
+    default_value: JWKS
+
+  - name: modifier
+    description: |-
+      Contains optional information about a remote service that can be used to modify
+      the claims that are about to be delivered using this authentication source.
+    type: ref
+    exposed: true
+    subtype: identitymodifier
+    stored: true
+    omit_empty: true
+    extensions:
+      noInit: true
+      refMode: pointer
+
+  - name: name
+    description: The name of the source.
+    type: string
+    exposed: true
+    stored: true
+    required: true
+    example_value: mykubernetes
+
+  - name: reviewerToken
+    description: |-
+      The token used to authenticate to the Kubernetes API server when sending
+      TokenReviews. The associated service account must be allowed to create
+      tokenreviews. This is required when the mode is TokenReview.
+    type: string
+    exposed: true
+    stored: true
+    example_value: valid.jwt.token
+    secret: true
+    transient: true
+    encrypted: true
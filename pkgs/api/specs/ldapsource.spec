@@ -99,6 +99,56 @@ attributes:
     exposed: true
     stored: true
 
+  - name: failoverAddresses
+    description: |-
+      Additional IP addresses or FQDNs of LDAP servers. They are tried in order
+      when the server at `address` cannot be reached.
+    type: list
+    exposed: true
+    subtype: string
+    stored: true
+    example_value:
+    - ldap2.company.com
+    omit_empty: true
+
+  - name: groupBaseDN
+    description: |-
+      The base distinguished name (DN) to use to search the groups of the user. If
+      empty, the groups are not retrieved. Each group adds a `group=<cn>` claim.
+    type: string
+    exposed: true
+    stored: true
+    example_value: ou=groups,dc=universe,dc=io
+    omit_empty: true
+
+  - name: groupMemberAttribute
+    description: |-
+      The attribute of the group entries containing the DN of their members. For
+      `groupOfUniqueNames` entries, the value should be `uniqueMember`.
+    type: string
+    exposed: true
+    stored: true
+    default_value: member
+
+  - name: groupNestingDepth
+    description: |-
+      The maximum depth of nested groups to resolve. If 0, only the groups the user
+      is a direct member of are retrieved.
+    type: integer
+    exposed: true
+    stored: true
+    max_value: 10
+    min_value: 0
+
+  - name: groupSearchFilter
+    description: |-
+      The filter to use to locate the group entries. The entries must also have the
+      DN of the member in their `groupMemberAttribute`.
+    type: string
+    exposed: true
+    stored: true
+    default_value: objectClass=groupOfNames
+
   - name: ignoredKeys
     description: |-
       A list of keys that must not be imported into the identity token. If
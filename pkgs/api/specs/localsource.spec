@@ -0,0 +1,105 @@
+# Model
+model:
+  rest_name: localsource
+  resource_name: localsources
+  entity_name: LocalSource
+  package: a3s
+  group: authn/source
+  description: |-
+    A source allowing to authenticate the local users stored in a3s, using their
+    username and password. The source defines how the passwords are hashed and
+    the policy they must comply with when they are set.
+  get:
+    description: Get a particular localsource object.
+  update:
+    description: Update a particular localsource object.
+  delete:
+    description: Delete a particular localsource object.
+  extends:
+  - '@sharded'
+  - '@identifiable'
+  - '@importable'
+  - '@timed'
+
+# Indexes
+indexes:
+- - namespace
+  - name
+
+# Attributes
+attributes:
+  v1:
+  - name: description
+    description: The description of the object.
+    type: string
+    exposed: true
+    stored: true
+
+  - name: hashAlgorithm
+    description: |-
+      The algorithm used to hash the passwords. Changing it does not affect the
+      existing passwords, which keep being verified with the algorithm they have
+      been hashed with until they are changed.
+    type: enum
+    exposed: true
+    stored: true
+    allowed_choices:
+    - Argon2id
+    - Bcrypt
+    default_value: Argon2id
+
+  - name: modifier
+    description: |-
+      Contains optional information about a remote service that can be used to modify
+      the claims that are about to be delivered using this authentication source.
+    type: ref
+    exposed: true
+    subtype: identitymodifier
+    stored: true
+    omit_empty: true
+    extensions:
+      noInit: true
+      refMode: pointer
+
+  - name: name
+    description: The name of the source.
+    type: string
+    exposed: true
+    stored: true
+    required: true
+    example_value: mysource
+
+  - name: passwordMinLength
+    description: The minimum number of characters a password must contain.
+    type: integer
+    exposed: true
+    stored: true
+    default_value: 12
+    min_value: 1
+    max_value: 72
+
+  - name: passwordRequireDigits
+    description: If set, passwords must contain at least one digit.
+    type: boolean
+    exposed: true
+    stored: true
+
+  - name: passwordRequireLowercase
+    description: If set, passwords must contain at least one lowercase letter.
+    type: boolean
+    exposed: true
+    stored: true
+
+  - name: passwordRequireSymbols
+    description: |-
+      If set, passwords must contain at least one character that is neither a
+      letter nor a digit.
+    type: boolean
+    exposed: true
+    stored: true
+
+  - name: passwordRequireUppercase
+    description: If set, passwords must contain at least one uppercase letter.
+    type: boolean
+    exposed: true
+    stored: true
@@ -0,0 +1,95 @@
+# Model
+model:
+  rest_name: localuser
+  resource_name: localusers
+  entity_name: LocalUser
+  package: a3s
+  group: authn/localuser
+  description: |-
+    A user stored in a3s, that can authenticate using a local source. The
+    password is never stored nor returned: only its hash is kept. Once the user is
+    created, the password can only be changed using a password change or a
+    password reset.
+  get:
+    description: Retrieves the local user with the given ID.
+  update:
+    description: Updates the local user with the given ID.
+  delete:
+    description: Deletes the local user with the given ID.
+  extends:
+  - '@sharded'
+  - '@identifiable'
+  - '@timed'
+
+# Indexes
+indexes:
+- - namespace
+  - sourceName
+  - username
+
+# Attributes
+attributes:
+  v1:
+  - name: claims
+    description: |-
+      Additional claims that will be added to the tokens delivered to the user.
+      They must be in the form `key=value`.
+    type: list
+    exposed: true
+    subtype: string
+    stored: true
+    example_value:
+    - team=blue
+    - email=joe@example.com
+    validations:
+    - $local_user_claims
+
+  - name: description
+    description: The description of the object.
+    type: string
+    exposed: true
+    stored: true
+
+  - name: password
+    description: |-
+      The password of the user. It must comply with the password policy of the
+      local source. It is only used to compute the password hash, and is never
+      returned.
+    type: string
+    exposed: true
+    secret: true
+    creation_only: true
+    transient: true
+    example_value: s3cr3t-Passw0rd
+    omit_empty: true
+
+  - name: passwordHash
+    description: The hash of the password of the user.
+    type: string
+    stored: true
+
+  - name: passwordUpdateTime
+    description: Last time the password of the user has been set.
+    type: time
+    exposed: true
+    stored: true
+    read_only: true
+    autogenerated: true
+
+  - name: sourceName
+    description: The name of the local source the user belongs to.
+    type: string
+    exposed: true
+    stored: true
+    required: true
+    creation_only: true
+    example_value: mysource
+
+  - name: username
+    description: The username of the user.
+    type: string
+    exposed: true
+    stored: true
+    required: true
+    creation_only: true
+    example_value: joe
@@ -0,0 +1,126 @@
+# Model
+model:
+  rest_name: mfacredential
+  resource_name: mfacredentials
+  entity_name: MFACredential
+  package: a3s
+  group: authn/mfa
+  description: |-
+    A second factor enrolled by a user, either a TOTP secret or a WebAuthn
+    credential. It is bound to the identity claims of the token that enrolled it,
+    and lives in the namespace of the source of that token. Credentials are
+    enrolled by the users themselves using an MFA enrollment, and can then be used
+    to upgrade their tokens using an issue request of type `MFA`. Administrators
+    can list, rename or delete them.
+  get:
+    description: Retrieves the MFA credential with the given ID.
+  update:
+    description: Updates the MFA credential with the given ID.
+  delete:
+    description: Deletes the MFA credential with the given ID.
+  extends:
+  - '@sharded'
+  - '@identifiable'
+  - '@timed'
+
+# Indexes
+indexes:
+- - namespace
+  - subject
+- - namespace
+  - webAuthnCredentialID
+
+# Attributes
+attributes:
+  v1:
+  - name: TOTPLastStep
+    description: |-
+      The last TOTP period a code has been accepted for, used to prevent code
+      replays.
+    type: integer
+    stored: true
+
+  - name: TOTPSecret
+    description: The TOTP secret.
+    type: string
+    stored: true
+    encrypted: true
+
+  - name: active
+    description: |-
+      If true, the credential has been verified and can be used. TOTP credentials
+      are activated once a first code has been verified.
+    type: boolean
+    exposed: true
+    stored: true
+    read_only: true
+    autogenerated: true
+
+  - name: description
+    description: The description of the object.
+    type: string
+    exposed: true
+    stored: true
+
+  - name: lastUsedTime
+    description: Last time the credential has been used to upgrade a token.
+    type: time
+    exposed: true
+    stored: true
+    read_only: true
+    autogenerated: true
+
+  - name: name
+    description: The name of the credential.
+    type: string
+    exposed: true
+    stored: true
+    required: true
+    example_value: my phone
+
+  - name: subject
+    description: |-
+      The claims the credential is bound to: the @source claims and the claim
+      identifying the user in the source. The credential can only be used with
+      tokens having the same ones.
+    type: list
+    exposed: true
+    subtype: string
+    stored: true
+    read_only: true
+    autogenerated: true
+    example_value:
+    - '@source:name=mysource'
+    - '@source:namespace=/my/ns'
+    - '@source:type=local'
+    - username=joe
+
+  - name: type
+    description: The type of the credential.
+    type: enum
+    exposed: true
+    stored: true
+    read_only: true
+    autogenerated: true
+    allowed_choices:
+    - TOTP
+    - WebAuthn
+
+  - name: webAuthnCredentialID
+    description: The base64url encoded ID of the WebAuthn credential.
+    type: string
+    exposed: true
+    stored: true
+    read_only: true
+    autogenerated: true
+    omit_empty: true
+
+  - name: webAuthnPublicKey
+    description: The base64 encoded PKIX public key of the WebAuthn credential.
+    type: string
+    stored: true
+
+  - name: webAuthnSignCount
+    description: The last signature counter returned by the WebAuthn authenticator.
+    type: integer
+    stored: true
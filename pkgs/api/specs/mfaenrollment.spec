@@ -0,0 +1,77 @@
+# Model
+model:
+  rest_name: mfaenrollment
+  resource_name: mfaenrollments
+  entity_name: MFAEnrollment
+  package: a3s
+  group: authn/mfa
+  description: |-
+    Allows the bearer of a token to enroll a second factor bound to its identity
+    claims. The enrollment is done in two steps. For a TOTP secret, the first step
+    returns the `otpauth` URI of the secret and the ID of the pending credential,
+    and the second step activates it using that ID and a first TOTP code. For a
+    WebAuthn credential, the first step returns the options to pass to
+    `navigator.credentials.create()`, and the second step registers the returned
+    credential. If the bearer already has an active second factor, the token must
+    have been upgraded using one of them.
+
+# Attributes
+attributes:
+  v1:
+  - name: TOTP
+    description: A TOTP code generated using the pending TOTP secret.
+    type: string
+    exposed: true
+    example_value: "123456"
+    omit_empty: true
+
+  - name: TOTPURI
+    description: |-
+      The `otpauth` URI of the pending TOTP secret, to register in an
+      authenticator app.
+    type: string
+    exposed: true
+    read_only: true
+    autogenerated: true
+    omit_empty: true
+
+  - name: credentialID
+    description: The ID of the pending TOTP credential, or of the enrolled credential.
+    type: string
+    exposed: true
+    omit_empty: true
+
+  - name: name
+    description: The name of the credential.
+    type: string
+    exposed: true
+    example_value: my phone
+    omit_empty: true
+
+  - name: type
+    description: The type of second factor to enroll.
+    type: enum
+    exposed: true
+    required: true
+    allowed_choices:
+    - TOTP
+    - WebAuthn
+    example_value: TOTP
+
+  - name: webAuthnOptions
+    description: |-
+      The JSON encoded options to pass to `navigator.credentials.create()`, with
+      the binary fields base64url encoded.
+    type: string
+    exposed: true
+    read_only: true
+    autogenerated: true
+    omit_empty: true
+
+  - name: webAuthnResponse
+    description: |-
+      The JSON encoded credential returned by `navigator.credentials.create()`,
+      with the binary fields base64url encoded.
+    type: string
+    exposed: true
+    omit_empty: true
@@ -49,12 +49,40 @@ attributes:
     validations:
     - $pem
 
+  - name: CRL
+    description: |-
+      Optional certificate revocation lists in PEM format. User certificates that
+      have been revoked by one of these lists will be rejected.
+    type: string
+    exposed: true
+    stored: true
+    validations:
+    - $crl
+
+  - name: checkOCSP
+    description: |-
+      If set, the OCSP responders listed in the user certificates will be queried
+      and the certificates will be rejected if they have been revoked, or if their
+      status cannot be determined.
+    type: boolean
+    exposed: true
+    stored: true
+
   - name: description
     description: The description of the object.
     type: string
     exposed: true
     stored: true
 
+  - name: fetchCRL
+    description: |-
+      If set, the certificate revocation lists published at the distribution points
+      listed in the user certificates will be retrieved and checked. They are cached
+      until their next update.
+    type: boolean
+    exposed: true
+    stored: true
+
   - name: fingerprints
     description: The fingerprint of the CAs in the chain.
     type: list
@@ -93,3 +121,15 @@ attributes:
     stored: true
     read_only: true
     autogenerated: true
+
+  - name: trustDomain
+    description: |-
+      If set, the user certificates must contain a SPIFFE ID in their URI SANs
+      belonging to the given trust domain.
+    type: string
+    exposed: true
+    stored: true
+    example_value: example.org
+    allowed_chars: ^[a-z0-9._-]+$
+    allowed_chars_message: must only contain lower case letters, digits, '.', '-'
+      or '_'
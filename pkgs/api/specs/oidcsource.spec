@@ -18,6 +18,8 @@ model:
   - '@identifiable'
   - '@importable'
   - '@timed'
+  validations:
+  - $oidcsource
 
 # Indexes
 indexes:
@@ -38,6 +40,20 @@ attributes:
     validations:
     - $pem
 
+  - name: claimMapping
+    description: |-
+      Renames the keys of the claims. Nested claims are flattened into dotted keys,
+      and filtered by `includedKeys` and `ignoredKeys` before being renamed. Like
+      for the filters, keys are matched case insensitively. The new keys cannot start
+      with `@`, as it is reserved.
+    type: external
+    exposed: true
+    subtype: map[string]string
+    stored: true
+    example_value:
+      realm_access.roles: role
+    omit_empty: true
+
   - name: clientID
     description: Unique client ID.
     type: string
@@ -47,11 +63,12 @@ attributes:
     example_value: 12345677890.apps.googleusercontent.com
 
   - name: clientSecret
-    description: Client secret associated with the client ID.
+    description: |-
+      Client secret associated with the client ID. If empty, the source is used
+      as a public client and the ceremony relies on PKCE only.
     type: string
     exposed: true
     stored: true
-    required: true
     example_value: Ytgbfjtj4652jHDFGls99jF
     encrypted: true
 
@@ -71,6 +88,35 @@ attributes:
     required: true
     example_value: https://accounts.google.com
 
+  - name: fetchUserInfo
+    description: |-
+      If set, the claims returned by the userinfo endpoint of the OIDC provider are
+      merged with the claims of the ID token. The claims of the ID token take
+      precedence.
+    type: boolean
+    exposed: true
+    stored: true
+
+  - name: ignoredKeys
+    description: |-
+      A list of keys that must not be imported into the identity token. If
+      `includedKeys` is also set, and a key is in both lists, the key will be ignored.
+    type: list
+    exposed: true
+    subtype: string
+    stored: true
+    omit_empty: true
+
+  - name: includedKeys
+    description: |-
+      A list of keys that must be imported into the identity token. If `ignoredKeys`
+      is also set, and a key is in both lists, the key will be ignored.
+    type: list
+    exposed: true
+    subtype: string
+    stored: true
+    omit_empty: true
+
   - name: modifier
     description: |-
       Contains optional information about a remote service that can be used to modify
@@ -0,0 +1,49 @@
+# Model
+model:
+  rest_name: passwordchange
+  resource_name: passwordchanges
+  entity_name: PasswordChange
+  package: a3s
+  group: authn/localuser
+  description: |-
+    Allows a local user to change their password, by providing their current
+    password. The new password must comply with the password policy of the local
+    source. This API does not require to be authenticated.
+
+# Attributes
+attributes:
+  v1:
+  - name: currentPassword
+    description: The current password of the user.
+    type: string
+    exposed: true
+    required: true
+    example_value: s3cr3t-Passw0rd
+
+  - name: newPassword
+    description: The new password of the user.
+    type: string
+    exposed: true
+    required: true
+    example_value: n3w-s3cr3t-Passw0rd
+
+  - name: sourceName
+    description: The name of the local source the user belongs to.
+    type: string
+    exposed: true
+    required: true
+    example_value: mysource
+
+  - name: sourceNamespace
+    description: The namespace of the local source the user belongs to.
+    type: string
+    exposed: true
+    required: true
+    example_value: /my/ns
+
+  - name: username
+    description: The username of the user.
+    type: string
+    exposed: true
+    required: true
+    example_value: joe
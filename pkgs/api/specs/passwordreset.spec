@@ -0,0 +1,35 @@
+# Model
+model:
+  rest_name: passwordreset
+  resource_name: passwordresets
+  entity_name: PasswordReset
+  package: a3s
+  group: authn/localuser
+  description: |-
+    Sets a new password for a local user living in the current namespace,
+    without knowing their current password. The new password must comply with the
+    password policy of the local source.
+
+# Attributes
+attributes:
+  v1:
+  - name: newPassword
+    description: The new password of the user.
+    type: string
+    exposed: true
+    required: true
+    example_value: n3w-s3cr3t-Passw0rd
+
+  - name: sourceName
+    description: The name of the local source the user belongs to.
+    type: string
+    exposed: true
+    required: true
+    example_value: mysource
+
+  - name: username
+    description: The username of the user.
+    type: string
+    exposed: true
+    required: true
+    example_value: joe
@@ -0,0 +1,121 @@
+# Model
+model:
+  rest_name: revocation
+  resource_name: revocations
+  entity_name: Revocation
+  package: a3s
+  group: authn/revocation
+  description: |-
+    A revocation prevents tokens from being used before they expire. A token can
+    be revoked by its ID (the `jti` claim), by the family of refresh tokens it
+    has been issued from, by a tag expression matching its identity claims, or by
+    the source that issued it. Revocations by subject or by source only apply to
+    tokens issued before the revocation was created, by sources living in the
+    namespace of the revocation or one of its children. Revocation records are
+    automatically deleted once all the tokens they cover have expired.
+  get:
+    description: Retrieves the revocation with the given ID.
+  delete:
+    description: Deletes the revocation with the given ID.
+  extends:
+  - '@sharded'
+  - '@identifiable'
+  - '@timed'
+  validations:
+  - $revocation
+
+# Indexes
+indexes:
+- - namespace
+  - tokenFamily
+- - namespace
+  - tokenID
+
+# Attributes
+attributes:
+  v1:
+  - name: description
+    description: Description of the revocation.
+    type: string
+    exposed: true
+    stored: true
+
+  - name: expiration
+    description: |-
+      Date after which the revocation is deleted. It can only be set for
+      revocations by token ID, and should be the expiration date of the token. It
+      is always capped to the maximum validity of a token. Revocations by subject or
+      by source always last for the maximum validity of a token.
+    type: time
+    exposed: true
+    stored: true
+    creation_only: true
+
+  - name: sourceName
+    description: The name of the source whose tokens must be revoked.
+    type: string
+    exposed: true
+    stored: true
+    creation_only: true
+    example_value: mysource
+
+  - name: sourceNamespace
+    description: |-
+      The namespace of the source whose tokens must be revoked. If empty, the
+      namespace of the revocation is used.
+    type: string
+    exposed: true
+    stored: true
+    creation_only: true
+    example_value: /my/namespace
+
+  - name: sourceType
+    description: The type of the source whose tokens must be revoked.
+    type: string
+    exposed: true
+    stored: true
+    creation_only: true
+    example_value: mtls
+
+  - name: subject
+    description: A tag expression that identifies the revoked identities.
+    type: external
+    exposed: true
+    subtype: '[][]string'
+    stored: true
+    creation_only: true
+    validations:
+    - $tags_expression
+    - $authorization_subject
+
+  - name: token
+    description: |-
+      The token to revoke. If set, the token is revoked by its ID, and it must have
+      been issued by a source living in the namespace of the revocation or one of
+      its children. The token itself is not stored.
+    type: string
+    exposed: true
+    creation_only: true
+    omit_empty: true
+    example_value: valid.jwt.token
+
+  - name: tokenFamily
+    description: |-
+      The ID of a family of refresh tokens to revoke. All the refresh tokens of the
+      family and all the tokens issued from them are revoked.
+    type: string
+    exposed: true
+    stored: true
+    creation_only: true
+    example_value: 2a3c5d3e-0a4f-4c3d-9a4e-5d4b3c2a1f0e
+
+  - name: tokenID
+    description: |-
+      The ID of the token to revoke, as found in its `jti` claim. The revocation
+      only applies to the tokens issued by sources living in the namespace of the
+      revocation or one of its children.
+    type: string
+    exposed: true
+    stored: true
+    creation_only: true
+    example_value: 2a3c5d3e-0a4f-4c3d-9a4e-5d4b3c2a1f0e
@@ -30,6 +30,30 @@ relations:
   create:
     description: Sends a authz request.
 
+- rest_name: awssource
+  get:
+    description: Retrieves the list of awssources.
+    global_parameters:
+    - $queryable
+  create:
+    description: Creates a new awssource.
+
+- rest_name: azuresource
+  get:
+    description: Retrieves the list of azuresources.
+    global_parameters:
+    - $queryable
+  create:
+    description: Creates a new azuresource.
+
+- rest_name: gcpsource
+  get:
+    description: Retrieves the list of gcpsources.
+    global_parameters:
+    - $queryable
+  create:
+    description: Creates a new gcpsource.
+
 - rest_name: httpsource
   get:
     description: Retrieves the list of httpsources.
@@ -51,6 +75,22 @@ relations:
   create:
     description: Ask to issue a new authentication token.
 
+- rest_name: jwtsource
+  get:
+    description: Retrieves the list of jwtsources.
+    global_parameters:
+    - $queryable
+  create:
+    description: Creates a new jwtsource.
+
+- rest_name: kubernetessource
+  get:
+    description: Retrieves the list of kubernetessources.
+    global_parameters:
+    - $queryable
+  create:
+    description: Creates a new kubernetessource.
+
 - rest_name: ldapsource
   get:
     description: Retrieves the list of ldapsources.
@@ -59,6 +99,32 @@ relations:
   create:
     description: Creates a new ldapsource.
 
+- rest_name: localsource
+  get:
+    description: Retrieves the list of localsources.
+    global_parameters:
+    - $queryable
+  create:
+    description: Creates a new localsource.
+
+- rest_name: localuser
+  get:
+    description: Retrieves the list of local users.
+    global_parameters:
+    - $queryable
+  create:
+    description: Creates a new local user.
+
+- rest_name: mfacredential
+  get:
+    description: Retrieves the list of MFA credentials.
+    global_parameters:
+    - $queryable
+
+- rest_name: mfaenrollment
+  create:
+    description: Enrolls a second factor for the bearer of the token.
+
 - rest_name: mtlssource
   get:
     description: Retrieves the list of mtlssources.
@@ -89,6 +155,42 @@ relations:
   create:
     description: Creates a new oidcsource.
 
+- rest_name: passwordchange
+  create:
+    description: Changes the password of a local user.
+
+- rest_name: passwordreset
+  create:
+    description: Resets the password of a local user.
+
 - rest_name: permissions
   create:
     description: Sends a permissions request.
+
+- rest_name: revocation
+  get:
+    description: Retrieves the list of revocations.
+    global_parameters:
+    - $queryable
+  create:
+    description: Creates a new revocation.
+
+- rest_name: samlsource
+  get:
+    description: Retrieves the list of samlsources.
+    global_parameters:
+    - $queryable
+  create:
+    description: Creates a new samlsource.
+
+- rest_name: spiffesource
+  get:
+    description: Retrieves the list of spiffesources.
+    global_parameters:
+    - $queryable
+  create:
+    description: Creates a new spiffesource.
+
+- rest_name: signingkey
+  get:
+    description: Retrieves the list of signing keys and their rotation state.
@@ -0,0 +1,105 @@
+# Model
+model:
+  rest_name: samlsource
+  resource_name: samlsources
+  entity_name: SAMLSource
+  package: a3s
+  group: authn/source
+  description: |-
+    A source allowing to trust a SAML 2.0 identity provider. A3S acts as the
+    service provider, and serves its metadata and its assertion consumer service.
+  get:
+    description: Get a particular samlsource object.
+  update:
+    description: Update a particular samlsource object.
+  delete:
+    description: Delete a particular samlsource object.
+  extends:
+  - '@sharded'
+  - '@identifiable'
+  - '@importable'
+  - '@timed'
+  validations:
+  - $samlsource
+
+# Indexes
+indexes:
+- - namespace
+  - name
+
+# Attributes
+attributes:
+  v1:
+  - name: IDPMetadata
+    description: |-
+      The XML metadata of the identity provider. It contains the entity ID of the
+      identity provider, the URL of its single sign-on service, and the
+      certificates used to sign the assertions.
+    type: string
+    exposed: true
+    stored: true
+    required: true
+    example_value: <EntityDescriptor>...</EntityDescriptor>
+
+  - name: allowedRedirectURLs
+    description: |-
+      The URLs the users can be redirected to during a SAML ceremony. The
+      redirectURL and redirectErrorURL given to start a ceremony must be one of
+      them.
+    type: list
+    exposed: true
+    subtype: string
+    stored: true
+    example_value:
+    - https://app.example.com/login
+    omit_empty: true
+
+  - name: attributeMapping
+    description: |-
+      Maps the names of the SAML attributes to the keys of the claims. Attributes
+      that are not mapped use their friendly name if they have one, or their name
+      otherwise. The leading `@` of the keys are removed, as they are reserved.
+    type: external
+    exposed: true
+    subtype: map[string]string
+    stored: true
+    example_value:
+      urn:oid:0.9.2342.19200300.100.1.3: email
+    omit_empty: true
+
+  - name: description
+    description: The description of the object.
+    type: string
+    exposed: true
+    stored: true
+
+  - name: entityID
+    description: |-
+      The entity ID of A3S as a service provider. The assertions must have been
+      issued for this audience. If left empty, the URL of the service provider
+      metadata is used.
+    type: string
+    exposed: true
+    stored: true
+    example_value: https://a3s.example.com/saml
+
+  - name: modifier
+    description: |-
+      Contains optional information about a remote service that can be used to modify
+      the claims that are about to be delivered using this authentication source.
+    type: ref
+    exposed: true
+    subtype: identitymodifier
+    stored: true
+    omit_empty: true
+    extensions:
+      noInit: true
+      refMode: pointer
+
+  - name: name
+    description: The name of the source.
+    type: string
+    exposed: true
+    stored: true
+    required: true
+    example_value: mysaml
@@ -0,0 +1,81 @@
+# Model
+model:
+  rest_name: signingkey
+  resource_name: signingkeys
+  entity_name: SigningKey
+  package: a3s
+  group: authn/issue
+  description: |-
+    Read only view of a key used to sign the tokens delivered by a3s, along with
+    its rotation state. A key is published in the JWKS before it becomes active
+    and stays published after it retires, so tokens signed with it can still be
+    verified until they expire.
+
+# Attributes
+attributes:
+  v1:
+  - name: activateTime
+    description: Date from which the key is used to sign new tokens.
+    type: time
+    exposed: true
+    read_only: true
+    autogenerated: true
+    omit_empty: true
+
+  - name: algorithm
+    description: The algorithm of the key.
+    type: string
+    exposed: true
+    read_only: true
+    autogenerated: true
+    example_value: ES256
+
+  - name: expireTime
+    description: Date from which the key is removed from the JWKS.
+    type: time
+    exposed: true
+    read_only: true
+    autogenerated: true
+    omit_empty: true
+
+  - name: kid
+    description: The ID of the key, as found in the `kid` header of the tokens.
+    type: string
+    exposed: true
+    read_only: true
+    autogenerated: true
+    example_value: 4F3DBF3B0E8E1E6C8F1D7A1A6C0E52C8A2C4B3B9A0E8E1E6C8F1D7A1A6C0E52C
+
+  - name: publishTime
+    description: Date from which the key is published in the JWKS.
+    type: time
+    exposed: true
+    read_only: true
+    autogenerated: true
+    omit_empty: true
+
+  - name: retireTime
+    description: Date from which the key is not used to sign new tokens anymore.
+    type: time
+    exposed: true
+    read_only: true
+    autogenerated: true
+    omit_empty: true
+
+  - name: state
+    description: |-
+      The current state of the key. `Pending` keys are not published yet,
+      `Published` keys are in the JWKS but not used to sign yet, `Active` keys are
+      used to sign new tokens, `Retired` keys are only used for verification and
+      `Expired` keys are not published anymore.
+    type: enum
+    exposed: true
+    read_only: true
+    autogenerated: true
+    allowed_choices:
+    - Pending
+    - Published
+    - Active
+    - Retired
+    - Expired
+    example_value: Active
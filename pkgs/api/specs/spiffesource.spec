@@ -0,0 +1,107 @@
+# Model
+model:
+  rest_name: spiffesource
+  resource_name: spiffesources
+  entity_name: SPIFFESource
+  package: a3s
+  group: authn/source
+  description: |-
+    A source allowing workloads to authenticate using a SPIFFE JWT-SVID. The
+    JWT-SVIDs are verified using the trust bundle of the trust domain, either
+    given statically or retrieved from a bundle endpoint.
+  get:
+    description: Get a particular spiffesource object.
+  update:
+    description: Update a particular spiffesource object.
+  delete:
+    description: Delete a particular spiffesource object.
+  extends:
+  - '@sharded'
+  - '@identifiable'
+  - '@importable'
+  - '@timed'
+  validations:
+  - $spiffesource
+
+# Indexes
+indexes:
+- - namespace
+  - name
+
+# Attributes
+attributes:
+  v1:
+  - name: CA
+    description: |-
+      The Certificate authority to use to validate the authenticity of the
+      bundle endpoint. If left empty, the system trust store will be used.
+    type: string
+    exposed: true
+    stored: true
+    validations:
+    - $pem
+
+  - name: audience
+    description: The audience the JWT-SVIDs must have been issued for.
+    type: string
+    exposed: true
+    stored: true
+    required: true
+    example_value: a3s
+
+  - name: bundle
+    description: |-
+      A static SPIFFE trust bundle of the trust domain, in JWKS format. Only the
+      keys with the `jwt-svid` use are used to verify the JWT-SVIDs. Either this or
+      `bundleEndpointURL` must be set.
+    type: string
+    exposed: true
+    stored: true
+
+  - name: bundleEndpointURL
+    description: |-
+      The URL of the SPIFFE bundle endpoint of the trust domain. The bundle is
+      retrieved using the https_web profile and refreshed according to its refresh
+      hint. Either this or `bundle` must be set.
+    type: string
+    exposed: true
+    stored: true
+    example_value: https://spire.example.org/bundle
+
+  - name: description
+    description: The description of the object.
+    type: string
+    exposed: true
+    stored: true
+
+  - name: modifier
+    description: |-
+      Contains optional information about a remote service that can be used to modify
+      the claims that are about to be delivered using this authentication source.
+    type: ref
+    exposed: true
+    subtype: identitymodifier
+    stored: true
+    omit_empty: true
+    extensions:
+      noInit: true
+      refMode: pointer
+
+  - name: name
+    description: The name of the source.
+    type: string
+    exposed: true
+    stored: true
+    required: true
+    example_value: myspiffe
+
+  - name: trustDomain
+    description: The trust domain the SPIFFE IDs of the JWT-SVIDs must belong to.
+    type: string
+    exposed: true
+    stored: true
+    required: true
+    example_value: example.org
+    allowed_chars: ^[a-z0-9._-]+$
+    allowed_chars_message: must only contain lower case letters, digits, '.', '-'
+      or '_'
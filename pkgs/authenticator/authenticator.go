@@ -6,11 +6,14 @@ import (
 	"crypto/x509"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/karlseguin/ccache/v2"
+	"go.aporeto.io/a3s/pkgs/revocation"
 	"go.aporeto.io/a3s/pkgs/token"
 	"go.aporeto.io/bahamut"
+	"go.aporeto.io/bahamut/authorizer/mtls"
 	"go.aporeto.io/elemental"
 )
 
@@ -23,6 +26,10 @@ type Authenticator struct {
 	audience               string
 	ignoredResources       map[string]struct{}
 	trustedJWKsCache       *ccache.Cache
+	revocations            *revocation.Cache
+	mtlsHeaderKey          string
+	mtlsHeaderPass         string
+	references             token.ReferenceResolver
 }
 
 // New returns a new Authenticator that will use the provided JWKS
@@ -53,13 +60,22 @@ func New(jwks *token.JWKS, issuer string, audience string, options ...Option) *A
 		ignoredResources:       ignored,
 		externalTrustedIssuers: trusted,
 		trustedJWKsCache:       ccache.New(ccache.Configure().MaxSize(1024)),
+		revocations:            cfg.revocations,
+		mtlsHeaderKey:          cfg.mtlsHeaderKey,
+		mtlsHeaderPass:         cfg.mtlsHeaderPass,
+		references:             cfg.references,
 	}
 }
 
 // AuthenticateSession authenticates the given session.
 func (a *Authenticator) AuthenticateSession(session bahamut.Session) (bahamut.AuthAction, error) {
 
-	action, claims, err := a.commonAuth(session.Context(), token.FromSession(session))
+	var tlsHeader string
+	if a.mtlsHeaderKey != "" {
+		tlsHeader = session.Header(a.mtlsHeaderKey)
+	}
+
+	action, claims, err := a.commonAuth(session.Context(), token.FromSession(session), session.TLSConnectionState(), tlsHeader)
 	if err != nil {
 		return bahamut.AuthActionKO, err
 	}
@@ -78,7 +94,12 @@ func (a *Authenticator) AuthenticateRequest(bctx bahamut.Context) (bahamut.AuthA
 
 	token := token.FromRequest(bctx.Request())
 
-	action, claims, err := a.commonAuth(bctx.Context(), token)
+	var tlsHeader string
+	if a.mtlsHeaderKey != "" {
+		tlsHeader = bctx.Request().Headers.Get(a.mtlsHeaderKey)
+	}
+
+	action, claims, err := a.commonAuth(bctx.Context(), token, bctx.Request().TLSConnectionState, tlsHeader)
 	if err != nil {
 		return bahamut.AuthActionKO, err
 	}
@@ -88,10 +109,103 @@ func (a *Authenticator) AuthenticateRequest(bctx bahamut.Context) (bahamut.AuthA
 	return action, nil
 }
 
-func (a *Authenticator) commonAuth(ctx context.Context, tokenString string) (bahamut.AuthAction, []string, error) {
+// AuthenticateHTTPRequest authenticates the given http.Request using the token
+// found in the x-a3s-token cookie or in the Authorization header, and returns
+// the verified IdentityToken. It can be used to protect custom routes.
+func (a *Authenticator) AuthenticateHTTPRequest(req *http.Request) (*token.IdentityToken, error) {
+
+	var tokenString string
+	if cookie, err := req.Cookie("x-a3s-token"); err == nil {
+		tokenString = cookie.Value
+	} else if auth := req.Header.Get("Authorization"); auth != "" {
+		tokenString = strings.TrimPrefix(auth, "Bearer ")
+	}
+
+	var tlsHeader string
+	if a.mtlsHeaderKey != "" {
+		tlsHeader = req.Header.Get(a.mtlsHeaderKey)
+	}
+
+	return a.authenticate(req.Context(), tokenString, req.TLS, tlsHeader)
+}
+
+func (a *Authenticator) commonAuth(ctx context.Context, tokenString string, tlsState *tls.ConnectionState, tlsHeader string) (bahamut.AuthAction, []string, error) {
+
+	idt, err := a.authenticate(ctx, tokenString, tlsState, tlsHeader)
+	if err != nil {
+		return bahamut.AuthActionKO, nil, err
+	}
+
+	return bahamut.AuthActionContinue, idt.Identity, nil
+}
+
+func (a *Authenticator) authenticate(ctx context.Context, tokenString string, tlsState *tls.ConnectionState, tlsHeader string) (*token.IdentityToken, error) {
+
+	idt, err := a.Verify(ctx, tokenString, a.audience)
+	if err != nil {
+		return nil, err
+	}
+
+	if idt.Confirmation != nil {
+		if err := a.verifyConfirmation(idt.Confirmation, tlsState, tlsHeader); err != nil {
+			return nil, elemental.NewError(
+				"Unauthorized",
+				fmt.Sprintf("Authentication rejected: %s", err),
+				"a3s:authn",
+				http.StatusUnauthorized,
+			)
+		}
+	}
+
+	return idt, nil
+}
+
+// verifyConfirmation verifies the client certificate matches the one
+// the token is bound to. If the mtls header is configured and set, its
+// certificate is used instead of the TLS peer certificate.
+func (a *Authenticator) verifyConfirmation(cnf *token.Confirmation, tlsState *tls.ConnectionState, tlsHeader string) error {
+
+	var certs []*x509.Certificate
+	if tlsState != nil {
+		certs = tlsState.PeerCertificates
+	}
+
+	if a.mtlsHeaderKey != "" && tlsHeader != "" {
+
+		cipher, err := elemental.NewAESAttributeEncrypter(a.mtlsHeaderPass)
+		if err != nil {
+			return fmt.Errorf("unable to build AES encrypter: %w", err)
+		}
+
+		header, err := cipher.DecryptString(tlsHeader)
+		if err != nil {
+			return fmt.Errorf("unable to decrypt header: %w", err)
+		}
+
+		if certs, err = mtls.CertificatesFromHeader(header); err != nil {
+			return fmt.Errorf("unable to retrieve certificate from mtls header: %w", err)
+		}
+	}
+
+	if len(certs) == 0 {
+		return fmt.Errorf("the token is bound to a client certificate but none was presented")
+	}
+
+	if !cnf.Matches(certs[0]) {
+		return fmt.Errorf("the client certificate does not match the one the token is bound to")
+	}
+
+	return nil
+}
+
+// Verify verifies the given token the same way the requests are
+// authenticated, handling federated and reference tokens, and returns
+// the resulting IdentityToken. If the given audience is empty, the
+// audience of the token is not verified.
+func (a *Authenticator) Verify(ctx context.Context, tokenString string, audience string) (*token.IdentityToken, error) {
 
 	if tokenString == "" {
-		return bahamut.AuthActionKO, nil, elemental.NewError(
+		return nil, elemental.NewError(
 			"Unauthorized",
 			"Missing token in Authorization header",
 			"a3s:authn",
@@ -99,12 +213,36 @@ func (a *Authenticator) commonAuth(ctx context.Context, tokenString string) (bah
 		)
 	}
 
+	if token.IsReference(tokenString) {
+
+		if a.references == nil {
+			return nil, elemental.NewError(
+				"Unauthorized",
+				"Authentication rejected: reference tokens are not supported",
+				"a3s:authn",
+				http.StatusUnauthorized,
+			)
+		}
+
+		resolved, err := a.references.Resolve(ctx, tokenString)
+		if err != nil {
+			return nil, elemental.NewError(
+				"Unauthorized",
+				fmt.Sprintf("Authentication rejected: unable to resolve reference token: %s", err),
+				"a3s:authn",
+				http.StatusUnauthorized,
+			)
+		}
+
+		tokenString = resolved
+	}
+
 	jwks := a.jwks
 	issuer := a.issuer
 
 	rjwks, rissuer, err := a.handleFederatedToken(ctx, tokenString)
 	if err != nil {
-		return bahamut.AuthActionKO, nil, elemental.NewError(
+		return nil, elemental.NewError(
 			"Unauthorized",
 			fmt.Sprintf("Unable to deal with eventually federated token: %s", err),
 			"a3s:authn",
@@ -116,9 +254,9 @@ func (a *Authenticator) commonAuth(ctx context.Context, tokenString string) (bah
 		issuer = rissuer
 	}
 
-	idt, err := token.Parse(tokenString, jwks, issuer, a.audience)
+	idt, err := token.Parse(tokenString, jwks, issuer, audience)
 	if err != nil {
-		return bahamut.AuthActionKO, nil, elemental.NewError(
+		return nil, elemental.NewError(
 			"Unauthorized",
 			fmt.Sprintf("Authentication rejected with error: %s", err),
 			"a3s:authn",
@@ -127,7 +265,7 @@ func (a *Authenticator) commonAuth(ctx context.Context, tokenString string) (bah
 	}
 
 	if idt.Refresh {
-		return bahamut.AuthActionKO, nil, elemental.NewError(
+		return nil, elemental.NewError(
 			"Unauthorized",
 			"Authentication impossible from a refresh token",
 			"a3s:authn",
@@ -135,7 +273,16 @@ func (a *Authenticator) commonAuth(ctx context.Context, tokenString string) (bah
 		)
 	}
 
-	return bahamut.AuthActionContinue, idt.Identity, nil
+	if a.revocations != nil && a.revocations.IsRevoked(idt) {
+		return nil, elemental.NewError(
+			"Unauthorized",
+			"Authentication rejected: the token has been revoked",
+			"a3s:authn",
+			http.StatusUnauthorized,
+		)
+	}
+
+	return idt, nil
 }
 
 func (a *Authenticator) handleFederatedToken(ctx context.Context, tokenString string) (jwks *token.JWKS, issuer string, err error) {
@@ -3,10 +3,12 @@ package authenticator
 import (
 	"context"
 	"crypto"
+	"crypto/tls"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/json"
 	"encoding/pem"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -14,6 +16,7 @@ import (
 	"github.com/golang-jwt/jwt/v4"
 	. "github.com/smartystreets/goconvey/convey"
 	"go.aporeto.io/a3s/pkgs/api"
+	"go.aporeto.io/a3s/pkgs/revocation"
 	"go.aporeto.io/a3s/pkgs/token"
 	"go.aporeto.io/bahamut"
 	"go.aporeto.io/elemental"
@@ -40,6 +43,18 @@ func getECCert() (*x509.Certificate, crypto.PrivateKey) {
 	return cert, key
 }
 
+type fakeResolver struct {
+	references map[string]string
+}
+
+func (r *fakeResolver) Resolve(ctx context.Context, reference string) (string, error) {
+	tkn, ok := r.references[reference]
+	if !ok {
+		return "", fmt.Errorf("not found")
+	}
+	return tkn, nil
+}
+
 func makeToken(claims *token.IdentityToken, signMethod jwt.SigningMethod, key crypto.PrivateKey, kid string) string {
 	if claims.Issuer == "" {
 		claims.Issuer = "iss"
@@ -98,7 +113,7 @@ func TestCommonAuth(t *testing.T) {
 				kid1,
 			)
 
-			action, claims, err := a.commonAuth(context.Background(), token)
+			action, claims, err := a.commonAuth(context.Background(), token, nil, "")
 
 			So(err, ShouldBeNil)
 			So(action, ShouldEqual, bahamut.AuthActionContinue)
@@ -114,7 +129,7 @@ func TestCommonAuth(t *testing.T) {
 				kid1,
 			)
 
-			action, claims, err := a.commonAuth(context.Background(), token)
+			action, claims, err := a.commonAuth(context.Background(), token, nil, "")
 
 			So(err, ShouldNotBeNil)
 			So(err.Error(), ShouldEqual, "error 401 (a3s:authn): Unauthorized: Authentication impossible from a refresh token")
@@ -122,6 +137,30 @@ func TestCommonAuth(t *testing.T) {
 			So(claims, ShouldBeNil)
 		})
 
+		Convey("Calling commonAuth on a revoked token should fail", func() {
+
+			revocations := revocation.NewCache(nil)
+			revocations.Set(&api.Revocation{ID: "1", TokenID: "jti"})
+			a.revocations = revocations
+
+			token := makeToken(
+				&token.IdentityToken{
+					Identity:         []string{"color=blue", "@source:type=test"},
+					RegisteredClaims: jwt.RegisteredClaims{ID: "jti"},
+				},
+				jwt.SigningMethodES256,
+				k,
+				kid1,
+			)
+
+			action, claims, err := a.commonAuth(context.Background(), token, nil, "")
+
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "error 401 (a3s:authn): Unauthorized: Authentication rejected: the token has been revoked")
+			So(action, ShouldEqual, bahamut.AuthActionKO)
+			So(claims, ShouldBeNil)
+		})
+
 		Convey("Calling commonAuth on a token signed by the wrong signer should fail", func() {
 
 			token := makeToken(
@@ -131,7 +170,7 @@ func TestCommonAuth(t *testing.T) {
 				kid1,
 			)
 
-			action, claims, err := a.commonAuth(context.Background(), token)
+			action, claims, err := a.commonAuth(context.Background(), token, nil, "")
 
 			So(err, ShouldNotBeNil)
 			So(err.Error(), ShouldEqual, `error 401 (a3s:authn): Unauthorized: Authentication rejected with error: unable to parse jwt: crypto/ecdsa: verification error`)
@@ -143,7 +182,7 @@ func TestCommonAuth(t *testing.T) {
 
 			token := "that's not good"
 
-			action, claims, err := a.commonAuth(context.Background(), token)
+			action, claims, err := a.commonAuth(context.Background(), token, nil, "")
 
 			So(err, ShouldNotBeNil)
 			So(err.Error(), ShouldEqual, `error 401 (a3s:authn): Unauthorized: Authentication rejected with error: unable to parse jwt: token contains an invalid number of segments`)
@@ -155,7 +194,7 @@ func TestCommonAuth(t *testing.T) {
 
 			token := ""
 
-			action, claims, err := a.commonAuth(context.Background(), token)
+			action, claims, err := a.commonAuth(context.Background(), token, nil, "")
 
 			So(err, ShouldNotBeNil)
 			So(err.Error(), ShouldEqual, `error 401 (a3s:authn): Unauthorized: Missing token in Authorization header`)
@@ -165,6 +204,171 @@ func TestCommonAuth(t *testing.T) {
 	})
 }
 
+func TestCertificateBoundToken(t *testing.T) {
+
+	Convey("Given I have an authenticator and a token bound to a certificate", t, func() {
+
+		c, k := getECCert()
+		jwks := token.NewJWKS()
+		_ = jwks.Append(c)
+		a := New(jwks, "iss", "aud")
+
+		clientCert, _ := getECCert()
+		otherCert, _ := getECCert()
+
+		tkn := makeToken(
+			&token.IdentityToken{
+				Identity:     []string{"color=blue", "@source:type=mtls"},
+				Confirmation: token.NewConfirmation(clientCert),
+			},
+			jwt.SigningMethodES256,
+			k,
+			token.Fingerprint(c),
+		)
+
+		Convey("Calling commonAuth with the bound certificate should work", func() {
+
+			action, claims, err := a.commonAuth(
+				context.Background(),
+				tkn,
+				&tls.ConnectionState{PeerCertificates: []*x509.Certificate{clientCert}},
+				"",
+			)
+
+			So(err, ShouldBeNil)
+			So(action, ShouldEqual, bahamut.AuthActionContinue)
+			So(claims, ShouldResemble, []string{"color=blue", "@source:type=mtls"})
+		})
+
+		Convey("Calling commonAuth with another certificate should fail", func() {
+
+			action, claims, err := a.commonAuth(
+				context.Background(),
+				tkn,
+				&tls.ConnectionState{PeerCertificates: []*x509.Certificate{otherCert}},
+				"",
+			)
+
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "error 401 (a3s:authn): Unauthorized: Authentication rejected: the client certificate does not match the one the token is bound to")
+			So(action, ShouldEqual, bahamut.AuthActionKO)
+			So(claims, ShouldBeNil)
+		})
+
+		Convey("Calling commonAuth with no certificate should fail", func() {
+
+			action, claims, err := a.commonAuth(context.Background(), tkn, nil, "")
+
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "error 401 (a3s:authn): Unauthorized: Authentication rejected: the token is bound to a client certificate but none was presented")
+			So(action, ShouldEqual, bahamut.AuthActionKO)
+			So(claims, ShouldBeNil)
+		})
+
+		Convey("Calling AuthenticateRequest with the bound certificate should work", func() {
+
+			ctx := bahamut.NewMockContext(context.Background())
+			req := elemental.NewRequest()
+			req.Password = tkn
+			req.TLSConnectionState = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{clientCert}}
+			ctx.MockRequest = req
+
+			action, err := a.AuthenticateRequest(ctx)
+
+			So(err, ShouldBeNil)
+			So(action, ShouldEqual, bahamut.AuthActionContinue)
+		})
+	})
+}
+
+func TestVerify(t *testing.T) {
+
+	Convey("Given I have an authenticator and a token", t, func() {
+
+		c, k := getECCert()
+		jwks := token.NewJWKS()
+		_ = jwks.Append(c)
+		a := New(jwks, "iss", "aud")
+
+		tkn := makeToken(
+			&token.IdentityToken{Identity: []string{"color=blue", "@source:type=test"}},
+			jwt.SigningMethodES256,
+			k,
+			token.Fingerprint(c),
+		)
+
+		Convey("Calling Verify with no audience should work", func() {
+
+			idt, err := a.Verify(context.Background(), tkn, "")
+
+			So(err, ShouldBeNil)
+			So(idt.Identity, ShouldResemble, []string{"color=blue", "@source:type=test"})
+			So(idt.Source.Type, ShouldEqual, "test")
+		})
+
+		Convey("Calling Verify with the token audience should work", func() {
+
+			idt, err := a.Verify(context.Background(), tkn, "aud")
+
+			So(err, ShouldBeNil)
+			So(idt, ShouldNotBeNil)
+		})
+
+		Convey("Calling Verify with another audience should fail", func() {
+
+			idt, err := a.Verify(context.Background(), tkn, "other")
+
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, `error 401 (a3s:authn): Unauthorized: Authentication rejected with error: audience '[aud]' is not acceptable. want 'other'`)
+			So(idt, ShouldBeNil)
+		})
+	})
+
+	Convey("Given I have an authenticator with a reference resolver", t, func() {
+
+		c, k := getECCert()
+		jwks := token.NewJWKS()
+		_ = jwks.Append(c)
+
+		tkn := makeToken(
+			&token.IdentityToken{Identity: []string{"color=blue", "@source:type=test"}},
+			jwt.SigningMethodES256,
+			k,
+			token.Fingerprint(c),
+		)
+
+		a := New(jwks, "iss", "aud", OptionReferenceResolver(&fakeResolver{
+			references: map[string]string{token.ReferencePrefix + "ref": tkn},
+		}))
+
+		Convey("Calling Verify with a known reference should work", func() {
+
+			idt, err := a.Verify(context.Background(), token.ReferencePrefix+"ref", "aud")
+
+			So(err, ShouldBeNil)
+			So(idt.Identity, ShouldResemble, []string{"color=blue", "@source:type=test"})
+		})
+
+		Convey("Calling Verify with an unknown reference should fail", func() {
+
+			idt, err := a.Verify(context.Background(), token.ReferencePrefix+"nope", "aud")
+
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, `error 401 (a3s:authn): Unauthorized: Authentication rejected: unable to resolve reference token: not found`)
+			So(idt, ShouldBeNil)
+		})
+
+		Convey("Calling Verify with a reference and no resolver should fail", func() {
+
+			idt, err := New(jwks, "iss", "aud").Verify(context.Background(), token.ReferencePrefix+"ref", "aud")
+
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, `error 401 (a3s:authn): Unauthorized: Authentication rejected: reference tokens are not supported`)
+			So(idt, ShouldBeNil)
+		})
+	})
+}
+
 func TestAuthenticateSession(t *testing.T) {
 
 	Convey("Given I have an authenticator", t, func() {
@@ -319,6 +523,84 @@ func TestAuthenticateRequest(t *testing.T) {
 	})
 }
 
+func TestAuthenticateHTTPRequest(t *testing.T) {
+
+	Convey("Given I have an authenticator", t, func() {
+
+		c, k := getECCert()
+		_, k2 := getECCert()
+		jwks := token.NewJWKS()
+		_ = jwks.Append(c)
+
+		a := New(jwks, "iss", "aud")
+
+		kid1 := token.Fingerprint(c)
+
+		Convey("Calling AuthenticateHTTPRequest with a valid bearer token should work", func() {
+
+			req := httptest.NewRequest(http.MethodPost, "http://url.com/hello", nil)
+			req.Header.Set("Authorization", "Bearer "+makeToken(
+				&token.IdentityToken{Identity: []string{"color=blue", "@source:type=test"}},
+				jwt.SigningMethodES256,
+				k,
+				kid1,
+			))
+
+			idt, err := a.AuthenticateHTTPRequest(req)
+
+			So(err, ShouldBeNil)
+			So(idt.Identity, ShouldResemble, []string{"color=blue", "@source:type=test"})
+		})
+
+		Convey("Calling AuthenticateHTTPRequest with a valid token in cookies should work", func() {
+
+			req := httptest.NewRequest(http.MethodPost, "http://url.com/hello", nil)
+			req.AddCookie(&http.Cookie{
+				Name: "x-a3s-token",
+				Value: makeToken(
+					&token.IdentityToken{Identity: []string{"color=blue", "@source:type=test"}},
+					jwt.SigningMethodES256,
+					k,
+					kid1,
+				),
+			})
+
+			idt, err := a.AuthenticateHTTPRequest(req)
+
+			So(err, ShouldBeNil)
+			So(idt.Identity, ShouldResemble, []string{"color=blue", "@source:type=test"})
+		})
+
+		Convey("Calling AuthenticateHTTPRequest with an invalid token should fail", func() {
+
+			req := httptest.NewRequest(http.MethodPost, "http://url.com/hello", nil)
+			req.Header.Set("Authorization", "Bearer "+makeToken(
+				&token.IdentityToken{Identity: []string{"color=blue", "@source:type=test"}},
+				jwt.SigningMethodES256,
+				k2,
+				kid1,
+			))
+
+			idt, err := a.AuthenticateHTTPRequest(req)
+
+			So(idt, ShouldBeNil)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "error 401 (a3s:authn): Unauthorized: Authentication rejected with error: unable to parse jwt: crypto/ecdsa: verification error")
+		})
+
+		Convey("Calling AuthenticateHTTPRequest without token should fail", func() {
+
+			req := httptest.NewRequest(http.MethodPost, "http://url.com/hello", nil)
+
+			idt, err := a.AuthenticateHTTPRequest(req)
+
+			So(idt, ShouldBeNil)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "error 401 (a3s:authn): Unauthorized: Missing token in Authorization header")
+		})
+	})
+}
+
 func TestHandleFederatedToken(t *testing.T) {
 
 	Convey("Given I have federated token", t, func() {
@@ -404,6 +686,10 @@ func TestHandleFederatedToken(t *testing.T) {
 
 			var called int
 			ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				if req.URL.Path == "/"+token.WellKnownDiscoveryPath {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
 				called++
 				j := token.NewJWKS()
 				j.Append(c2) // nolint
@@ -465,7 +751,7 @@ func TestHandleFederatedToken(t *testing.T) {
 			)
 			rjwks, rissuer, err := a.handleFederatedToken(context.Background(), token)
 			So(err, ShouldNotBeNil)
-			So(err.Error(), ShouldStartWith, "Unable to retrieve remote jwks: unable to retrieve remote jwks:")
+			So(err.Error(), ShouldStartWith, "Unable to retrieve remote jwks: unable to discover remote jwks:")
 			So(err.Error(), ShouldEndWith, "x509: certificate signed by unknown authority")
 			So(rjwks, ShouldBeNil)
 			So(rissuer, ShouldBeEmpty)
@@ -1,10 +1,19 @@
 package authenticator
 
-import "crypto/x509"
+import (
+	"crypto/x509"
+
+	"go.aporeto.io/a3s/pkgs/revocation"
+	"go.aporeto.io/a3s/pkgs/token"
+)
 
 type config struct {
 	ignoredResources       []string
 	externalTrustedIssuers []RemoteIssuer
+	revocations            *revocation.Cache
+	mtlsHeaderKey          string
+	mtlsHeaderPass         string
+	references             token.ReferenceResolver
 }
 
 // An Option can be used to configure various options in the Authenticator.
@@ -31,3 +40,32 @@ func OptionExternalTrustedIssuers(issuers ...RemoteIssuer) Option {
 		cfg.externalTrustedIssuers = issuers
 	}
 }
+
+// OptionRevocations sets the revocation cache to use to reject
+// tokens that have been revoked before they expire.
+func OptionRevocations(revocations *revocation.Cache) Option {
+	return func(cfg *config) {
+		cfg.revocations = revocations
+	}
+}
+
+// OptionMTLSHeader sets the header containing the AES encrypted
+// client certificates, and the passphrase to decrypt it. If the header is
+// set, its certificate is used instead of the TLS peer certificate to verify
+// the tokens bound to a client certificate.
+// This is insecure if there is no proper tls verification happening upstream.
+func OptionMTLSHeader(key string, passphrase string) Option {
+	return func(cfg *config) {
+		cfg.mtlsHeaderKey = key
+		cfg.mtlsHeaderPass = passphrase
+	}
+}
+
+// OptionReferenceResolver sets the resolver to use to resolve
+// the opaque reference tokens into the tokens they refer to.
+// If it is not set, reference tokens are rejected.
+func OptionReferenceResolver(resolver token.ReferenceResolver) Option {
+	return func(cfg *config) {
+		cfg.references = resolver
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	. "github.com/smartystreets/goconvey/convey"
+	"go.aporeto.io/a3s/pkgs/revocation"
 )
 
 func TestOption(t *testing.T) {
@@ -21,4 +22,25 @@ func TestOption(t *testing.T) {
 		OptionExternalTrustedIssuers(i1, i2)(cfg)
 		So(cfg.externalTrustedIssuers, ShouldResemble, []RemoteIssuer{i1, i2})
 	})
+
+	Convey("OptionMTLSHeader should work", t, func() {
+		cfg := &config{}
+		OptionMTLSHeader("key", "pass")(cfg)
+		So(cfg.mtlsHeaderKey, ShouldEqual, "key")
+		So(cfg.mtlsHeaderPass, ShouldEqual, "pass")
+	})
+
+	Convey("OptionRevocations should work", t, func() {
+		cfg := &config{}
+		r := revocation.NewCache(nil)
+		OptionRevocations(r)(cfg)
+		So(cfg.revocations, ShouldEqual, r)
+	})
+
+	Convey("OptionReferenceResolver should work", t, func() {
+		cfg := &config{}
+		r := &fakeResolver{}
+		OptionReferenceResolver(r)(cfg)
+		So(cfg.references, ShouldEqual, r)
+	})
 }
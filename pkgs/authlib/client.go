@@ -3,6 +3,7 @@ package authlib
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	"go.aporeto.io/a3s/pkgs/api"
 	"go.aporeto.io/a3s/pkgs/authlib/internal/providers"
@@ -36,6 +37,7 @@ func (a *Client) AuthFromCertificate(ctx context.Context, sourceNamespace string
 	req.SourceType = api.IssueSourceTypeMTLS
 	req.SourceName = sourceName
 	req.SourceNamespace = sourceNamespace
+	req.CertificateBound = cfg.bound
 
 	applyOptions(req, cfg)
 
@@ -64,6 +66,29 @@ func (a *Client) AuthFromLDAP(ctx context.Context, username string, password str
 	return a.sendRequest(ctx, req)
 }
 
+// AuthFromLocal requests a token using the provided username and password
+// of a user of a local source.
+func (a *Client) AuthFromLocal(ctx context.Context, username string, password string, sourceNamespace string, sourceName string, options ...Option) (string, error) {
+
+	cfg := newConfig()
+	for _, opt := range options {
+		opt(&cfg)
+	}
+
+	req := api.NewIssue()
+	req.SourceType = api.IssueSourceTypeLocal
+	req.SourceName = sourceName
+	req.SourceNamespace = sourceNamespace
+	req.InputLocal = &api.IssueLocal{
+		Username: username,
+		Password: password,
+	}
+
+	applyOptions(req, cfg)
+
+	return a.sendRequest(ctx, req)
+}
+
 // AuthFromA3S requests a token using the provided local a3s token.
 func (a *Client) AuthFromA3S(ctx context.Context, token string, options ...Option) (string, error) {
 
@@ -83,6 +108,66 @@ func (a *Client) AuthFromA3S(ctx context.Context, token string, options ...Optio
 	return a.sendRequest(ctx, req)
 }
 
+// AuthFromTOTP upgrades the provided local a3s token using a TOTP code generated
+// from a TOTP secret enrolled by its bearer. The issued token carries the @mfa=totp claim.
+func (a *Client) AuthFromTOTP(ctx context.Context, token string, code string, options ...Option) (string, error) {
+
+	cfg := newConfig()
+	for _, opt := range options {
+		opt(&cfg)
+	}
+
+	req := api.NewIssue()
+	req.SourceType = api.IssueSourceTypeMFA
+	req.InputMFA = &api.IssueMFA{
+		Token: token,
+		TOTP:  code,
+	}
+
+	applyOptions(req, cfg)
+
+	return a.sendRequest(ctx, req)
+}
+
+// AuthFromWebAuthnStep1 starts the upgrade of the provided local a3s token using a WebAuthn credential
+// enrolled by its bearer. The function will return the JSON encoded options to pass to the authenticator.
+func (a *Client) AuthFromWebAuthnStep1(ctx context.Context, token string) (string, error) {
+
+	req := api.NewIssue()
+	req.SourceType = api.IssueSourceTypeMFA
+	req.InputMFA = &api.IssueMFA{
+		Token: token,
+	}
+
+	if _, err := a.sendRequest(ctx, req); err != nil {
+		return "", err
+	}
+
+	return req.InputMFA.WebAuthnOptions, nil
+}
+
+// AuthFromWebAuthnStep2 finishes the upgrade of the provided local a3s token using the JSON encoded
+// response of the authenticator to the options returned by AuthFromWebAuthnStep1. The issued token
+// carries the @mfa=webauthn claim.
+func (a *Client) AuthFromWebAuthnStep2(ctx context.Context, token string, response string, options ...Option) (string, error) {
+
+	cfg := newConfig()
+	for _, opt := range options {
+		opt(&cfg)
+	}
+
+	req := api.NewIssue()
+	req.SourceType = api.IssueSourceTypeMFA
+	req.InputMFA = &api.IssueMFA{
+		Token:            token,
+		WebAuthnResponse: response,
+	}
+
+	applyOptions(req, cfg)
+
+	return a.sendRequest(ctx, req)
+}
+
 // AuthFromRemoteA3S requests a token using the provided remote a3s token with the provided RemoteA3S source with the given namespace and name.
 func (a *Client) AuthFromRemoteA3S(ctx context.Context, token string, sourceNamespace string, sourceName string, options ...Option) (string, error) {
 
@@ -105,9 +190,10 @@ func (a *Client) AuthFromRemoteA3S(ctx context.Context, token string, sourceName
 	return a.sendRequest(ctx, req)
 }
 
-// AuthFromAWS requests a token using the provided AWS sts information. If accessKeyID, secretAccessKey and token are empty,
-// the function will assume it is running on an AWS instance and will try to retrieve them using the magic IP.
-func (a *Client) AuthFromAWS(ctx context.Context, accessKeyID, secretAccessKey, token string, options ...Option) (string, error) {
+// AuthFromAWS requests a token using the provided AWS sts information, from the AWS source with the given namespace and name.
+// If accessKeyID, secretAccessKey and token are empty, the function will assume it is running on an AWS instance and will try
+// to retrieve them using the magic IP.
+func (a *Client) AuthFromAWS(ctx context.Context, accessKeyID, secretAccessKey, token string, sourceNamespace string, sourceName string, options ...Option) (string, error) {
 
 	cfg := newConfig()
 	for _, opt := range options {
@@ -136,6 +222,8 @@ func (a *Client) AuthFromAWS(ctx context.Context, accessKeyID, secretAccessKey,
 
 	req := api.NewIssue()
 	req.SourceType = api.IssueSourceTypeAWS
+	req.SourceNamespace = sourceNamespace
+	req.SourceName = sourceName
 	req.InputAWS = &api.IssueAWS{
 		ID:     s.AccessKeyID,
 		Secret: s.SecretAccessKey,
@@ -147,9 +235,9 @@ func (a *Client) AuthFromAWS(ctx context.Context, accessKeyID, secretAccessKey,
 	return a.sendRequest(ctx, req)
 }
 
-// AuthFromGCP requests a token using the provided GCP token. If token is empty,
-// the function will assume it is running on an GCP instance and will try to retrieve it using the magic IP.
-func (a *Client) AuthFromGCP(ctx context.Context, token string, audience string, options ...Option) (string, error) {
+// AuthFromGCP requests a token using the provided GCP token, from the GCP source with the given namespace and name.
+// If token is empty, the function will assume it is running on an GCP instance and will try to retrieve it using the magic IP.
+func (a *Client) AuthFromGCP(ctx context.Context, token string, audience string, sourceNamespace string, sourceName string, options ...Option) (string, error) {
 
 	cfg := newConfig()
 	for _, opt := range options {
@@ -167,6 +255,8 @@ func (a *Client) AuthFromGCP(ctx context.Context, token string, audience string,
 
 	req := api.NewIssue()
 	req.SourceType = api.IssueSourceTypeGCP
+	req.SourceNamespace = sourceNamespace
+	req.SourceName = sourceName
 	req.InputGCP = &api.IssueGCP{
 		Token:    token,
 		Audience: audience,
@@ -177,9 +267,9 @@ func (a *Client) AuthFromGCP(ctx context.Context, token string, audience string,
 	return a.sendRequest(ctx, req)
 }
 
-// AuthFromAzure requests a token using the provided Azure token. If token is empty,
-// the function will assume it is running on an Azure instance and will try to retrieve it using the magic IP.
-func (a *Client) AuthFromAzure(ctx context.Context, token string, options ...Option) (string, error) {
+// AuthFromAzure requests a token using the provided Azure token, from the Azure source with the given namespace and name.
+// If token is empty, the function will assume it is running on an Azure instance and will try to retrieve it using the magic IP.
+func (a *Client) AuthFromAzure(ctx context.Context, token string, sourceNamespace string, sourceName string, options ...Option) (string, error) {
 
 	var err error
 
@@ -197,6 +287,8 @@ func (a *Client) AuthFromAzure(ctx context.Context, token string, options ...Opt
 
 	req := api.NewIssue()
 	req.SourceType = api.IssueSourceTypeAzure
+	req.SourceNamespace = sourceNamespace
+	req.SourceName = sourceName
 	req.InputAzure = &api.IssueAzure{
 		Token: token,
 	}
@@ -206,6 +298,80 @@ func (a *Client) AuthFromAzure(ctx context.Context, token string, options ...Opt
 	return a.sendRequest(ctx, req)
 }
 
+// AuthFromJWT requests a token using the provided JWT, from the JWT source with the given namespace and name.
+func (a *Client) AuthFromJWT(ctx context.Context, token string, sourceNamespace string, sourceName string, options ...Option) (string, error) {
+
+	cfg := newConfig()
+	for _, opt := range options {
+		opt(&cfg)
+	}
+
+	req := api.NewIssue()
+	req.SourceType = api.IssueSourceTypeJWT
+	req.SourceNamespace = sourceNamespace
+	req.SourceName = sourceName
+	req.InputJWT = &api.IssueJWT{
+		Token: token,
+	}
+
+	applyOptions(req, cfg)
+
+	return a.sendRequest(ctx, req)
+}
+
+// AuthFromSPIFFE requests a token using the provided JWT-SVID, from the SPIFFE source with the given namespace and name.
+func (a *Client) AuthFromSPIFFE(ctx context.Context, svid string, sourceNamespace string, sourceName string, options ...Option) (string, error) {
+
+	cfg := newConfig()
+	for _, opt := range options {
+		opt(&cfg)
+	}
+
+	req := api.NewIssue()
+	req.SourceType = api.IssueSourceTypeSPIFFE
+	req.SourceNamespace = sourceNamespace
+	req.SourceName = sourceName
+	req.InputSPIFFE = &api.IssueSPIFFE{
+		Token: svid,
+	}
+
+	applyOptions(req, cfg)
+
+	return a.sendRequest(ctx, req)
+}
+
+// AuthFromKubernetes requests a token using the provided Kubernetes service account token, from the Kubernetes source
+// with the given namespace and name. If token is empty, the function will read it from the projected token file at
+// tokenPath, or from the default service account token path if tokenPath is empty.
+func (a *Client) AuthFromKubernetes(ctx context.Context, token string, tokenPath string, sourceNamespace string, sourceName string, options ...Option) (string, error) {
+
+	var err error
+
+	if token == "" {
+		token, err = providers.KubernetesServiceAccountToken(tokenPath)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	cfg := newConfig()
+	for _, opt := range options {
+		opt(&cfg)
+	}
+
+	req := api.NewIssue()
+	req.SourceType = api.IssueSourceTypeKubernetes
+	req.SourceNamespace = sourceNamespace
+	req.SourceName = sourceName
+	req.InputKubernetes = &api.IssueKubernetes{
+		Token: token,
+	}
+
+	applyOptions(req, cfg)
+
+	return a.sendRequest(ctx, req)
+}
+
 // AuthFromOIDCStep1 performs the first step of the OIDC ceremony using the configured OIDC auth source identified by
 // its name and namespace. The functiion will return the provider URL to use to autenticate.
 func (a *Client) AuthFromOIDCStep1(ctx context.Context, sourceNamespace string, sourceName string, redirectURL string) (string, error) {
@@ -250,6 +416,115 @@ func (a *Client) AuthFromOIDCStep2(ctx context.Context, sourceNamespace string,
 	return a.sendRequest(ctx, req)
 }
 
+// AuthFromOIDCDeviceStep1 starts the OIDC device authorization flow using the configured OIDC auth source identified by
+// its name and namespace. The function will return the api.IssueOIDC containing the state, the user code, the verification URI
+// where the user must enter it, and the polling interval.
+func (a *Client) AuthFromOIDCDeviceStep1(ctx context.Context, sourceNamespace string, sourceName string) (*api.IssueOIDC, error) {
+
+	req := api.NewIssue()
+	req.SourceType = api.IssueSourceTypeOIDC
+	req.SourceNamespace = sourceNamespace
+	req.SourceName = sourceName
+	req.InputOIDC = &api.IssueOIDC{
+		DeviceAuth: true,
+	}
+
+	if _, err := a.sendRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return req.InputOIDC, nil
+}
+
+// AuthFromOIDCDeviceStep2 finishes the OIDC device authorization flow using the state obtained from AuthFromOIDCDeviceStep1.
+// It will poll the server every interval until the user approves or denies the request, or until the context is canceled.
+func (a *Client) AuthFromOIDCDeviceStep2(ctx context.Context, sourceNamespace string, sourceName string, state string, interval time.Duration, options ...Option) (string, error) {
+
+	cfg := newConfig()
+	for _, opt := range options {
+		opt(&cfg)
+	}
+
+	for {
+
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+
+		req := api.NewIssue()
+		req.SourceType = api.IssueSourceTypeOIDC
+		req.SourceNamespace = sourceNamespace
+		req.SourceName = sourceName
+		req.InputOIDC = &api.IssueOIDC{
+			DeviceAuth: true,
+			State:      state,
+		}
+
+		applyOptions(req, cfg)
+
+		t, err := a.sendRequest(ctx, req)
+		if err != nil {
+			return "", err
+		}
+
+		if req.InputOIDC == nil || !req.InputOIDC.AuthorizationPending {
+			return t, nil
+		}
+
+		if i := time.Duration(req.InputOIDC.Interval) * time.Second; i > interval {
+			interval = i
+		}
+	}
+}
+
+// AuthFromSAMLStep1 performs the first step of the SAML ceremony using the configured SAML auth source identified by
+// its name and namespace. The function will return the identity provider URL to use to authenticate,
+// and the verifier to pass to AuthFromSAMLStep2. Once authenticated, the identity provider will redirect
+// to the given redirectURL with a state.
+func (a *Client) AuthFromSAMLStep1(ctx context.Context, sourceNamespace string, sourceName string, redirectURL string) (string, string, error) {
+
+	req := api.NewIssue()
+	req.SourceType = api.IssueSourceTypeSAML
+	req.SourceNamespace = sourceNamespace
+	req.SourceName = sourceName
+	req.InputSAML = &api.IssueSAML{
+		RedirectURL:    redirectURL,
+		NoAuthRedirect: true,
+	}
+
+	_, err := a.sendRequest(ctx, req)
+	if err != nil {
+		return "", "", err
+	}
+
+	return req.InputSAML.AuthURL, req.InputSAML.Verifier, nil
+}
+
+// AuthFromSAMLStep2 finishes the SAML ceremony using the state you obtained after performing
+// the authentication against the SAML identity provider, and the verifier returned by AuthFromSAMLStep1.
+func (a *Client) AuthFromSAMLStep2(ctx context.Context, sourceNamespace string, sourceName string, state string, verifier string, options ...Option) (string, error) {
+
+	cfg := newConfig()
+	for _, opt := range options {
+		opt(&cfg)
+	}
+
+	req := api.NewIssue()
+	req.SourceType = api.IssueSourceTypeSAML
+	req.SourceNamespace = sourceNamespace
+	req.SourceName = sourceName
+	req.InputSAML = &api.IssueSAML{
+		State:    state,
+		Verifier: verifier,
+	}
+
+	applyOptions(req, cfg)
+
+	return a.sendRequest(ctx, req)
+}
+
 // AuthFromHTTP requests a token using the provided username and password from the source with the given namespace and name.
 func (a *Client) AuthFromHTTP(ctx context.Context, username string, password string, totp string, sourceNamespace string, sourceName string, options ...Option) (string, error) {
 
@@ -290,6 +565,8 @@ func applyOptions(req *api.Issue, cfg config) {
 
 	if cfg.refresh {
 		req.TokenType = api.IssueTokenTypeRefresh
+	} else if cfg.reference {
+		req.TokenType = api.IssueTokenTypeReference
 	}
 
 	req.Cloak = cfg.cloak
@@ -3,6 +3,8 @@ package authlib
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -51,10 +53,12 @@ func TestAuthFromCertificate(t *testing.T) {
 			"name",
 			OptAudience("aud"),
 			OptRefresh(true),
+			OptCertificateBound(true),
 		)
 
 		So(err, ShouldBeNil)
 		So(expectedRequest.SourceType, ShouldEqual, api.IssueSourceTypeMTLS)
+		So(expectedRequest.CertificateBound, ShouldBeTrue)
 		So(expectedRequest.SourceNamespace, ShouldEqual, "/ns")
 		So(expectedRequest.SourceName, ShouldEqual, "name")
 		So(expectedRequest.Audience, ShouldResemble, []string{"aud"})
@@ -100,6 +104,42 @@ func TestAuthFromLDAP(t *testing.T) {
 	})
 }
 
+func TestAuthFromLocal(t *testing.T) {
+
+	Convey("The function should work", t, func() {
+
+		expectedRequest := api.NewIssue()
+
+		m := maniptest.NewTestManipulator()
+		m.MockCreate(t, func(mctx manipulate.Context, object elemental.Identifiable) error {
+			expectedRequest = object.(*api.Issue)
+			expectedRequest.Token = "yeay!"
+			return nil
+		})
+
+		cl := NewClient(m)
+
+		token, err := cl.AuthFromLocal(
+			context.Background(),
+			"user",
+			"pass",
+			"/ns",
+			"name",
+			OptAudience("aud"),
+		)
+
+		So(err, ShouldBeNil)
+		So(expectedRequest.SourceType, ShouldEqual, api.IssueSourceTypeLocal)
+		So(expectedRequest.SourceNamespace, ShouldEqual, "/ns")
+		So(expectedRequest.SourceName, ShouldEqual, "name")
+		So(expectedRequest.Audience, ShouldResemble, []string{"aud"})
+		So(expectedRequest.Validity, ShouldEqual, time.Hour.String())
+		So(expectedRequest.InputLocal.Username, ShouldEqual, "user")
+		So(expectedRequest.InputLocal.Password, ShouldEqual, "pass")
+		So(token, ShouldEqual, "yeay!")
+	})
+}
+
 func TestAuthFromA3S(t *testing.T) {
 
 	Convey("The function should work", t, func() {
@@ -132,6 +172,106 @@ func TestAuthFromA3S(t *testing.T) {
 	})
 }
 
+func TestAuthFromTOTP(t *testing.T) {
+
+	Convey("The function should work", t, func() {
+
+		expectedRequest := api.NewIssue()
+
+		m := maniptest.NewTestManipulator()
+		m.MockCreate(t, func(mctx manipulate.Context, object elemental.Identifiable) error {
+			expectedRequest = object.(*api.Issue)
+			expectedRequest.Token = "yeay!"
+			return nil
+		})
+
+		cl := NewClient(m)
+
+		token, err := cl.AuthFromTOTP(
+			context.Background(),
+			"token",
+			"123456",
+			OptAudience("aud"),
+		)
+
+		So(err, ShouldBeNil)
+		So(expectedRequest.SourceType, ShouldEqual, api.IssueSourceTypeMFA)
+		So(expectedRequest.Audience, ShouldResemble, []string{"aud"})
+		So(expectedRequest.Validity, ShouldEqual, time.Hour.String())
+		So(expectedRequest.InputMFA.Token, ShouldEqual, "token")
+		So(expectedRequest.InputMFA.TOTP, ShouldEqual, "123456")
+		So(token, ShouldEqual, "yeay!")
+	})
+}
+
+func TestAuthFromWebAuthn(t *testing.T) {
+
+	Convey("Step1 should work", t, func() {
+
+		expectedRequest := api.NewIssue()
+
+		m := maniptest.NewTestManipulator()
+		m.MockCreate(t, func(mctx manipulate.Context, object elemental.Identifiable) error {
+			expectedRequest = object.(*api.Issue)
+			expectedRequest.InputMFA.WebAuthnOptions = `{"publicKey":{}}`
+			return nil
+		})
+
+		cl := NewClient(m)
+
+		opts, err := cl.AuthFromWebAuthnStep1(context.Background(), "token")
+
+		So(err, ShouldBeNil)
+		So(expectedRequest.SourceType, ShouldEqual, api.IssueSourceTypeMFA)
+		So(expectedRequest.InputMFA.Token, ShouldEqual, "token")
+		So(opts, ShouldEqual, `{"publicKey":{}}`)
+	})
+
+	Convey("Step1 should fail if the request fails", t, func() {
+
+		m := maniptest.NewTestManipulator()
+		m.MockCreate(t, func(mctx manipulate.Context, object elemental.Identifiable) error {
+			return fmt.Errorf("boom")
+		})
+
+		cl := NewClient(m)
+
+		opts, err := cl.AuthFromWebAuthnStep1(context.Background(), "token")
+
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldEqual, "boom")
+		So(opts, ShouldBeEmpty)
+	})
+
+	Convey("Step2 should work", t, func() {
+
+		expectedRequest := api.NewIssue()
+
+		m := maniptest.NewTestManipulator()
+		m.MockCreate(t, func(mctx manipulate.Context, object elemental.Identifiable) error {
+			expectedRequest = object.(*api.Issue)
+			expectedRequest.Token = "yeay!"
+			return nil
+		})
+
+		cl := NewClient(m)
+
+		token, err := cl.AuthFromWebAuthnStep2(
+			context.Background(),
+			"token",
+			`{"type":"public-key"}`,
+			OptAudience("aud"),
+		)
+
+		So(err, ShouldBeNil)
+		So(expectedRequest.SourceType, ShouldEqual, api.IssueSourceTypeMFA)
+		So(expectedRequest.Audience, ShouldResemble, []string{"aud"})
+		So(expectedRequest.InputMFA.Token, ShouldEqual, "token")
+		So(expectedRequest.InputMFA.WebAuthnResponse, ShouldEqual, `{"type":"public-key"}`)
+		So(token, ShouldEqual, "yeay!")
+	})
+}
+
 func TestAuthFromRemoteA3S(t *testing.T) {
 
 	Convey("The function should work", t, func() {
@@ -186,13 +326,15 @@ func TestAuthFromAWS(t *testing.T) {
 			"aid",
 			"sid",
 			"token",
+			"/ns",
+			"aws",
 			OptAudience("aud"),
 		)
 
 		So(err, ShouldBeNil)
 		So(expectedRequest.SourceType, ShouldEqual, api.IssueSourceTypeAWS)
-		So(expectedRequest.SourceNamespace, ShouldEqual, "")
-		So(expectedRequest.SourceName, ShouldEqual, "")
+		So(expectedRequest.SourceNamespace, ShouldEqual, "/ns")
+		So(expectedRequest.SourceName, ShouldEqual, "aws")
 		So(expectedRequest.Audience, ShouldResemble, []string{"aud"})
 		So(expectedRequest.Validity, ShouldEqual, time.Hour.String())
 		So(expectedRequest.InputAWS.ID, ShouldEqual, "aid")
@@ -221,13 +363,15 @@ func TestAuthFromGCP(t *testing.T) {
 			context.Background(),
 			"token",
 			"audience",
+			"/ns",
+			"gcp",
 			OptAudience("aud"),
 		)
 
 		So(err, ShouldBeNil)
 		So(expectedRequest.SourceType, ShouldEqual, api.IssueSourceTypeGCP)
-		So(expectedRequest.SourceNamespace, ShouldEqual, "")
-		So(expectedRequest.SourceName, ShouldEqual, "")
+		So(expectedRequest.SourceNamespace, ShouldEqual, "/ns")
+		So(expectedRequest.SourceName, ShouldEqual, "gcp")
 		So(expectedRequest.Audience, ShouldResemble, []string{"aud"})
 		So(expectedRequest.Validity, ShouldEqual, time.Hour.String())
 		So(expectedRequest.InputGCP.Token, ShouldEqual, "token")
@@ -254,13 +398,15 @@ func TestAuthFromAzure(t *testing.T) {
 		token, err := cl.AuthFromAzure(
 			context.Background(),
 			"token",
+			"/ns",
+			"azure",
 			OptAudience("aud"),
 		)
 
 		So(err, ShouldBeNil)
 		So(expectedRequest.SourceType, ShouldEqual, api.IssueSourceTypeAzure)
-		So(expectedRequest.SourceNamespace, ShouldEqual, "")
-		So(expectedRequest.SourceName, ShouldEqual, "")
+		So(expectedRequest.SourceNamespace, ShouldEqual, "/ns")
+		So(expectedRequest.SourceName, ShouldEqual, "azure")
 		So(expectedRequest.Audience, ShouldResemble, []string{"aud"})
 		So(expectedRequest.Validity, ShouldEqual, time.Hour.String())
 		So(expectedRequest.InputAzure.Token, ShouldEqual, "token")
@@ -268,6 +414,143 @@ func TestAuthFromAzure(t *testing.T) {
 	})
 }
 
+func TestAuthFromJWT(t *testing.T) {
+
+	Convey("The function should work", t, func() {
+
+		expectedRequest := api.NewIssue()
+
+		m := maniptest.NewTestManipulator()
+		m.MockCreate(t, func(mctx manipulate.Context, object elemental.Identifiable) error {
+			expectedRequest = object.(*api.Issue)
+			expectedRequest.Token = "yeay!"
+			return nil
+		})
+
+		cl := NewClient(m)
+
+		token, err := cl.AuthFromJWT(
+			context.Background(),
+			"token",
+			"/ns",
+			"jwt",
+			OptAudience("aud"),
+		)
+
+		So(err, ShouldBeNil)
+		So(expectedRequest.SourceType, ShouldEqual, api.IssueSourceTypeJWT)
+		So(expectedRequest.SourceNamespace, ShouldEqual, "/ns")
+		So(expectedRequest.SourceName, ShouldEqual, "jwt")
+		So(expectedRequest.Audience, ShouldResemble, []string{"aud"})
+		So(expectedRequest.Validity, ShouldEqual, time.Hour.String())
+		So(expectedRequest.InputJWT.Token, ShouldEqual, "token")
+		So(token, ShouldEqual, "yeay!")
+	})
+}
+
+func TestAuthFromSPIFFE(t *testing.T) {
+
+	Convey("The function should work", t, func() {
+
+		expectedRequest := api.NewIssue()
+
+		m := maniptest.NewTestManipulator()
+		m.MockCreate(t, func(mctx manipulate.Context, object elemental.Identifiable) error {
+			expectedRequest = object.(*api.Issue)
+			expectedRequest.Token = "yeay!"
+			return nil
+		})
+
+		cl := NewClient(m)
+
+		token, err := cl.AuthFromSPIFFE(
+			context.Background(),
+			"svid",
+			"/ns",
+			"spiffe",
+			OptAudience("aud"),
+		)
+
+		So(err, ShouldBeNil)
+		So(expectedRequest.SourceType, ShouldEqual, api.IssueSourceTypeSPIFFE)
+		So(expectedRequest.SourceNamespace, ShouldEqual, "/ns")
+		So(expectedRequest.SourceName, ShouldEqual, "spiffe")
+		So(expectedRequest.Audience, ShouldResemble, []string{"aud"})
+		So(expectedRequest.Validity, ShouldEqual, time.Hour.String())
+		So(expectedRequest.InputSPIFFE.Token, ShouldEqual, "svid")
+		So(token, ShouldEqual, "yeay!")
+	})
+}
+
+func TestAuthFromKubernetes(t *testing.T) {
+
+	Convey("Given a client", t, func() {
+
+		expectedRequest := api.NewIssue()
+
+		m := maniptest.NewTestManipulator()
+		m.MockCreate(t, func(mctx manipulate.Context, object elemental.Identifiable) error {
+			expectedRequest = object.(*api.Issue)
+			expectedRequest.Token = "yeay!"
+			return nil
+		})
+
+		cl := NewClient(m)
+
+		Convey("Calling AuthFromKubernetes with a token should work", func() {
+
+			token, err := cl.AuthFromKubernetes(
+				context.Background(),
+				"token",
+				"",
+				"/ns",
+				"kubernetes",
+				OptAudience("aud"),
+			)
+
+			So(err, ShouldBeNil)
+			So(expectedRequest.SourceType, ShouldEqual, api.IssueSourceTypeKubernetes)
+			So(expectedRequest.SourceNamespace, ShouldEqual, "/ns")
+			So(expectedRequest.SourceName, ShouldEqual, "kubernetes")
+			So(expectedRequest.Audience, ShouldResemble, []string{"aud"})
+			So(expectedRequest.Validity, ShouldEqual, time.Hour.String())
+			So(expectedRequest.InputKubernetes.Token, ShouldEqual, "token")
+			So(token, ShouldEqual, "yeay!")
+		})
+
+		Convey("Calling AuthFromKubernetes with a token path should work", func() {
+
+			path := filepath.Join(t.TempDir(), "token")
+			So(os.WriteFile(path, []byte("file-token"), 0600), ShouldBeNil)
+
+			token, err := cl.AuthFromKubernetes(
+				context.Background(),
+				"",
+				path,
+				"/ns",
+				"kubernetes",
+			)
+
+			So(err, ShouldBeNil)
+			So(expectedRequest.InputKubernetes.Token, ShouldEqual, "file-token")
+			So(token, ShouldEqual, "yeay!")
+		})
+
+		Convey("Calling AuthFromKubernetes with a missing token file should fail", func() {
+
+			_, err := cl.AuthFromKubernetes(
+				context.Background(),
+				"",
+				filepath.Join(t.TempDir(), "token"),
+				"/ns",
+				"kubernetes",
+			)
+
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
 func TestAuthFromOIDCStep1(t *testing.T) {
 
 	Convey("The function should work", t, func() {
@@ -336,6 +619,206 @@ func TestAuthFromOIDCStep2(t *testing.T) {
 	})
 }
 
+func TestAuthFromOIDCDeviceStep1(t *testing.T) {
+
+	Convey("The function should work", t, func() {
+
+		expectedRequest := api.NewIssue()
+
+		m := maniptest.NewTestManipulator()
+		m.MockCreate(t, func(mctx manipulate.Context, object elemental.Identifiable) error {
+			expectedRequest = object.(*api.Issue)
+			expectedRequest.InputOIDC.State = "state"
+			expectedRequest.InputOIDC.UserCode = "ABCD-EFGH"
+			expectedRequest.InputOIDC.VerificationURI = "https://device"
+			expectedRequest.InputOIDC.Interval = 5
+			return nil
+		})
+
+		cl := NewClient(m)
+
+		out, err := cl.AuthFromOIDCDeviceStep1(
+			context.Background(),
+			"/ns",
+			"name",
+		)
+
+		So(err, ShouldBeNil)
+		So(expectedRequest.SourceType, ShouldEqual, api.IssueSourceTypeOIDC)
+		So(expectedRequest.SourceNamespace, ShouldEqual, "/ns")
+		So(expectedRequest.SourceName, ShouldEqual, "name")
+		So(expectedRequest.InputOIDC.DeviceAuth, ShouldBeTrue)
+		So(out.State, ShouldEqual, "state")
+		So(out.UserCode, ShouldEqual, "ABCD-EFGH")
+		So(out.VerificationURI, ShouldEqual, "https://device")
+		So(out.Interval, ShouldEqual, 5)
+	})
+
+	Convey("The function should return the errors", t, func() {
+
+		m := maniptest.NewTestManipulator()
+		m.MockCreate(t, func(mctx manipulate.Context, object elemental.Identifiable) error {
+			return fmt.Errorf("boom")
+		})
+
+		cl := NewClient(m)
+
+		out, err := cl.AuthFromOIDCDeviceStep1(context.Background(), "/ns", "name")
+		So(out, ShouldBeNil)
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldEqual, "boom")
+	})
+}
+
+func TestAuthFromOIDCDeviceStep2(t *testing.T) {
+
+	Convey("The function should poll until the request is approved", t, func() {
+
+		var calls int
+		expectedRequest := api.NewIssue()
+
+		m := maniptest.NewTestManipulator()
+		m.MockCreate(t, func(mctx manipulate.Context, object elemental.Identifiable) error {
+			calls++
+			expectedRequest = object.(*api.Issue)
+			if calls < 3 {
+				expectedRequest.InputOIDC.AuthorizationPending = true
+				return nil
+			}
+			expectedRequest.InputOIDC = nil
+			expectedRequest.Token = "yeay!"
+			return nil
+		})
+
+		cl := NewClient(m)
+
+		token, err := cl.AuthFromOIDCDeviceStep2(
+			context.Background(),
+			"/ns",
+			"name",
+			"state",
+			time.Millisecond,
+			OptAudience("aud"),
+		)
+
+		So(err, ShouldBeNil)
+		So(calls, ShouldEqual, 3)
+		So(expectedRequest.SourceType, ShouldEqual, api.IssueSourceTypeOIDC)
+		So(expectedRequest.SourceNamespace, ShouldEqual, "/ns")
+		So(expectedRequest.SourceName, ShouldEqual, "name")
+		So(expectedRequest.Audience, ShouldResemble, []string{"aud"})
+		So(token, ShouldEqual, "yeay!")
+	})
+
+	Convey("The function should send the state", t, func() {
+
+		var input *api.IssueOIDC
+
+		m := maniptest.NewTestManipulator()
+		m.MockCreate(t, func(mctx manipulate.Context, object elemental.Identifiable) error {
+			input = object.(*api.Issue).InputOIDC
+			return fmt.Errorf("boom")
+		})
+
+		cl := NewClient(m)
+
+		token, err := cl.AuthFromOIDCDeviceStep2(context.Background(), "/ns", "name", "state", time.Millisecond)
+		So(token, ShouldEqual, "")
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldEqual, "boom")
+		So(input.DeviceAuth, ShouldBeTrue)
+		So(input.State, ShouldEqual, "state")
+	})
+
+	Convey("The function should stop when the context is canceled", t, func() {
+
+		m := maniptest.NewTestManipulator()
+		m.MockCreate(t, func(mctx manipulate.Context, object elemental.Identifiable) error {
+			object.(*api.Issue).InputOIDC.AuthorizationPending = true
+			return nil
+		})
+
+		cl := NewClient(m)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		token, err := cl.AuthFromOIDCDeviceStep2(ctx, "/ns", "name", "state", time.Millisecond)
+		So(token, ShouldEqual, "")
+		So(err, ShouldEqual, context.DeadlineExceeded)
+	})
+}
+
+func TestAuthFromSAMLStep1(t *testing.T) {
+
+	Convey("The function should work", t, func() {
+
+		expectedRequest := api.NewIssue()
+
+		m := maniptest.NewTestManipulator()
+		m.MockCreate(t, func(mctx manipulate.Context, object elemental.Identifiable) error {
+			expectedRequest = object.(*api.Issue)
+			expectedRequest.InputSAML.AuthURL = "authurl"
+			expectedRequest.InputSAML.Verifier = "verifier"
+			return nil
+		})
+
+		cl := NewClient(m)
+
+		url, verifier, err := cl.AuthFromSAMLStep1(
+			context.Background(),
+			"/ns",
+			"name",
+			"url",
+		)
+
+		So(err, ShouldBeNil)
+		So(expectedRequest.SourceType, ShouldEqual, api.IssueSourceTypeSAML)
+		So(expectedRequest.SourceNamespace, ShouldEqual, "/ns")
+		So(expectedRequest.SourceName, ShouldEqual, "name")
+		So(expectedRequest.InputSAML.RedirectURL, ShouldEqual, "url")
+		So(expectedRequest.InputSAML.NoAuthRedirect, ShouldEqual, true)
+		So(url, ShouldEqual, "authurl")
+		So(verifier, ShouldEqual, "verifier")
+	})
+}
+
+func TestAuthFromSAMLStep2(t *testing.T) {
+
+	Convey("The function should work", t, func() {
+
+		expectedRequest := api.NewIssue()
+
+		m := maniptest.NewTestManipulator()
+		m.MockCreate(t, func(mctx manipulate.Context, object elemental.Identifiable) error {
+			expectedRequest = object.(*api.Issue)
+			expectedRequest.Token = "yeay!"
+			return nil
+		})
+
+		cl := NewClient(m)
+
+		token, err := cl.AuthFromSAMLStep2(
+			context.Background(),
+			"/ns",
+			"name",
+			"state",
+			"verifier",
+			OptAudience("aud"),
+		)
+
+		So(err, ShouldBeNil)
+		So(expectedRequest.SourceType, ShouldEqual, api.IssueSourceTypeSAML)
+		So(expectedRequest.SourceNamespace, ShouldEqual, "/ns")
+		So(expectedRequest.SourceName, ShouldEqual, "name")
+		So(expectedRequest.Audience, ShouldResemble, []string{"aud"})
+		So(expectedRequest.Validity, ShouldEqual, time.Hour.String())
+		So(expectedRequest.InputSAML.State, ShouldEqual, "state")
+		So(expectedRequest.InputSAML.Verifier, ShouldEqual, "verifier")
+		So(token, ShouldEqual, "yeay!")
+	})
+}
+
 func TestAuthFromHTTP(t *testing.T) {
 
 	Convey("The function should work", t, func() {
@@ -0,0 +1,33 @@
+package providers
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// KubernetesServiceAccountTokenPath is the default path
+// of the service account token mounted in a pod.
+const KubernetesServiceAccountTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token" // #nosec
+
+// KubernetesServiceAccountToken reads the projected service account token
+// from the given path. If path is empty, KubernetesServiceAccountTokenPath is used.
+// The file is read on every call, as the kubelet rotates the token.
+func KubernetesServiceAccountToken(path string) (string, error) {
+
+	if path == "" {
+		path = KubernetesServiceAccountTokenPath
+	}
+
+	data, err := os.ReadFile(path) // #nosec
+	if err != nil {
+		return "", fmt.Errorf("unable to read service account token: %w", err)
+	}
+
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("service account token file '%s' is empty", path)
+	}
+
+	return token, nil
+}
@@ -0,0 +1,47 @@
+package providers
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestKubernetesServiceAccountToken(t *testing.T) {
+
+	Convey("Given a projected service account token file", t, func() {
+
+		dir := t.TempDir()
+		path := filepath.Join(dir, "token")
+
+		Convey("When the file contains a token", func() {
+
+			So(os.WriteFile(path, []byte("token\n"), 0600), ShouldBeNil)
+
+			Convey("Then I should get the token", func() {
+				token, err := KubernetesServiceAccountToken(path)
+				So(err, ShouldBeNil)
+				So(token, ShouldEqual, "token")
+			})
+		})
+
+		Convey("When the file is empty", func() {
+
+			So(os.WriteFile(path, nil, 0600), ShouldBeNil)
+
+			Convey("Then I should get an error", func() {
+				_, err := KubernetesServiceAccountToken(path)
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When the file does not exist", func() {
+
+			Convey("Then I should get an error", func() {
+				_, err := KubernetesServiceAccountToken(path)
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
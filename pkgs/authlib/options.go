@@ -13,6 +13,8 @@ type config struct {
 	restrictions permissions.Restrictions
 	cloak        []string
 	refresh      bool
+	reference    bool
+	bound        bool
 }
 
 func newConfig() config {
@@ -71,3 +73,19 @@ func OptRefresh(refresh bool) Option {
 		opts.refresh = refresh
 	}
 }
+
+// OptReference asks for an opaque reference token.
+// It is ignored if a refresh token is requested.
+func OptReference(reference bool) Option {
+	return func(opts *config) {
+		opts.reference = reference
+	}
+}
+
+// OptCertificateBound asks for a token bound to the client
+// certificate. This is only supported by AuthFromCertificate.
+func OptCertificateBound(bound bool) Option {
+	return func(opts *config) {
+		opts.bound = bound
+	}
+}
@@ -45,4 +45,14 @@ func TestBahamut_Options(t *testing.T) {
 		OptRefresh(true)(&c)
 		So(c.refresh, ShouldBeTrue)
 	})
+
+	Convey("Calling OptReference should work", t, func() {
+		OptReference(true)(&c)
+		So(c.reference, ShouldBeTrue)
+	})
+
+	Convey("Calling OptCertificateBound should work", t, func() {
+		OptCertificateBound(true)(&c)
+		So(c.bound, ShouldBeTrue)
+	})
 }
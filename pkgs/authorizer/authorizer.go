@@ -10,6 +10,7 @@ import (
 	"github.com/spaolacci/murmur3"
 	"go.aporeto.io/a3s/pkgs/nscache"
 	"go.aporeto.io/a3s/pkgs/permissions"
+	"go.aporeto.io/a3s/pkgs/revocation"
 	"go.aporeto.io/a3s/pkgs/token"
 	"go.aporeto.io/bahamut"
 	"go.aporeto.io/elemental"
@@ -37,6 +38,13 @@ var (
 		"a3s:authorizer",
 		http.StatusForbidden,
 	)
+
+	ErrRevokedToken = elemental.NewError(
+		"Forbidden",
+		"The token has been revoked",
+		"a3s:authorizer",
+		http.StatusForbidden,
+	)
 )
 
 // An Authorizer is a bahamut.Authorizer compliant structure
@@ -59,6 +67,8 @@ type authorizer struct {
 	ignoredResources     map[string]struct{}
 	operationTransformer OperationTransformer
 	cache                *nscache.NamespacedCache
+	revocations          *revocation.Cache
+	references           token.ReferenceResolver
 }
 
 // New creates a new Authorizer using the given permissions.Retriever and PubSubClient.
@@ -87,6 +97,8 @@ func New(ctx context.Context, retriever permissions.Retriever, pubsub bahamut.Pu
 		ignoredResources:     ignored,
 		operationTransformer: cfg.operationTransformer,
 		cache:                authCache,
+		revocations:          cfg.revocations,
+		references:           cfg.references,
 	}
 }
 
@@ -99,12 +111,53 @@ func (a *authorizer) IsAuthorized(ctx bahamut.Context) (bahamut.AuthAction, erro
 		return bahamut.AuthActionOK, nil
 	}
 
-	token := token.FromRequest(req)
-	if token == "" {
+	tokenString := token.FromRequest(req)
+	if tokenString == "" {
 		return bahamut.AuthActionKO, ErrMissingToken
 	}
 
-	restrictions, err := permissions.GetRestrictions(token)
+	// The authenticator already verified the token the reference
+	// token refers to. We only need it to read its restrictions.
+	if token.IsReference(tokenString) {
+
+		if a.references == nil {
+			return bahamut.AuthActionKO, elemental.NewError(
+				"Forbidden",
+				"reference tokens are not supported",
+				"a3s:authorizer",
+				http.StatusForbidden,
+			)
+		}
+
+		resolved, err := a.references.Resolve(ctx.Context(), tokenString)
+		if err != nil {
+			return bahamut.AuthActionKO, elemental.NewError(
+				"Forbidden",
+				fmt.Sprintf("unable to resolve reference token: %s", err),
+				"a3s:authorizer",
+				http.StatusForbidden,
+			)
+		}
+
+		tokenString = resolved
+	}
+
+	if a.revocations != nil {
+		idt, err := token.ParseUnverified(tokenString)
+		if err != nil {
+			return bahamut.AuthActionKO, elemental.NewError(
+				"Forbidden",
+				err.Error(),
+				"a3s:authorizer",
+				http.StatusForbidden,
+			)
+		}
+		if a.revocations.IsRevoked(idt) {
+			return bahamut.AuthActionKO, ErrRevokedToken
+		}
+	}
+
+	restrictions, err := permissions.GetRestrictions(tokenString)
 	if err != nil {
 		return bahamut.AuthActionKO, elemental.NewError(
 			"Forbidden",
@@ -12,9 +12,11 @@ import (
 
 	"github.com/golang-jwt/jwt/v4"
 	. "github.com/smartystreets/goconvey/convey"
+	"go.aporeto.io/a3s/pkgs/api"
 	"go.aporeto.io/a3s/pkgs/notification"
 	"go.aporeto.io/a3s/pkgs/nscache"
 	"go.aporeto.io/a3s/pkgs/permissions"
+	"go.aporeto.io/a3s/pkgs/revocation"
 	"go.aporeto.io/a3s/pkgs/token"
 	"go.aporeto.io/bahamut"
 	"go.aporeto.io/elemental"
@@ -49,6 +51,15 @@ func makeToken(claims *token.IdentityToken, key crypto.PrivateKey) string {
 	return token
 }
 
+type fakeResolver map[string]string
+
+func (r fakeResolver) Resolve(ctx context.Context, reference string) (string, error) {
+	if tkn, ok := r[reference]; ok {
+		return tkn, nil
+	}
+	return "", fmt.Errorf("reference token not found")
+}
+
 func TestNewAuthorizer(t *testing.T) {
 
 	Convey("New should work", t, func() {
@@ -116,6 +127,30 @@ func TestIsAuthorized(t *testing.T) {
 			So(action, ShouldEqual, bahamut.AuthActionKO)
 		})
 
+		Convey("Calling with a revoked token should fail", func() {
+
+			_, key := getECCert()
+			idt := &token.IdentityToken{
+				Source: token.Source{Type: "mtls"},
+			}
+			token := makeToken(idt, key)
+
+			a.revocations = revocation.NewCache(nil)
+			a.revocations.Set(&api.Revocation{ID: "1", TokenID: idt.ID})
+
+			bctx := bahamut.NewMockContext(context.Background())
+			bctx.MockRequest = &elemental.Request{
+				Identity:  elemental.MakeIdentity("r0", "r0"),
+				Namespace: "/",
+				Password:  token,
+				Operation: elemental.OperationRetrieveMany,
+			}
+
+			action, err := a.IsAuthorized(bctx)
+			So(err, ShouldEqual, ErrRevokedToken)
+			So(action, ShouldEqual, bahamut.AuthActionKO)
+		})
+
 		Convey("Calling with a token with valid token and permissions are granted should work", func() {
 
 			_, key := getECCert()
@@ -140,6 +175,70 @@ func TestIsAuthorized(t *testing.T) {
 			So(action, ShouldEqual, bahamut.AuthActionOK)
 		})
 
+		Convey("Calling with a reference token and permissions are granted should work", func() {
+
+			_, key := getECCert()
+			idt := &token.IdentityToken{
+				Source: token.Source{Type: "mtls"},
+			}
+			idt.Restrictions = &permissions.Restrictions{Permissions: []string{"r0:retrieve-many"}}
+			a.references = fakeResolver{"a3sr_ref": makeToken(idt, key)}
+
+			bctx := bahamut.NewMockContext(context.Background())
+			bctx.MockRequest = &elemental.Request{
+				Identity:  elemental.MakeIdentity("r0", "r0"),
+				Namespace: "/",
+				Password:  "a3sr_ref",
+				Operation: elemental.OperationRetrieveMany,
+			}
+
+			r.MockPermissions(t, func(context.Context, []string, string, ...permissions.RetrieverOption) (permissions.PermissionMap, error) {
+				return permissions.PermissionMap{"r0": permissions.Permissions{"retrieve-many": true}}, nil
+			})
+
+			action, err := a.IsAuthorized(bctx)
+			So(err, ShouldBeNil)
+			So(action, ShouldEqual, bahamut.AuthActionOK)
+
+			// the permissions have been cached using the
+			// restrictions of the token the reference refers to.
+			So(a.cache.Get("/", hash(bctx.Claims(), "", "", *idt.Restrictions)), ShouldNotBeNil)
+		})
+
+		Convey("Calling with a reference token that cannot be resolved should fail", func() {
+
+			a.references = fakeResolver{}
+
+			bctx := bahamut.NewMockContext(context.Background())
+			bctx.MockRequest = &elemental.Request{
+				Identity:  elemental.MakeIdentity("r0", "r0"),
+				Namespace: "/",
+				Password:  "a3sr_ref",
+				Operation: elemental.OperationRetrieveMany,
+			}
+
+			action, err := a.IsAuthorized(bctx)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "error 403 (a3s:authorizer): Forbidden: unable to resolve reference token: reference token not found")
+			So(action, ShouldEqual, bahamut.AuthActionKO)
+		})
+
+		Convey("Calling with a reference token without resolver should fail", func() {
+
+			bctx := bahamut.NewMockContext(context.Background())
+			bctx.MockRequest = &elemental.Request{
+				Identity:  elemental.MakeIdentity("r0", "r0"),
+				Namespace: "/",
+				Password:  "a3sr_ref",
+				Operation: elemental.OperationRetrieveMany,
+			}
+
+			action, err := a.IsAuthorized(bctx)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "error 403 (a3s:authorizer): Forbidden: reference tokens are not supported")
+			So(action, ShouldEqual, bahamut.AuthActionKO)
+		})
+
 		Convey("Calling with a valid token, operation transformer and permissions are granted should work", func() {
 
 			transformer := NewMockOperationTransformer()
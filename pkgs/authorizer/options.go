@@ -1,10 +1,16 @@
 package authorizer
 
-import "go.aporeto.io/a3s/pkgs/permissions"
+import (
+	"go.aporeto.io/a3s/pkgs/permissions"
+	"go.aporeto.io/a3s/pkgs/revocation"
+	"go.aporeto.io/a3s/pkgs/token"
+)
 
 type config struct {
 	ignoredResources     []string
 	operationTransformer OperationTransformer
+	revocations          *revocation.Cache
+	references           token.ReferenceResolver
 }
 
 // An Option can be used to configure various options in the Authorizer.
@@ -24,6 +30,23 @@ func OptionOperationTransformer(t OperationTransformer) Option {
 	}
 }
 
+// OptionRevocations sets the revocation cache to use to reject
+// tokens that have been revoked before they expire.
+func OptionRevocations(revocations *revocation.Cache) Option {
+	return func(cfg *config) {
+		cfg.revocations = revocations
+	}
+}
+
+// OptionReferenceResolver sets the resolver to use to resolve the opaque
+// reference tokens into the tokens they refer to, so their restrictions
+// can be read. If it is not set, reference tokens are rejected.
+func OptionReferenceResolver(resolver token.ReferenceResolver) Option {
+	return func(cfg *config) {
+		cfg.references = resolver
+	}
+}
+
 type checkConfig struct {
 	sourceIP     string
 	id           string
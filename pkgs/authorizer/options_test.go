@@ -5,6 +5,7 @@ import (
 
 	. "github.com/smartystreets/goconvey/convey"
 	"go.aporeto.io/a3s/pkgs/permissions"
+	"go.aporeto.io/a3s/pkgs/revocation"
 )
 
 func TestOption(t *testing.T) {
@@ -21,6 +22,13 @@ func TestOption(t *testing.T) {
 		OptionOperationTransformer(t)(cfg)
 		So(cfg.operationTransformer, ShouldResemble, t)
 	})
+
+	Convey("OptionRevocations should work", t, func() {
+		cfg := &config{}
+		r := revocation.NewCache(nil)
+		OptionRevocations(r)(cfg)
+		So(cfg.revocations, ShouldEqual, r)
+	})
 }
 
 func TestOptionCheck(t *testing.T) {
@@ -2,11 +2,14 @@ package authorizer
 
 import (
 	"context"
+	"sync"
 
 	"go.aporeto.io/a3s/pkgs/api"
 	"go.aporeto.io/a3s/pkgs/notification"
 	"go.aporeto.io/a3s/pkgs/nscache"
+	"go.aporeto.io/a3s/pkgs/revocation"
 	"go.aporeto.io/bahamut"
+	"go.aporeto.io/elemental"
 	"go.aporeto.io/manipulate"
 )
 
@@ -15,15 +18,27 @@ type eventData struct {
 	Name      string `json:"name" msgpack:"name"`
 }
 
+type wsSubscription struct {
+	topic  string
+	pubs   chan *bahamut.Publication
+	errors chan error
+}
+
 // webSocketPubSub is a naive bahamut.PubSubClient internal implementation
 // that is backed by a manipulate.Subscriber. This is used to
 // make the Authorizer working when used by third party clients that
 // won't have access to the internal NATS notification topic.
 // It basically acts a shim layer that translates classic elemental.Events
 // into the relevant notification.Message used by the authorizer internal
-// namespace cache.
+// namespace cache and the revocation cache. As there is only one
+// underlying subscriber, every message is sent to all subscriptions,
+// and it is up to the handlers to ignore the message types they don't
+// understand.
 type webSocketPubSub struct {
-	subscriber manipulate.Subscriber
+	subscriber    manipulate.Subscriber
+	subscriptions map[*wsSubscription]struct{}
+	startOnce     sync.Once
+	lock          sync.RWMutex
 }
 
 // not implemented. These are just here to satisfy the bahamut.PubSubClient interface.
@@ -35,69 +50,141 @@ func (w *webSocketPubSub) Publish(*bahamut.Publication, ...bahamut.PubSubOptPubl
 
 func (w *webSocketPubSub) Subscribe(pubs chan *bahamut.Publication, errors chan error, topic string, opts ...bahamut.PubSubOptSubscribe) func() {
 
-	sendErr := func(err error) {
+	sub := &wsSubscription{
+		topic:  topic,
+		pubs:   pubs,
+		errors: errors,
+	}
+
+	w.lock.Lock()
+	if w.subscriptions == nil {
+		w.subscriptions = map[*wsSubscription]struct{}{}
+	}
+	w.subscriptions[sub] = struct{}{}
+	w.lock.Unlock()
+
+	w.startOnce.Do(func() { go w.listen() })
+
+	return func() {
+
+		w.lock.Lock()
+		delete(w.subscriptions, sub)
+		last := len(w.subscriptions) == 0
+		w.lock.Unlock()
+
+		if last {
+			w.subscriber.Status() <- manipulate.SubscriberStatusFinalDisconnection
+		}
+	}
+}
+
+func (w *webSocketPubSub) listen() {
+
+	for {
 		select {
-		case errors <- err:
-		default:
+
+		case evt := <-w.subscriber.Events():
+
+			msg, err := translateEvent(evt)
+			if err != nil {
+				w.sendErr(err)
+				break
+			}
+
+			if msg != nil {
+				w.sendMsg(msg)
+			}
+
+		case st := <-w.subscriber.Status():
+			if st == manipulate.SubscriberStatusFinalDisconnection {
+				return
+			}
+
+		case err := <-w.subscriber.Errors():
+			w.sendErr(err)
 		}
 	}
+}
+
+func (w *webSocketPubSub) sendErr(err error) {
 
-	sendPub := func(pub *bahamut.Publication) {
+	w.lock.RLock()
+	defer w.lock.RUnlock()
+
+	for sub := range w.subscriptions {
 		select {
-		case pubs <- pub:
+		case sub.errors <- err:
 		default:
 		}
 	}
+}
 
-	go func() {
+func (w *webSocketPubSub) sendMsg(msg *notification.Message) {
 
-		for {
-			select {
+	w.lock.RLock()
+	defer w.lock.RUnlock()
 
-			case evt := <-w.subscriber.Events():
-
-				// We decode the vent in a generic container structure.
-				d := &eventData{}
-				if err := evt.Decode(d); err != nil {
-					sendErr(err)
-					break
-				}
-
-				// We prepare a notification Message that the authorizer
-				// nscache will understand.
-				msg := notification.Message{
-					Type: nscache.NotificationNamespaceChanges,
-				}
-
-				// We populate the namespace name based on the
-				// event identity.
-				switch evt.Identity {
-				case api.NamespaceIdentity.Name:
-					msg.Data = d.Name
-				case api.AuthorizationIdentity.Name:
-					msg.Data = d.Namespace
-				}
-
-				// Then we create a publication and wrap the msg inside.
-				p := bahamut.NewPublication(topic)
-				if err := p.Encode(msg); err != nil {
-					sendErr(err)
-					break
-				}
-
-				sendPub(p)
-
-			case st := <-w.subscriber.Status():
-				if st == manipulate.SubscriberStatusFinalDisconnection {
-					return
-				}
-
-			case err := <-w.subscriber.Errors():
-				sendErr(err)
+	for sub := range w.subscriptions {
+
+		// We create a publication and wrap the msg inside.
+		p := bahamut.NewPublication(sub.topic)
+		if err := p.Encode(msg); err != nil {
+			select {
+			case sub.errors <- err:
+			default:
 			}
+			continue
+		}
+
+		select {
+		case sub.pubs <- p:
+		default:
+		}
+	}
+}
+
+// translateEvent converts the given event into the notification Message
+// the internal caches will understand. It returns nil if the event
+// has no meaning for the caches.
+func translateEvent(evt *elemental.Event) (*notification.Message, error) {
+
+	if evt.Identity == api.RevocationIdentity.Name {
+
+		rev := api.NewRevocation()
+		if err := evt.Decode(rev); err != nil {
+			return nil, err
+		}
+
+		switch evt.Type {
+		case elemental.EventCreate:
+			return &notification.Message{Type: revocation.MessageTypeCreate, Data: rev}, nil
+		case elemental.EventDelete:
+			return &notification.Message{Type: revocation.MessageTypeDelete, Data: rev}, nil
+		default:
+			return nil, nil
 		}
+	}
+
+	// We decode the event in a generic container structure.
+	d := &eventData{}
+	if err := evt.Decode(d); err != nil {
+		return nil, err
+	}
 
-	}()
+	// We prepare a notification Message that the authorizer
+	// nscache will understand.
+	msg := &notification.Message{
+		Type: nscache.NotificationNamespaceChanges,
+	}
+
+	// We populate the namespace name based on the
+	// event identity.
+	switch evt.Identity {
+	case api.NamespaceIdentity.Name:
+		msg.Data = d.Name
+	case api.AuthorizationIdentity.Name:
+		msg.Data = d.Namespace
+	}
 
-	return func() { w.subscriber.Status() <- manipulate.SubscriberStatusFinalDisconnection }
+	return msg, nil
 }
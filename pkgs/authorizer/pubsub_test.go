@@ -10,6 +10,7 @@ import (
 	"go.aporeto.io/a3s/pkgs/api"
 	"go.aporeto.io/a3s/pkgs/notification"
 	"go.aporeto.io/a3s/pkgs/nscache"
+	"go.aporeto.io/a3s/pkgs/revocation"
 	"go.aporeto.io/bahamut"
 	"go.aporeto.io/elemental"
 	"go.aporeto.io/manipulate"
@@ -72,6 +73,43 @@ func TestSubscribe(t *testing.T) {
 			checkPresent(chOutPubs)
 		})
 
+		Convey("when I receive a push from a revocation", func() {
+			chOutPubs := make(chan *bahamut.Publication, 2)
+			chOutErrs := make(chan error, 2)
+			d := a.Subscribe(chOutPubs, chOutErrs, "topic")
+			defer d()
+			chInEvents <- elemental.NewEvent(elemental.EventCreate, &api.Revocation{ID: "1", TokenID: "jti"})
+			pub := <-chOutPubs
+			msg := notification.Message{}
+			pub.Decode(&msg) // nolint
+			So(msg.Type, ShouldEqual, revocation.MessageTypeCreate)
+			So(msg.Data.(map[string]any)["tokenID"], ShouldEqual, "jti")
+		})
+
+		Convey("when I receive an update from a revocation", func() {
+			chOutPubs := make(chan *bahamut.Publication, 2)
+			chOutErrs := make(chan error, 2)
+			d := a.Subscribe(chOutPubs, chOutErrs, "topic")
+			defer d()
+			chInEvents <- elemental.NewEvent(elemental.EventUpdate, &api.Revocation{ID: "1", TokenID: "jti"})
+			chInEvents <- elemental.NewEvent(elemental.EventUpdate, &api.Namespace{Name: "/the/ns"})
+			checkPresent(chOutPubs)
+		})
+
+		Convey("when I have multiple subscriptions", func() {
+			chOutPubs1 := make(chan *bahamut.Publication, 2)
+			chOutErrs1 := make(chan error, 2)
+			chOutPubs2 := make(chan *bahamut.Publication, 2)
+			chOutErrs2 := make(chan error, 2)
+			d1 := a.Subscribe(chOutPubs1, chOutErrs1, "topic1")
+			defer d1()
+			d2 := a.Subscribe(chOutPubs2, chOutErrs2, "topic2")
+			defer d2()
+			chInEvents <- elemental.NewEvent(elemental.EventUpdate, &api.Namespace{Name: "/the/ns"})
+			checkPresent(chOutPubs1)
+			checkPresent(chOutPubs2)
+		})
+
 		Convey("when I receive an error", func() {
 			chOutPubs := make(chan *bahamut.Publication, 2)
 			chOutErrs := make(chan error, 2)
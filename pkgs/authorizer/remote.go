@@ -5,9 +5,11 @@ import (
 
 	"go.aporeto.io/a3s/pkgs/api"
 	"go.aporeto.io/a3s/pkgs/permissions"
+	"go.aporeto.io/a3s/pkgs/revocation"
 	"go.aporeto.io/elemental"
 	"go.aporeto.io/manipulate"
 	"go.aporeto.io/manipulate/maniphttp"
+	"go.uber.org/zap"
 )
 
 type remoteAuthorizer struct {
@@ -16,7 +18,9 @@ type remoteAuthorizer struct {
 
 // NewRemote returns a ready to use bahamut.Authorizer that can be used over the API.
 // This is meant to be use by external bahamut service.
-// Updates of the namespace/authorization state comes from the websocket.
+// Updates of the namespace/authorization state and revocations come from the websocket.
+// The manipulator must be allowed to retrieve the revocations in order to reject
+// revoked tokens.
 func NewRemote(ctx context.Context, m manipulate.Manipulator, r permissions.Retriever, options ...Option) Authorizer {
 
 	subscriber := maniphttp.NewSubscriber(
@@ -29,15 +33,24 @@ func NewRemote(ctx context.Context, m manipulate.Manipulator, r permissions.Retr
 	pcfg := elemental.NewPushConfig()
 	pcfg.FilterIdentity(api.NamespaceIdentity.Name)
 	pcfg.FilterIdentity(api.AuthorizationIdentity.Name)
+	pcfg.FilterIdentity(api.RevocationIdentity.Name)
 
 	subscriber.Start(ctx, pcfg)
 
+	pubsub := &webSocketPubSub{subscriber: subscriber}
+
+	revocations := revocation.NewCache(pubsub)
+	revocations.Start(ctx)
+	if err := revocations.Load(ctx, m); err != nil {
+		zap.L().Error("Unable to load revocations", zap.Error(err))
+	}
+
 	return &remoteAuthorizer{
 		Authorizer: New(
 			ctx,
 			r,
-			&webSocketPubSub{subscriber: subscriber},
-			options...,
+			pubsub,
+			append([]Option{OptionRevocations(revocations)}, options...)...,
 		),
 	}
 }
@@ -0,0 +1,371 @@
+package certrevocation
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/karlseguin/ccache/v2"
+	"go.aporeto.io/a3s/pkgs/api"
+	"golang.org/x/crypto/ocsp"
+)
+
+const (
+	maxResponseSize         = 10 << 20
+	defaultCRLCacheDuration = time.Hour
+	minCRLCacheDuration     = time.Minute
+	maxCRLCacheDuration     = 24 * time.Hour
+	minOCSPCacheDuration    = time.Minute
+	maxOCSPCacheDuration    = time.Hour
+	maxClockSkew            = 5 * time.Minute
+)
+
+// ErrRevoked is returned when a certificate has been revoked.
+var ErrRevoked = errors.New("certificate has been revoked")
+
+// A Checker checks the revocation status of certificates
+// using certificate revocation lists and OCSP responders.
+// The retrieved CRLs and OCSP responses are cached until
+// their next update.
+type Checker struct {
+	client *http.Client
+	crls   *ccache.Cache
+	ocsps  *ccache.Cache
+}
+
+// NewChecker returns a new Checker that will use the given
+// http.Client to retrieve CRLs and query OCSP responders.
+func NewChecker(client *http.Client) *Checker {
+
+	return &Checker{
+		client: client,
+		crls:   ccache.New(ccache.Configure().MaxSize(1024)),
+		ocsps:  ccache.New(ccache.Configure().MaxSize(4096)),
+	}
+}
+
+// Check verifies the given certificate, issued by the given issuer,
+// has not been revoked, according to the revocation settings of the
+// given MTLSSource. It returns ErrRevoked if the certificate has been
+// revoked, or another error if its status cannot be determined.
+func (c *Checker) Check(ctx context.Context, source *api.MTLSSource, cert *x509.Certificate, issuer *x509.Certificate) error {
+
+	if source.CRL != "" {
+
+		crls, err := ParseCRLs([]byte(source.CRL))
+		if err != nil {
+			return err
+		}
+
+		for _, crl := range crls {
+
+			// The source can hold the CRLs of several CAs.
+			if !isIssuedBy(crl, cert) {
+				continue
+			}
+
+			if err := crl.CheckSignatureFrom(issuer); err != nil {
+				return fmt.Errorf("unable to verify crl signature: %w", err)
+			}
+
+			if isExpired(crl, time.Now()) {
+				return fmt.Errorf("crl has expired on %s", crl.NextUpdate.Format(time.RFC3339))
+			}
+
+			if isRevoked(crl, cert) {
+				return ErrRevoked
+			}
+		}
+	}
+
+	if source.FetchCRL {
+
+		for _, u := range cert.CRLDistributionPoints {
+
+			crl, err := c.retrieveCRL(ctx, u, issuer)
+			if err != nil {
+				return err
+			}
+
+			if crl != nil && isRevoked(crl, cert) {
+				return ErrRevoked
+			}
+		}
+	}
+
+	if source.CheckOCSP && len(cert.OCSPServer) > 0 {
+		return c.checkOCSP(ctx, cert, issuer)
+	}
+
+	return nil
+}
+
+// ParseCRLs parses the certificate revocation lists
+// contained in the given PEM data.
+func ParseCRLs(data []byte) ([]*x509.RevocationList, error) {
+
+	var crls []*x509.RevocationList
+
+	var block *pem.Block
+	for {
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+
+		if block.Type != "X509 CRL" {
+			continue
+		}
+
+		crl, err := x509.ParseRevocationList(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse crl: %w", err)
+		}
+
+		crls = append(crls, crl)
+	}
+
+	return crls, nil
+}
+
+// retrieveCRL returns the CRL published at the given distribution point.
+// It returns nil if the distribution point is not an http url.
+func (c *Checker) retrieveCRL(ctx context.Context, u string, issuer *x509.Certificate) (*x509.RevocationList, error) {
+
+	if pu, err := url.Parse(u); err != nil || (pu.Scheme != "http" && pu.Scheme != "https") {
+		return nil, nil
+	}
+
+	// The CRL is only trusted for the issuer
+	// that signed it when it was retrieved.
+	key := issuerKey(issuer) + "\n" + u
+
+	// A cached CRL past its next update is retrieved again.
+	if item := c.crls.Get(key); item != nil && !item.Expired() {
+		if crl := item.Value().(*x509.RevocationList); !isExpired(crl, time.Now()) {
+			return crl, nil
+		}
+	}
+
+	data, err := c.fetch(ctx, http.MethodGet, u, "", nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve crl from '%s': %w", u, err)
+	}
+
+	// Distribution points usually serve DER
+	// but some of them serve PEM.
+	if block, _ := pem.Decode(data); block != nil {
+		data = block.Bytes
+	}
+
+	crl, err := x509.ParseRevocationList(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse crl from '%s': %w", u, err)
+	}
+
+	if err := crl.CheckSignatureFrom(issuer); err != nil {
+		return nil, fmt.Errorf("unable to verify crl signature from '%s': %w", u, err)
+	}
+
+	if isExpired(crl, time.Now()) {
+		return nil, fmt.Errorf("crl from '%s' has expired on %s", u, crl.NextUpdate.Format(time.RFC3339))
+	}
+
+	c.crls.Set(key, crl, crlCacheDuration(crl, time.Now()))
+
+	return crl, nil
+}
+
+// checkOCSP queries the OCSP responders of the given certificate in
+// order, until one of them gives a valid response. Responses giving
+// the status of the certificate are cached by issuer and serial.
+func (c *Checker) checkOCSP(ctx context.Context, cert *x509.Certificate, issuer *x509.Certificate) error {
+
+	key := issuerKey(issuer) + "\n" + cert.SerialNumber.String()
+
+	// A cached response past its next update is retrieved again.
+	if item := c.ocsps.Get(key); item != nil && !item.Expired() {
+		if resp := item.Value().(*ocsp.Response); isCurrent(resp, time.Now()) {
+			return ocspStatus(resp)
+		}
+	}
+
+	req, err := ocsp.CreateRequest(cert, issuer, nil)
+	if err != nil {
+		return fmt.Errorf("unable to create ocsp request: %w", err)
+	}
+
+	for _, u := range cert.OCSPServer {
+
+		var data []byte
+		data, err = c.fetch(ctx, http.MethodPost, u, "application/ocsp-request", req)
+		if err != nil {
+			err = fmt.Errorf("unable to query ocsp responder '%s': %w", u, err)
+			continue
+		}
+
+		var resp *ocsp.Response
+		resp, err = ocsp.ParseResponseForCert(data, cert, issuer)
+		if err != nil {
+			err = fmt.Errorf("unable to parse ocsp response from '%s': %w", u, err)
+			continue
+		}
+
+		if !isCurrent(resp, time.Now()) {
+			err = fmt.Errorf("ocsp response from '%s' is outside of its validity period", u)
+			continue
+		}
+
+		if resp.Status != ocsp.Good && resp.Status != ocsp.Revoked {
+			err = fmt.Errorf("ocsp responder '%s' does not know the certificate", u)
+			continue
+		}
+
+		c.ocsps.Set(key, resp, ocspCacheDuration(resp, time.Now()))
+
+		return ocspStatus(resp)
+	}
+
+	return err
+}
+
+// ocspStatus returns ErrRevoked if the given
+// OCSP response says the certificate is revoked.
+func ocspStatus(resp *ocsp.Response) error {
+
+	if resp.Status == ocsp.Revoked {
+		return ErrRevoked
+	}
+
+	return nil
+}
+
+func (c *Checker) fetch(ctx context.Context, method string, u string, contentType string, body []byte) ([]byte, error) {
+
+	req, err := http.NewRequestWithContext(ctx, method, u, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close() // nolint: errcheck
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("server responded with '%s'", resp.Status)
+	}
+
+	return io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+}
+
+// issuerKey returns the key identifying the
+// given issuer in the caches.
+func issuerKey(issuer *x509.Certificate) string {
+
+	sum := sha256.Sum256(issuer.Raw)
+
+	return hex.EncodeToString(sum[:])
+}
+
+func isIssuedBy(crl *x509.RevocationList, cert *x509.Certificate) bool {
+
+	if !bytes.Equal(crl.RawIssuer, cert.RawIssuer) {
+		return false
+	}
+
+	if len(crl.AuthorityKeyId) > 0 && len(cert.AuthorityKeyId) > 0 {
+		return bytes.Equal(crl.AuthorityKeyId, cert.AuthorityKeyId)
+	}
+
+	return true
+}
+
+func isRevoked(crl *x509.RevocationList, cert *x509.Certificate) bool {
+
+	for _, entry := range crl.RevokedCertificateEntries {
+		if entry.SerialNumber.Cmp(cert.SerialNumber) == 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isExpired returns true if the given CRL is past its next update.
+// A CRL with no next update never expires.
+func isExpired(crl *x509.RevocationList, now time.Time) bool {
+
+	return !crl.NextUpdate.IsZero() && now.After(crl.NextUpdate.Add(maxClockSkew))
+}
+
+// isCurrent returns true if the given OCSP response is within its
+// validity period. A response with no next update means newer
+// information is always available, and is only checked against
+// its this update.
+func isCurrent(resp *ocsp.Response, now time.Time) bool {
+
+	if now.Before(resp.ThisUpdate.Add(-maxClockSkew)) {
+		return false
+	}
+
+	return resp.NextUpdate.IsZero() || !now.After(resp.NextUpdate.Add(maxClockSkew))
+}
+
+// crlCacheDuration returns how long the given CRL can be cached.
+// The CRL is kept until its next update, within reasonable bounds.
+func crlCacheDuration(crl *x509.RevocationList, now time.Time) time.Duration {
+
+	if crl.NextUpdate.IsZero() {
+		return defaultCRLCacheDuration
+	}
+
+	d := crl.NextUpdate.Sub(now)
+
+	if d < minCRLCacheDuration {
+		return minCRLCacheDuration
+	}
+
+	if d > maxCRLCacheDuration {
+		return maxCRLCacheDuration
+	}
+
+	return d
+}
+
+// ocspCacheDuration returns how long the given OCSP response can be
+// cached. The response is kept until its next update, within reasonable
+// bounds. A response with no next update means newer information is
+// always available, so it is only kept for the minimum duration.
+func ocspCacheDuration(resp *ocsp.Response, now time.Time) time.Duration {
+
+	if resp.NextUpdate.IsZero() {
+		return minOCSPCacheDuration
+	}
+
+	d := resp.NextUpdate.Sub(now)
+
+	if d < minOCSPCacheDuration {
+		return minOCSPCacheDuration
+	}
+
+	if d > maxOCSPCacheDuration {
+		return maxOCSPCacheDuration
+	}
+
+	return d
+}
@@ -0,0 +1,401 @@
+package certrevocation
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.aporeto.io/a3s/pkgs/api"
+	"golang.org/x/crypto/ocsp"
+)
+
+func makeCert(serial int64, parent *x509.Certificate, parentKey crypto.Signer, crlURL string, ocspURL string) (*x509.Certificate, crypto.Signer) {
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "cert"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	if parent == nil {
+		template.Subject.CommonName = "ca"
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageCRLSign
+		template.ExtKeyUsage = nil
+		parent = template
+		parentKey = key
+	}
+
+	if crlURL != "" {
+		template.CRLDistributionPoints = []string{crlURL}
+	}
+
+	if ocspURL != "" {
+		template.OCSPServer = []string{ocspURL}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, key.Public(), parentKey)
+	if err != nil {
+		panic(err)
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		panic(err)
+	}
+
+	return cert, key
+}
+
+func makeCRL(ca *x509.Certificate, key crypto.Signer, nextUpdate time.Time, serials ...int64) []byte {
+
+	var entries []x509.RevocationListEntry
+	for _, s := range serials {
+		entries = append(entries, x509.RevocationListEntry{SerialNumber: big.NewInt(s), RevocationTime: time.Now()})
+	}
+
+	der, err := x509.CreateRevocationList(
+		rand.Reader,
+		&x509.RevocationList{
+			Number:                    big.NewInt(1),
+			ThisUpdate:                nextUpdate.Add(-2 * time.Hour),
+			NextUpdate:                nextUpdate,
+			RevokedCertificateEntries: entries,
+		},
+		ca,
+		key,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	return der
+}
+
+func toPEM(crls ...[]byte) string {
+
+	var out []byte
+	for _, crl := range crls {
+		out = append(out, pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: crl})...)
+	}
+
+	return string(out)
+}
+
+func TestCheck(t *testing.T) {
+
+	Convey("Given a CA, a CRL distribution point and an OCSP responder", t, func() {
+
+		ca, cakey := makeCert(1, nil, nil, "", "")
+		otherCA, otherCAKey := makeCert(1, nil, nil, "", "")
+
+		var crlCalls int
+		var crl []byte
+		crlServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			crlCalls++
+			if crl == nil {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(crl)
+		}))
+		defer crlServer.Close()
+
+		var ocspCalls int
+		ocspStatus := ocsp.Good
+		ocspSigner := cakey
+		ocspThisUpdate := time.Now()
+		ocspNextUpdate := time.Now().Add(time.Hour)
+		ocspServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ocspCalls++
+			data, _ := io.ReadAll(r.Body)
+			req, err := ocsp.ParseRequest(data)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			resp, err := ocsp.CreateResponse(ca, ca, ocsp.Response{
+				Status:       ocspStatus,
+				SerialNumber: req.SerialNumber,
+				ThisUpdate:   ocspThisUpdate,
+				NextUpdate:   ocspNextUpdate,
+				RevokedAt:    time.Now(),
+			}, ocspSigner)
+			if err != nil {
+				panic(err)
+			}
+			_, _ = w.Write(resp)
+		}))
+		defer ocspServer.Close()
+
+		cert, _ := makeCert(42, ca, cakey, crlServer.URL+"/ca.crl", ocspServer.URL)
+
+		src := api.NewMTLSSource()
+		checker := NewChecker(http.DefaultClient)
+
+		Convey("When the source has no revocation settings", func() {
+			So(checker.Check(context.Background(), src, cert, ca), ShouldBeNil)
+			So(crlCalls, ShouldEqual, 0)
+		})
+
+		Convey("When the source has an inline CRL", func() {
+
+			Convey("Then a certificate that is not in it should be accepted", func() {
+				src.CRL = toPEM(makeCRL(ca, cakey, time.Now().Add(time.Hour), 43))
+				So(checker.Check(context.Background(), src, cert, ca), ShouldBeNil)
+			})
+
+			Convey("Then a certificate that is in it should be rejected", func() {
+				src.CRL = toPEM(makeCRL(ca, cakey, time.Now().Add(time.Hour), 43, 42))
+				So(checker.Check(context.Background(), src, cert, ca), ShouldEqual, ErrRevoked)
+			})
+
+			Convey("Then an expired CRL should be rejected", func() {
+				src.CRL = toPEM(makeCRL(ca, cakey, time.Now().Add(-time.Hour), 43))
+				err := checker.Check(context.Background(), src, cert, ca)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldStartWith, "crl has expired on ")
+			})
+
+			Convey("Then the CRLs of other CAs should be ignored", func() {
+				src.CRL = toPEM(
+					makeCRL(otherCA, otherCAKey, time.Now().Add(time.Hour), 42),
+					makeCRL(ca, cakey, time.Now().Add(time.Hour), 43),
+				)
+				So(checker.Check(context.Background(), src, cert, ca), ShouldBeNil)
+			})
+		})
+
+		Convey("When the source fetches the CRLs", func() {
+
+			src.FetchCRL = true
+
+			Convey("Then a certificate that is not in it should be accepted and the CRL cached", func() {
+				crl = makeCRL(ca, cakey, time.Now().Add(time.Hour), 43)
+				So(checker.Check(context.Background(), src, cert, ca), ShouldBeNil)
+				So(checker.Check(context.Background(), src, cert, ca), ShouldBeNil)
+				So(crlCalls, ShouldEqual, 1)
+			})
+
+			Convey("Then a certificate that is in it should be rejected", func() {
+				crl = makeCRL(ca, cakey, time.Now().Add(time.Hour), 42)
+				So(checker.Check(context.Background(), src, cert, ca), ShouldEqual, ErrRevoked)
+			})
+
+			Convey("Then a CRL in PEM format should work", func() {
+				crl = []byte(toPEM(makeCRL(ca, cakey, time.Now().Add(time.Hour), 42)))
+				So(checker.Check(context.Background(), src, cert, ca), ShouldEqual, ErrRevoked)
+			})
+
+			Convey("Then a CRL signed by another CA should be rejected", func() {
+				crl = makeCRL(otherCA, otherCAKey, time.Now().Add(time.Hour), 43)
+				err := checker.Check(context.Background(), src, cert, ca)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldStartWith, "unable to verify crl signature from '"+crlServer.URL+"/ca.crl': ")
+			})
+
+			Convey("Then an expired CRL should be rejected and not cached", func() {
+				crl = makeCRL(ca, cakey, time.Now().Add(-time.Hour), 43)
+				err := checker.Check(context.Background(), src, cert, ca)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldStartWith, "crl from '"+crlServer.URL+"/ca.crl' has expired on ")
+				So(checker.Check(context.Background(), src, cert, ca), ShouldNotBeNil)
+				So(crlCalls, ShouldEqual, 2)
+			})
+
+			Convey("Then a cached CRL past its next update should be retrieved again", func() {
+				expired, err := x509.ParseRevocationList(makeCRL(ca, cakey, time.Now().Add(-time.Hour), 43))
+				So(err, ShouldBeNil)
+				checker.crls.Set(issuerKey(ca)+"\n"+crlServer.URL+"/ca.crl", expired, time.Hour)
+
+				crl = makeCRL(ca, cakey, time.Now().Add(time.Hour), 42)
+				So(checker.Check(context.Background(), src, cert, ca), ShouldEqual, ErrRevoked)
+				So(crlCalls, ShouldEqual, 1)
+			})
+
+			Convey("Then a cached CRL should not be used for another issuer", func() {
+				crl = makeCRL(ca, cakey, time.Now().Add(time.Hour), 43)
+				So(checker.Check(context.Background(), src, cert, ca), ShouldBeNil)
+
+				otherCert, _ := makeCert(42, otherCA, otherCAKey, crlServer.URL+"/ca.crl", "")
+				err := checker.Check(context.Background(), src, otherCert, otherCA)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldStartWith, "unable to verify crl signature from '"+crlServer.URL+"/ca.crl': ")
+				So(crlCalls, ShouldEqual, 2)
+			})
+
+			Convey("Then an unreachable CRL should be rejected", func() {
+				err := checker.Check(context.Background(), src, cert, ca)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "unable to retrieve crl from '"+crlServer.URL+"/ca.crl': server responded with '404 Not Found'")
+			})
+		})
+
+		Convey("When the source checks OCSP", func() {
+
+			src.CheckOCSP = true
+
+			Convey("Then a good certificate should be accepted and the response cached", func() {
+				So(checker.Check(context.Background(), src, cert, ca), ShouldBeNil)
+				So(checker.Check(context.Background(), src, cert, ca), ShouldBeNil)
+				So(ocspCalls, ShouldEqual, 1)
+			})
+
+			Convey("Then a revoked certificate should be rejected and the response cached", func() {
+				ocspStatus = ocsp.Revoked
+				So(checker.Check(context.Background(), src, cert, ca), ShouldEqual, ErrRevoked)
+				So(checker.Check(context.Background(), src, cert, ca), ShouldEqual, ErrRevoked)
+				So(ocspCalls, ShouldEqual, 1)
+			})
+
+			Convey("Then another certificate should not use the cached response", func() {
+				So(checker.Check(context.Background(), src, cert, ca), ShouldBeNil)
+
+				ocspStatus = ocsp.Revoked
+				otherCert, _ := makeCert(43, ca, cakey, "", ocspServer.URL)
+				So(checker.Check(context.Background(), src, otherCert, ca), ShouldEqual, ErrRevoked)
+				So(ocspCalls, ShouldEqual, 2)
+			})
+
+			Convey("Then a cached response past its next update should be retrieved again", func() {
+				checker.ocsps.Set(
+					issuerKey(ca)+"\n"+cert.SerialNumber.String(),
+					&ocsp.Response{Status: ocsp.Good, ThisUpdate: time.Now().Add(-2 * time.Hour), NextUpdate: time.Now().Add(-time.Hour)},
+					time.Hour,
+				)
+
+				ocspStatus = ocsp.Revoked
+				So(checker.Check(context.Background(), src, cert, ca), ShouldEqual, ErrRevoked)
+				So(ocspCalls, ShouldEqual, 1)
+			})
+
+			Convey("Then an unknown certificate should be rejected and the response not cached", func() {
+				ocspStatus = ocsp.Unknown
+				err := checker.Check(context.Background(), src, cert, ca)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "ocsp responder '"+ocspServer.URL+"' does not know the certificate")
+				So(checker.Check(context.Background(), src, cert, ca), ShouldNotBeNil)
+				So(ocspCalls, ShouldEqual, 2)
+			})
+
+			Convey("Then an expired response should be rejected", func() {
+				ocspThisUpdate = time.Now().Add(-2 * time.Hour)
+				ocspNextUpdate = time.Now().Add(-time.Hour)
+				err := checker.Check(context.Background(), src, cert, ca)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "ocsp response from '"+ocspServer.URL+"' is outside of its validity period")
+			})
+
+			Convey("Then a response from the future should be rejected", func() {
+				ocspThisUpdate = time.Now().Add(time.Hour)
+				ocspNextUpdate = time.Now().Add(2 * time.Hour)
+				err := checker.Check(context.Background(), src, cert, ca)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "ocsp response from '"+ocspServer.URL+"' is outside of its validity period")
+			})
+
+			Convey("Then a response signed by another key should be rejected", func() {
+				ocspSigner = otherCAKey
+				err := checker.Check(context.Background(), src, cert, ca)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldStartWith, "unable to parse ocsp response from '"+ocspServer.URL+"': ")
+			})
+		})
+	})
+}
+
+func Test_isCurrent(t *testing.T) {
+
+	now := time.Now()
+
+	tests := []struct {
+		name       string
+		thisUpdate time.Time
+		nextUpdate time.Time
+		want       bool
+	}{
+		{"current", now.Add(-time.Hour), now.Add(time.Hour), true},
+		{"no next update", now.Add(-time.Hour), time.Time{}, true},
+		{"this update within clock skew", now.Add(time.Minute), now.Add(time.Hour), true},
+		{"next update within clock skew", now.Add(-time.Hour), now.Add(-time.Minute), true},
+		{"this update in the future", now.Add(time.Hour), now.Add(2 * time.Hour), false},
+		{"next update in the past", now.Add(-2 * time.Hour), now.Add(-time.Hour), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isCurrent(&ocsp.Response{ThisUpdate: tt.thisUpdate, NextUpdate: tt.nextUpdate}, now); got != tt.want {
+				t.Errorf("isCurrent() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_crlCacheDuration(t *testing.T) {
+
+	now := time.Now()
+
+	tests := []struct {
+		name       string
+		nextUpdate time.Time
+		want       time.Duration
+	}{
+		{"no next update", time.Time{}, defaultCRLCacheDuration},
+		{"next update in the past", now.Add(-time.Hour), minCRLCacheDuration},
+		{"next update soon", now.Add(2 * time.Hour), 2 * time.Hour},
+		{"next update far away", now.Add(30 * 24 * time.Hour), maxCRLCacheDuration},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := crlCacheDuration(&x509.RevocationList{NextUpdate: tt.nextUpdate}, now); got != tt.want {
+				t.Errorf("crlCacheDuration() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_ocspCacheDuration(t *testing.T) {
+
+	now := time.Now()
+
+	tests := []struct {
+		name       string
+		nextUpdate time.Time
+		want       time.Duration
+	}{
+		{"no next update", time.Time{}, minOCSPCacheDuration},
+		{"next update in the past", now.Add(-time.Hour), minOCSPCacheDuration},
+		{"next update soon", now.Add(30 * time.Minute), 30 * time.Minute},
+		{"next update far away", now.Add(7 * 24 * time.Hour), maxOCSPCacheDuration},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ocspCacheDuration(&ocsp.Response{NextUpdate: tt.nextUpdate}, now); got != tt.want {
+				t.Errorf("ocspCacheDuration() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/karlseguin/ccache/v2"
 	"go.aporeto.io/a3s/pkgs/api"
+	"go.aporeto.io/a3s/pkgs/certrevocation"
 	"go.aporeto.io/bahamut/gateway"
 	"go.aporeto.io/elemental"
 	"go.aporeto.io/manipulate"
@@ -28,7 +29,9 @@ import (
 // The returned function will use the provided manipulator to search A3S for an
 // mtls source that holds the CA that has issued the presented client
 // certificates by matching the certificate AuthorityKeyID. If it can find one,
-// the certificate signature will be checked using the matching CA.
+// the certificate signature will be checked using the matching CA. If the
+// mtls source has revocation settings, the revocation status of the
+// certificate will also be checked.
 //
 // The results are cached for the provided cacheDuration and a maximum of
 // cacheSize items will be kept.
@@ -44,6 +47,7 @@ func MakeTLSPeerCertificateVerifier(
 	}
 
 	cache := ccache.New(ccache.Configure().MaxSize(cfg.cacheMaxSize))
+	checker := certrevocation.NewChecker(&http.Client{Timeout: cfg.timeout})
 
 	return func(
 		rawCerts [][]byte,
@@ -61,7 +65,7 @@ func MakeTLSPeerCertificateVerifier(
 
 		authorityKeyID := fmt.Sprintf("%02X", cert.AuthorityKeyId)
 		item := cache.Get(authorityKeyID)
-		var pool *x509.CertPool
+		var src *verifierSource
 
 		if item == nil || item.Expired() {
 
@@ -90,28 +94,51 @@ func MakeTLSPeerCertificateVerifier(
 				return fmt.Errorf("more than one mtls sources hold the signing CA. this is not supported")
 			}
 
-			pool = x509.NewCertPool()
-			pool.AppendCertsFromPEM([]byte(sources[0].CA))
-			cache.Set(authorityKeyID, pool, cfg.cacheDuration)
+			src = &verifierSource{
+				pool:   x509.NewCertPool(),
+				source: sources[0],
+			}
+			src.pool.AppendCertsFromPEM([]byte(sources[0].CA))
+			cache.Set(authorityKeyID, src, cfg.cacheDuration)
 		} else {
-			pool = item.Value().(*x509.CertPool)
+			src = item.Value().(*verifierSource)
 		}
 
-		if _, err := cert.Verify(
+		chains, err := cert.Verify(
 			x509.VerifyOptions{
-				Roots: pool,
+				Roots: src.pool,
 				KeyUsages: []x509.ExtKeyUsage{
 					x509.ExtKeyUsageClientAuth,
 				},
 			},
-		); err != nil {
+		)
+		if err != nil {
 			return fmt.Errorf("unable to validate client certificate: %w", err)
 		}
 
+		issuer := chains[0][0]
+		if len(chains[0]) > 1 {
+			issuer = chains[0][1]
+		}
+
+		tctx, cancel := context.WithTimeout(ctx, cfg.timeout)
+		defer cancel()
+
+		if err := checker.Check(tctx, src.source, cert, issuer); err != nil {
+			return fmt.Errorf("unable to validate client certificate revocation: %w", err)
+		}
+
 		return nil
 	}
 }
 
+// A verifierSource holds the cached
+// information about an mtls source.
+type verifierSource struct {
+	pool   *x509.CertPool
+	source *api.MTLSSource
+}
+
 // MakeTLSPeerCertificateForwarder returns a bahamut gateway.InterceptorFunc
 // that you will need to add to the bahamut.Gateway in order to intercept any
 // calls going to the A3S /issue endpoint (or any other one you would have as a
@@ -3,11 +3,13 @@ package gwutils
 import (
 	"context"
 	"crypto"
+	"crypto/rand"
 	"crypto/tls"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/pem"
 	"fmt"
+	"math/big"
 	"net/http"
 	"testing"
 	"time"
@@ -21,9 +23,9 @@ import (
 	"go.aporeto.io/tg/tglib"
 )
 
-func getECCert() (*x509.Certificate, crypto.PrivateKey) {
+func getECCert(opts ...tglib.IssueOption) (*x509.Certificate, crypto.PrivateKey) {
 
-	certBlock, keyBlock, err := tglib.Issue(pkix.Name{})
+	certBlock, keyBlock, err := tglib.Issue(pkix.Name{}, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -41,6 +43,31 @@ func getECCert() (*x509.Certificate, crypto.PrivateKey) {
 	return cert, key
 }
 
+func getCRL(ca *x509.Certificate, key crypto.PrivateKey, serials ...int64) string {
+
+	var entries []x509.RevocationListEntry
+	for _, s := range serials {
+		entries = append(entries, x509.RevocationListEntry{SerialNumber: big.NewInt(s), RevocationTime: time.Now()})
+	}
+
+	der, err := x509.CreateRevocationList(
+		rand.Reader,
+		&x509.RevocationList{
+			Number:                    big.NewInt(1),
+			ThisUpdate:                time.Now(),
+			NextUpdate:                time.Now().Add(time.Hour),
+			RevokedCertificateEntries: entries,
+		},
+		ca,
+		key.(crypto.Signer),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: der}))
+}
+
 func TestMakeTLSPeerCertificateVerifier(t *testing.T) {
 
 	Convey("Given a cert and a manipulator", t, func() {
@@ -124,6 +151,32 @@ func TestMakeTLSPeerCertificateVerifier(t *testing.T) {
 			So(err.Error(), ShouldStartWith, "unable to validate client certificate: x509: certificate signed by unknown authority")
 		})
 
+		Convey("Calling with a certificate that has been revoked should fail", func() {
+
+			ca, cakey := getECCert(tglib.OptIssueTypeCA())
+			caBlock, _ := tglib.CertToPEM(ca)
+			userCert, _ := getECCert(
+				tglib.OptIssueSigner(ca, cakey),
+				tglib.OptIssueSerialNumber(big.NewInt(42)),
+			)
+			userBlock, _ := tglib.CertToPEM(userCert)
+
+			m.MockRetrieveMany(t, func(mctx manipulate.Context, dest elemental.Identifiables) error {
+				*dest.(*api.MTLSSourcesList) = append(
+					*dest.(*api.MTLSSourcesList),
+					&api.MTLSSource{
+						CA:  string(pem.EncodeToMemory(caBlock)),
+						CRL: getCRL(ca, cakey, 42),
+					},
+				)
+				return nil
+			})
+
+			err := verifier([][]byte{userBlock.Bytes}, nil)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "unable to validate client certificate revocation: certificate has been revoked")
+		})
+
 		Convey("Calling with a certificate that does not matche any existing mtls source should fail", func() {
 
 			m.MockRetrieveMany(t, func(mctx manipulate.Context, dest elemental.Identifiables) error {
@@ -0,0 +1,320 @@
+package revocation
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.aporeto.io/a3s/pkgs/api"
+	"go.aporeto.io/a3s/pkgs/notification"
+	"go.aporeto.io/a3s/pkgs/token"
+	"go.aporeto.io/bahamut"
+	"go.aporeto.io/elemental"
+	"go.aporeto.io/manipulate"
+	"go.uber.org/zap"
+)
+
+// Constants for notification topics.
+const (
+	NotificationRevocationChanges = "notifications.changes.revocation"
+)
+
+// Various types of notification messages sent
+// on NotificationRevocationChanges.
+const (
+	MessageTypeCreate = "revocation.create"
+	MessageTypeDelete = "revocation.delete"
+)
+
+// A Cache holds the revocations currently in effect and can
+// tell if an IdentityToken has been revoked. It is kept in sync
+// using the notifications received from the pubsub.
+type Cache struct {
+	pubsub      bahamut.PubSubClient
+	revocations map[string]*api.Revocation
+	tokenIDs    map[string]map[string]*api.Revocation
+	families    map[string]map[string]*api.Revocation
+	lock        sync.RWMutex
+}
+
+// NewCache returns a new Cache that will use the given
+// pubsub to receive revocation changes.
+func NewCache(pubsub bahamut.PubSubClient) *Cache {
+	return &Cache{
+		pubsub:      pubsub,
+		revocations: map[string]*api.Revocation{},
+		tokenIDs:    map[string]map[string]*api.Revocation{},
+		families:    map[string]map[string]*api.Revocation{},
+	}
+}
+
+// Load retrieves all the existing revocations using the given manipulator
+// and adds them to the cache.
+func (c *Cache) Load(ctx context.Context, m manipulate.Manipulator) error {
+
+	revs := api.RevocationsList{}
+	if err := m.RetrieveMany(
+		manipulate.NewContext(ctx, manipulate.ContextOptionRecursive(true)),
+		&revs,
+	); err != nil {
+		return fmt.Errorf("unable to retrieve revocations: %w", err)
+	}
+
+	for _, rev := range revs {
+		c.Set(rev)
+	}
+
+	return nil
+}
+
+// Start starts listening to notifications to keep the cache
+// up to date and periodically drops expired revocations.
+func (c *Cache) Start(ctx context.Context) {
+
+	notification.Subscribe(
+		ctx,
+		c.pubsub,
+		NotificationRevocationChanges,
+		func(msg *notification.Message) {
+
+			if msg.Type != MessageTypeCreate && msg.Type != MessageTypeDelete {
+				return
+			}
+
+			rev := api.NewRevocation()
+			if err := decodeMessageData(msg.Data, rev); err != nil {
+				zap.L().Error("Unable to decode revocation notification", zap.Error(err))
+				return
+			}
+
+			switch msg.Type {
+			case MessageTypeCreate:
+				c.Set(rev)
+			case MessageTypeDelete:
+				c.Delete(rev.ID)
+			}
+		},
+	)
+
+	go func() {
+
+		ticker := time.NewTicker(time.Minute)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				c.prune(time.Now())
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Set adds or replaces the given revocation.
+func (c *Cache) Set(rev *api.Revocation) {
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.remove(rev.ID)
+
+	c.revocations[rev.ID] = rev
+
+	switch {
+	case rev.TokenID != "":
+		index(c.tokenIDs, rev.TokenID, rev)
+	case rev.TokenFamily != "":
+		index(c.families, rev.TokenFamily, rev)
+	}
+}
+
+// Delete removes the revocation with the given ID.
+func (c *Cache) Delete(id string) {
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.remove(id)
+}
+
+// IsRevoked returns true if the given IdentityToken
+// is covered by a revocation.
+func (c *Cache) IsRevoked(idt *token.IdentityToken) bool {
+
+	now := time.Now()
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	if idt.ID != "" {
+		if covers(c.tokenIDs[idt.ID], idt, now) {
+			return true
+		}
+		// The first refresh token of a family has no family
+		// claim, as the family ID is its own ID.
+		if covers(c.families[idt.ID], idt, now) {
+			return true
+		}
+	}
+
+	if idt.RefreshFamily != "" {
+		if covers(c.families[idt.RefreshFamily], idt, now) {
+			return true
+		}
+	}
+
+	for _, rev := range c.revocations {
+
+		if rev.TokenID != "" || rev.TokenFamily != "" || isExpired(rev, now) {
+			continue
+		}
+
+		if matches(rev, idt) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *Cache) remove(id string) {
+
+	rev, ok := c.revocations[id]
+	if !ok {
+		return
+	}
+
+	delete(c.revocations, id)
+	if rev.TokenID != "" {
+		unindex(c.tokenIDs, rev.TokenID, id)
+	}
+	if rev.TokenFamily != "" {
+		unindex(c.families, rev.TokenFamily, id)
+	}
+}
+
+// index adds the given revocation to the revocations
+// of the given token or family ID. Several revocations
+// from different namespaces can target the same ID.
+func index(revs map[string]map[string]*api.Revocation, key string, rev *api.Revocation) {
+
+	if revs[key] == nil {
+		revs[key] = map[string]*api.Revocation{}
+	}
+
+	revs[key][rev.ID] = rev
+}
+
+func unindex(revs map[string]map[string]*api.Revocation, key string, id string) {
+
+	delete(revs[key], id)
+
+	if len(revs[key]) == 0 {
+		delete(revs, key)
+	}
+}
+
+// covers returns true if one of the given revocations by token or
+// family ID is in effect for the given token. Such revocation only
+// covers the tokens from sources living in its namespace or one of
+// its children, so a tenant cannot revoke the tokens of another one.
+func covers(revs map[string]*api.Revocation, idt *token.IdentityToken, now time.Time) bool {
+
+	for _, rev := range revs {
+		if !isExpired(rev, now) && inNamespace(rev, idt) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *Cache) prune(now time.Time) {
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for id, rev := range c.revocations {
+		if isExpired(rev, now) {
+			c.remove(id)
+		}
+	}
+}
+
+func isExpired(rev *api.Revocation, now time.Time) bool {
+	return !rev.Expiration.IsZero() && !now.Before(rev.Expiration)
+}
+
+// matches checks if a revocation by subject or by source applies to
+// the given token. Such revocation only covers the tokens issued before
+// it was created, from sources living in its namespace or one of its children.
+func matches(rev *api.Revocation, idt *token.IdentityToken) bool {
+
+	if idt.IssuedAt != nil && idt.IssuedAt.Time.After(rev.CreateTime) {
+		return false
+	}
+
+	if !inNamespace(rev, idt) {
+		return false
+	}
+
+	if len(rev.Subject) == 0 {
+		return idt.Source.Type == rev.SourceType &&
+			idt.Source.Name == rev.SourceName &&
+			idt.Source.Namespace == rev.SourceNamespace
+	}
+
+	claims := make(map[string]struct{}, len(idt.Identity))
+	for _, c := range idt.Identity {
+		claims[c] = struct{}{}
+	}
+
+	for _, ands := range rev.Subject {
+
+		if len(ands) == 0 {
+			continue
+		}
+
+		matched := true
+		for _, claim := range ands {
+			if _, ok := claims[claim]; !ok {
+				matched = false
+				break
+			}
+		}
+
+		if matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// inNamespace returns true if the source of the given token lives in the
+// namespace of the given revocation or one of its children. Tokens from
+// sources without a namespace are considered to come from the root one.
+func inNamespace(rev *api.Revocation, idt *token.IdentityToken) bool {
+
+	sourceNamespace := idt.Source.Namespace
+	if sourceNamespace == "" {
+		sourceNamespace = "/"
+	}
+
+	return sourceNamespace == rev.Namespace || elemental.IsNamespaceChildrenOfNamespace(sourceNamespace, rev.Namespace)
+}
+
+// decodeMessageData converts the generic data of a notification
+// message into the given destination.
+func decodeMessageData(data any, dest any) error {
+
+	raw, err := elemental.Encode(elemental.EncodingTypeMSGPACK, data)
+	if err != nil {
+		return err
+	}
+
+	return elemental.Decode(elemental.EncodingTypeMSGPACK, raw, dest)
+}
@@ -0,0 +1,309 @@
+package revocation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.aporeto.io/a3s/pkgs/api"
+	"go.aporeto.io/a3s/pkgs/notification"
+	"go.aporeto.io/a3s/pkgs/token"
+	"go.aporeto.io/bahamut"
+	"go.aporeto.io/elemental"
+	"go.aporeto.io/manipulate"
+	"go.aporeto.io/manipulate/maniptest"
+)
+
+func makeIdentityToken(id string, iat time.Time, source token.Source, claims ...string) *token.IdentityToken {
+	return &token.IdentityToken{
+		Identity: claims,
+		Source:   source,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:       id,
+			IssuedAt: jwt.NewNumericDate(iat),
+		},
+	}
+}
+
+func TestIsRevoked(t *testing.T) {
+
+	Convey("Given I have a cache", t, func() {
+
+		now := time.Now()
+		c := NewCache(nil)
+
+		source := token.Source{Type: "mtls", Namespace: "/a/b", Name: "src"}
+
+		Convey("When I revoke a token by ID", func() {
+
+			c.Set(&api.Revocation{
+				ID:         "1",
+				Namespace:  "/",
+				TokenID:    "jti",
+				CreateTime: now,
+				Expiration: now.Add(time.Hour),
+			})
+
+			So(c.IsRevoked(makeIdentityToken("jti", now.Add(-time.Hour), source)), ShouldBeTrue)
+			So(c.IsRevoked(makeIdentityToken("other", now.Add(-time.Hour), source)), ShouldBeFalse)
+
+			Convey("Then deleting the revocation should work", func() {
+				c.Delete("1")
+				So(c.IsRevoked(makeIdentityToken("jti", now.Add(-time.Hour), source)), ShouldBeFalse)
+			})
+		})
+
+		Convey("When I revoke a token by ID from another namespace", func() {
+
+			c.Set(&api.Revocation{
+				ID:         "1",
+				Namespace:  "/b",
+				TokenID:    "jti",
+				CreateTime: now,
+				Expiration: now.Add(time.Hour),
+			})
+
+			So(c.IsRevoked(makeIdentityToken("jti", now.Add(-time.Hour), source)), ShouldBeFalse)
+
+			Convey("Then it should not hide a revocation from the namespace of the source", func() {
+
+				c.Set(&api.Revocation{
+					ID:         "2",
+					Namespace:  "/a",
+					TokenID:    "jti",
+					CreateTime: now,
+					Expiration: now.Add(time.Hour),
+				})
+
+				So(c.IsRevoked(makeIdentityToken("jti", now.Add(-time.Hour), source)), ShouldBeTrue)
+				So(len(c.tokenIDs["jti"]), ShouldEqual, 2)
+
+				c.Delete("1")
+				So(c.IsRevoked(makeIdentityToken("jti", now.Add(-time.Hour), source)), ShouldBeTrue)
+
+				c.Delete("2")
+				So(c.IsRevoked(makeIdentityToken("jti", now.Add(-time.Hour), source)), ShouldBeFalse)
+				So(len(c.tokenIDs), ShouldEqual, 0)
+			})
+		})
+
+		Convey("When I revoke a family of refresh tokens from another namespace", func() {
+
+			c.Set(&api.Revocation{
+				ID:          "1",
+				Namespace:   "/b",
+				TokenFamily: "family",
+				CreateTime:  now,
+				Expiration:  now.Add(time.Hour),
+			})
+
+			next := makeIdentityToken("next", now.Add(time.Minute), source)
+			next.RefreshFamily = "family"
+			So(c.IsRevoked(next), ShouldBeFalse)
+		})
+
+		Convey("When I revoke a token by ID that is expired", func() {
+
+			c.Set(&api.Revocation{
+				ID:         "1",
+				TokenID:    "jti",
+				CreateTime: now.Add(-2 * time.Hour),
+				Expiration: now.Add(-time.Hour),
+			})
+
+			So(c.IsRevoked(makeIdentityToken("jti", now.Add(-3*time.Hour), source)), ShouldBeFalse)
+
+			Convey("Then pruning should remove it", func() {
+				c.prune(now)
+				So(len(c.revocations), ShouldEqual, 0)
+				So(len(c.tokenIDs), ShouldEqual, 0)
+			})
+		})
+
+		Convey("When I revoke a family of refresh tokens", func() {
+
+			c.Set(&api.Revocation{
+				ID:          "1",
+				Namespace:   "/",
+				TokenFamily: "family",
+				CreateTime:  now,
+				Expiration:  now.Add(time.Hour),
+			})
+
+			first := makeIdentityToken("family", now.Add(-time.Hour), source)
+			So(c.IsRevoked(first), ShouldBeTrue)
+
+			next := makeIdentityToken("next", now.Add(time.Minute), source)
+			next.RefreshFamily = "family"
+			So(c.IsRevoked(next), ShouldBeTrue)
+
+			other := makeIdentityToken("other", now.Add(-time.Minute), source)
+			other.RefreshFamily = "other"
+			So(c.IsRevoked(other), ShouldBeFalse)
+
+			Convey("Then deleting the revocation should work", func() {
+				c.Delete("1")
+				So(c.IsRevoked(next), ShouldBeFalse)
+				So(len(c.families), ShouldEqual, 0)
+			})
+		})
+
+		Convey("When I revoke tokens by subject", func() {
+
+			c.Set(&api.Revocation{
+				ID:         "1",
+				Namespace:  "/a",
+				Subject:    [][]string{{"color=blue", "size=big"}, {"color=red"}},
+				CreateTime: now,
+				Expiration: now.Add(time.Hour),
+			})
+
+			So(c.IsRevoked(makeIdentityToken("1", now.Add(-time.Minute), source, "color=blue", "size=big")), ShouldBeTrue)
+			So(c.IsRevoked(makeIdentityToken("2", now.Add(-time.Minute), source, "color=red")), ShouldBeTrue)
+			So(c.IsRevoked(makeIdentityToken("3", now.Add(-time.Minute), source, "color=blue")), ShouldBeFalse)
+
+			Convey("Then tokens issued after the revocation should not be revoked", func() {
+				So(c.IsRevoked(makeIdentityToken("4", now.Add(time.Minute), source, "color=red")), ShouldBeFalse)
+			})
+
+			Convey("Then tokens from sources outside of the namespace should not be revoked", func() {
+				outside := token.Source{Type: "mtls", Namespace: "/b", Name: "src"}
+				So(c.IsRevoked(makeIdentityToken("5", now.Add(-time.Minute), outside, "color=red")), ShouldBeFalse)
+				noNamespace := token.Source{Type: "aws"}
+				So(c.IsRevoked(makeIdentityToken("6", now.Add(-time.Minute), noNamespace, "color=red")), ShouldBeFalse)
+			})
+		})
+
+		Convey("When I revoke tokens by subject from the root namespace", func() {
+
+			c.Set(&api.Revocation{
+				ID:         "1",
+				Namespace:  "/",
+				Subject:    [][]string{{"color=red"}},
+				CreateTime: now,
+				Expiration: now.Add(time.Hour),
+			})
+
+			noNamespace := token.Source{Type: "aws"}
+			So(c.IsRevoked(makeIdentityToken("1", now.Add(-time.Minute), noNamespace, "color=red")), ShouldBeTrue)
+			So(c.IsRevoked(makeIdentityToken("2", now.Add(-time.Minute), source, "color=red")), ShouldBeTrue)
+		})
+
+		Convey("When I revoke tokens by source", func() {
+
+			c.Set(&api.Revocation{
+				ID:              "1",
+				Namespace:       "/a",
+				SourceType:      "mtls",
+				SourceNamespace: "/a/b",
+				SourceName:      "src",
+				CreateTime:      now,
+				Expiration:      now.Add(time.Hour),
+			})
+
+			So(c.IsRevoked(makeIdentityToken("1", now.Add(-time.Minute), source)), ShouldBeTrue)
+			So(c.IsRevoked(makeIdentityToken("2", now.Add(time.Minute), source)), ShouldBeFalse)
+
+			other := token.Source{Type: "mtls", Namespace: "/a/b", Name: "other"}
+			So(c.IsRevoked(makeIdentityToken("3", now.Add(-time.Minute), other)), ShouldBeFalse)
+		})
+	})
+}
+
+func TestLoad(t *testing.T) {
+
+	Convey("Given I have a cache and a manipulator", t, func() {
+
+		c := NewCache(nil)
+		m := maniptest.NewTestManipulator()
+
+		Convey("When the manipulator works", func() {
+
+			m.MockRetrieveMany(t, func(mctx manipulate.Context, dest elemental.Identifiables) error {
+				*dest.(*api.RevocationsList) = api.RevocationsList{
+					{ID: "1", TokenID: "jti"},
+				}
+				return nil
+			})
+
+			So(c.Load(context.Background(), m), ShouldBeNil)
+			So(c.IsRevoked(makeIdentityToken("jti", time.Now(), token.Source{})), ShouldBeTrue)
+		})
+
+		Convey("When the manipulator fails", func() {
+
+			m.MockRetrieveMany(t, func(mctx manipulate.Context, dest elemental.Identifiables) error {
+				return fmt.Errorf("boom")
+			})
+
+			So(c.Load(context.Background(), m).Error(), ShouldEqual, "unable to retrieve revocations: boom")
+		})
+	})
+}
+
+func TestStart(t *testing.T) {
+
+	Convey("Given I have a started cache", t, func() {
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		pubsub := bahamut.NewLocalPubSubClient()
+		_ = pubsub.Connect(ctx)
+
+		c := NewCache(pubsub)
+		c.Start(ctx)
+
+		idt := makeIdentityToken("jti", time.Now(), token.Source{})
+
+		Convey("When I publish a revocation", func() {
+
+			_ = notification.Publish(pubsub, NotificationRevocationChanges, &notification.Message{
+				Type: MessageTypeCreate,
+				Data: &api.Revocation{ID: "1", TokenID: "jti", Expiration: time.Now().Add(time.Hour)},
+			})
+
+			So(func() bool {
+				for i := 0; i < 100; i++ {
+					if c.IsRevoked(idt) {
+						return true
+					}
+					time.Sleep(10 * time.Millisecond)
+				}
+				return false
+			}(), ShouldBeTrue)
+
+			Convey("Then publishing its deletion should work", func() {
+
+				_ = notification.Publish(pubsub, NotificationRevocationChanges, &notification.Message{
+					Type: MessageTypeDelete,
+					Data: &api.Revocation{ID: "1", TokenID: "jti"},
+				})
+
+				So(func() bool {
+					for i := 0; i < 100; i++ {
+						if !c.IsRevoked(idt) {
+							return true
+						}
+						time.Sleep(10 * time.Millisecond)
+					}
+					return false
+				}(), ShouldBeTrue)
+			})
+		})
+
+		Convey("When I publish an unrelated message", func() {
+
+			_ = notification.Publish(pubsub, NotificationRevocationChanges, &notification.Message{
+				Type: "something",
+				Data: "/a",
+			})
+
+			time.Sleep(100 * time.Millisecond)
+			So(len(c.revocations), ShouldEqual, 0)
+		})
+	})
+}
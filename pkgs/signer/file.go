@@ -0,0 +1,38 @@
+package signer
+
+import (
+	"crypto"
+	"crypto/x509"
+	"fmt"
+
+	"go.aporeto.io/tg/tglib"
+)
+
+type fileProvider struct {
+	cert   *x509.Certificate
+	signer crypto.Signer
+}
+
+// NewFileProvider returns a Provider using the certificate and the
+// private key stored in the given PEM files. The key is decrypted
+// using the given password if it is encrypted.
+func NewFileProvider(certPath string, keyPath string, keyPass string) (Provider, error) {
+
+	cert, key, err := tglib.ReadCertificatePEM(certPath, keyPath, keyPass)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read certificate: %w", err)
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type: %T", key)
+	}
+
+	return &fileProvider{
+		cert:   cert,
+		signer: signer,
+	}, nil
+}
+
+func (p *fileProvider) Certificate() *x509.Certificate { return p.cert }
+func (p *fileProvider) Signer() crypto.Signer          { return p.signer }
@@ -0,0 +1,16 @@
+package signer
+
+import (
+	"crypto"
+	"crypto/x509"
+)
+
+// A Provider provides a signing key.
+type Provider interface {
+
+	// Certificate returns the certificate of the key.
+	Certificate() *x509.Certificate
+
+	// Signer returns the crypto.Signer to use to sign with the key.
+	Signer() crypto.Signer
+}
@@ -0,0 +1,182 @@
+package signer
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func writeCert(dir string, useRSA bool) (string, string) {
+
+	var signer crypto.Signer
+	var keyBlock *pem.Block
+
+	if useRSA {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			panic(err)
+		}
+		signer = key
+		keyBlock = &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}
+	} else {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			panic(err)
+		}
+		der, err := x509.MarshalECPrivateKey(key)
+		if err != nil {
+			panic(err)
+		}
+		signer = key
+		keyBlock = &pem.Block{Type: "EC PRIVATE KEY", Bytes: der}
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "signer"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, signer.Public(), signer)
+	if err != nil {
+		panic(err)
+	}
+
+	certPath := filepath.Join(dir, "cert.pem")
+	keyPath := filepath.Join(dir, "key.pem")
+
+	if err := os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600); err != nil {
+		panic(err)
+	}
+	if err := os.WriteFile(keyPath, pem.EncodeToMemory(keyBlock), 0600); err != nil {
+		panic(err)
+	}
+
+	return certPath, keyPath
+}
+
+func TestNewFileProvider(t *testing.T) {
+
+	Convey("Given I have a certificate and a key on disk", t, func() {
+
+		certPath, keyPath := writeCert(t.TempDir(), false)
+
+		Convey("Calling NewFileProvider should work", func() {
+
+			p, err := NewFileProvider(certPath, keyPath, "")
+
+			So(err, ShouldBeNil)
+			So(p.Certificate().Subject.CommonName, ShouldEqual, "signer")
+			So(p.Signer().Public(), ShouldResemble, p.Certificate().PublicKey)
+		})
+
+		Convey("Calling NewFileProvider on missing files should fail", func() {
+
+			p, err := NewFileProvider(certPath+".nope", keyPath, "")
+
+			So(err, ShouldNotBeNil)
+			So(p, ShouldBeNil)
+		})
+	})
+}
+
+func TestNewSocketProvider(t *testing.T) {
+
+	for _, tc := range []struct {
+		name   string
+		useRSA bool
+		pss    bool
+	}{
+		{"an ECDSA key", false, false},
+		{"a RSA key", true, false},
+		{"a RSA key with PSS", true, true},
+	} {
+
+		Convey("Given I have a signing daemon holding "+tc.name, t, func() {
+
+			dir := t.TempDir()
+			certPath, keyPath := writeCert(dir, tc.useRSA)
+
+			local, err := NewFileProvider(certPath, keyPath, "")
+			So(err, ShouldBeNil)
+
+			socketPath := filepath.Join(dir, "signer.sock")
+			l, err := net.Listen("unix", socketPath)
+			So(err, ShouldBeNil)
+
+			server := &http.Server{Handler: NewSocketHandler(map[string]Provider{"jwt": local})}
+			go server.Serve(l) // nolint
+
+			defer server.Close() // nolint
+
+			Convey("Calling NewSocketProvider should work", func() {
+
+				p, err := NewSocketProvider(context.Background(), socketPath, "jwt")
+
+				So(err, ShouldBeNil)
+				So(p.Certificate().Equal(local.Certificate()), ShouldBeTrue)
+				So(p.Signer().Public(), ShouldResemble, local.Certificate().PublicKey)
+
+				Convey("Then signing a digest should produce a valid signature", func() {
+
+					digest := sha256.Sum256([]byte("hello"))
+
+					var opts crypto.SignerOpts = crypto.SHA256
+					if tc.pss {
+						opts = &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash, Hash: crypto.SHA256}
+					}
+
+					sig, err := p.Signer().Sign(rand.Reader, digest[:], opts)
+					So(err, ShouldBeNil)
+
+					switch public := p.Certificate().PublicKey.(type) {
+					case *ecdsa.PublicKey:
+						So(ecdsa.VerifyASN1(public, digest[:], sig), ShouldBeTrue)
+					case *rsa.PublicKey:
+						if tc.pss {
+							So(rsa.VerifyPSS(public, crypto.SHA256, digest[:], sig, opts.(*rsa.PSSOptions)), ShouldBeNil)
+						} else {
+							So(rsa.VerifyPKCS1v15(public, crypto.SHA256, digest[:], sig), ShouldBeNil)
+						}
+					default:
+						t.Fatalf("unexpected key type %T", public)
+					}
+				})
+			})
+
+			Convey("Calling NewSocketProvider with an unknown key should fail", func() {
+
+				p, err := NewSocketProvider(context.Background(), socketPath, "nope")
+
+				So(p, ShouldBeNil)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "unable to retrieve certificate from signing daemon: 404 Not Found")
+			})
+		})
+	}
+
+	Convey("Calling NewSocketProvider with no daemon should fail", t, func() {
+
+		p, err := NewSocketProvider(context.Background(), filepath.Join(t.TempDir(), "nope.sock"), "jwt")
+
+		So(p, ShouldBeNil)
+		So(err, ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,232 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// The hashes the signing daemon can be asked to sign with.
+var hashes = map[string]crypto.Hash{
+	crypto.SHA256.String(): crypto.SHA256,
+	crypto.SHA384.String(): crypto.SHA384,
+	crypto.SHA512.String(): crypto.SHA512,
+}
+
+// A signRequest is sent to the signing daemon to sign a digest.
+// If Hash is empty, the digest is the message itself, as expected
+// by Ed25519 keys.
+type signRequest struct {
+	Digest     []byte `json:"digest"`
+	Hash       string `json:"hash,omitempty"`
+	PSS        bool   `json:"pss,omitempty"`
+	SaltLength int    `json:"saltLength,omitempty"`
+}
+
+// A signResponse is returned by the signing daemon.
+type signResponse struct {
+	Signature []byte `json:"signature,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+type socketProvider struct {
+	cert   *x509.Certificate
+	signer *socketSigner
+}
+
+// NewSocketProvider returns a Provider using the key with the given name
+// held by an external signing daemon listening on the given unix socket.
+// The private key never leaves the daemon: the provider only retrieves the
+// certificate of the key, and sends the digests to sign.
+//
+// The daemon must serve HTTP on the socket, and handle:
+//
+//   - GET /keys/{name}/certificate: returns the PEM encoded certificate.
+//   - POST /keys/{name}/sign: signs the digest of the JSON request
+//     {"digest", "hash", "pss", "saltLength"} and returns {"signature"}.
+//
+// NewSocketHandler implements this protocol and can be used as a stand-in.
+func NewSocketProvider(ctx context.Context, socketPath string, name string) (Provider, error) {
+
+	client := &http.Client{
+		Timeout: 10 * time.Second,
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _ string, _ string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, "unix", socketPath)
+			},
+		},
+	}
+
+	baseURL := "http://signer/keys/" + url.PathEscape(name)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+"/certificate", nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build certificate request: %w", err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve certificate from signing daemon: %w", err)
+	}
+	defer resp.Body.Close() // nolint
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read certificate from signing daemon: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to retrieve certificate from signing daemon: %s", resp.Status)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("unable to decode certificate from signing daemon: no pem block")
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse certificate from signing daemon: %w", err)
+	}
+
+	return &socketProvider{
+		cert: cert,
+		signer: &socketSigner{
+			client:  client,
+			signURL: baseURL + "/sign",
+			public:  cert.PublicKey,
+		},
+	}, nil
+}
+
+func (p *socketProvider) Certificate() *x509.Certificate { return p.cert }
+func (p *socketProvider) Signer() crypto.Signer          { return p.signer }
+
+// socketSigner is a crypto.Signer sending the
+// digests to sign to the signing daemon.
+type socketSigner struct {
+	client  *http.Client
+	signURL string
+	public  crypto.PublicKey
+}
+
+func (s *socketSigner) Public() crypto.PublicKey {
+	return s.public
+}
+
+func (s *socketSigner) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+
+	sreq := signRequest{
+		Digest: digest,
+	}
+
+	if h := opts.HashFunc(); h != 0 {
+		sreq.Hash = h.String()
+	}
+
+	if pss, ok := opts.(*rsa.PSSOptions); ok {
+		sreq.PSS = true
+		sreq.SaltLength = pss.SaltLength
+	}
+
+	body, err := json.Marshal(sreq)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode sign request: %w", err)
+	}
+
+	resp, err := s.client.Post(s.signURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("unable to send sign request to signing daemon: %w", err)
+	}
+	defer resp.Body.Close() // nolint
+
+	sresp := signResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(&sresp); err != nil {
+		return nil, fmt.Errorf("unable to decode sign response from signing daemon: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("signing daemon returned %s: %s", resp.Status, sresp.Error)
+	}
+
+	return sresp.Signature, nil
+}
+
+// NewSocketHandler returns an http.Handler implementing the protocol of the
+// signing daemon described in NewSocketProvider, signing with the given
+// providers, keyed by name. It can be used as a local stand-in for an HSM
+// or KMS backed daemon, and serves as a reference implementation.
+func NewSocketHandler(providers map[string]Provider) http.Handler {
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /keys/{name}/certificate", func(w http.ResponseWriter, req *http.Request) {
+
+		p, ok := providers[req.PathValue("name")]
+		if !ok {
+			http.Error(w, "key not found", http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/x-pem-file")
+		_ = pem.Encode(w, &pem.Block{Type: "CERTIFICATE", Bytes: p.Certificate().Raw})
+	})
+
+	mux.HandleFunc("POST /keys/{name}/sign", func(w http.ResponseWriter, req *http.Request) {
+
+		p, ok := providers[req.PathValue("name")]
+		if !ok {
+			writeSignResponse(w, http.StatusNotFound, &signResponse{Error: "key not found"})
+			return
+		}
+
+		sreq := signRequest{}
+		if err := json.NewDecoder(req.Body).Decode(&sreq); err != nil {
+			writeSignResponse(w, http.StatusBadRequest, &signResponse{Error: fmt.Sprintf("invalid request: %s", err)})
+			return
+		}
+
+		var h crypto.Hash
+		if sreq.Hash != "" {
+			if h, ok = hashes[sreq.Hash]; !ok {
+				writeSignResponse(w, http.StatusBadRequest, &signResponse{Error: fmt.Sprintf("unsupported hash: %s", sreq.Hash)})
+				return
+			}
+		}
+
+		var opts crypto.SignerOpts = h
+		if sreq.PSS {
+			opts = &rsa.PSSOptions{SaltLength: sreq.SaltLength, Hash: h}
+		}
+
+		sig, err := p.Signer().Sign(rand.Reader, sreq.Digest, opts)
+		if err != nil {
+			writeSignResponse(w, http.StatusInternalServerError, &signResponse{Error: err.Error()})
+			return
+		}
+
+		writeSignResponse(w, http.StatusOK, &signResponse{Signature: sig})
+	})
+
+	return mux
+}
+
+func writeSignResponse(w http.ResponseWriter, status int, resp *signResponse) {
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(resp)
+}
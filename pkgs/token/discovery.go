@@ -0,0 +1,149 @@
+package token
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// Well known paths served by an issuer.
+const (
+	WellKnownJWKSPath      = ".well-known/jwks.json"
+	WellKnownDiscoveryPath = ".well-known/openid-configuration"
+)
+
+// A ErrDiscoveryRemote represents an error while
+// interacting with a remote discovery document.
+// StatusCode is set if the remote server returned an
+// unexpected status code.
+type ErrDiscoveryRemote struct {
+	Err        error
+	StatusCode int
+}
+
+func (e ErrDiscoveryRemote) Error() string {
+	return fmt.Sprintf("remote discovery error: %s", e.Err)
+}
+
+// Unwrap returns the warped error.
+func (e ErrDiscoveryRemote) Unwrap() error {
+	return e.Err
+}
+
+// A Discovery represents the OpenID Connect discovery
+// document of an issuer.
+type Discovery struct {
+	Issuer                           string   `json:"issuer"`
+	JWKSURI                          string   `json:"jwks_uri"`
+	IntrospectionEndpoint            string   `json:"introspection_endpoint,omitempty"`
+	ResponseTypesSupported           []string `json:"response_types_supported,omitempty"`
+	SubjectTypesSupported            []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported []string `json:"id_token_signing_alg_values_supported"`
+}
+
+// NewDiscovery returns the Discovery document of the given issuer,
+// whose endpoints are served from the given apiURL. The supported signing
+// algorithms are the ones of the keys currently published in the given JWKS.
+// As a3s does not serve an authorization endpoint, the document
+// does not advertise any supported response type.
+func NewDiscovery(issuer string, apiURL string, jwks *JWKS) *Discovery {
+
+	apiURL = strings.TrimRight(apiURL, "/")
+
+	algs := map[string]struct{}{}
+	for _, k := range jwks.Published().Keys {
+		if m := k.SigningMethod(); m != nil {
+			algs[m.Alg()] = struct{}{}
+		}
+	}
+
+	supported := make([]string, 0, len(algs))
+	for alg := range algs {
+		supported = append(supported, alg)
+	}
+	sort.Strings(supported)
+
+	return &Discovery{
+		Issuer:                           issuer,
+		JWKSURI:                          fmt.Sprintf("%s/%s", apiURL, WellKnownJWKSPath),
+		IntrospectionEndpoint:            fmt.Sprintf("%s/oauth2/introspect", apiURL),
+		SubjectTypesSupported:            []string{"public"},
+		IDTokenSigningAlgValuesSupported: supported,
+	}
+}
+
+// NewRemoteDiscovery retrieves the Discovery document
+// found at the given URL using the provided http.Client.
+func NewRemoteDiscovery(ctx context.Context, client *http.Client, url string) (*Discovery, error) {
+
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, ErrDiscoveryRemote{Err: fmt.Errorf("unable to build request: %w", err)}
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, ErrDiscoveryRemote{Err: fmt.Errorf("unable to send request: %w", err)}
+	}
+
+	defer resp.Body.Close() // nolint
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, ErrDiscoveryRemote{Err: fmt.Errorf("unexpected status code: %d", resp.StatusCode), StatusCode: resp.StatusCode}
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, ErrDiscoveryRemote{Err: fmt.Errorf("unable to read response body: %w", err)}
+	}
+
+	d := &Discovery{}
+	if err := json.Unmarshal(data, d); err != nil {
+		return nil, ErrDiscoveryRemote{Err: fmt.Errorf("unable to parse response body: %w", err)}
+	}
+
+	return d, nil
+}
+
+// DiscoverJWKSURL returns the URL of the JWKS of the given issuer, served
+// from the given endpoint. If the endpoint serves a discovery document, its
+// jwks_uri is used, as long as the document has been issued for the given issuer.
+// If the endpoint does not serve any discovery document (404), the well known JWKS
+// path of the endpoint is returned. Any other error is returned. If the endpoint
+// already points to a well known JWKS path, it is returned as is.
+func DiscoverJWKSURL(ctx context.Context, client *http.Client, endpoint string, issuer string) (string, error) {
+
+	if strings.HasSuffix(endpoint, WellKnownJWKSPath) {
+		return endpoint, nil
+	}
+
+	endpoint = strings.TrimRight(endpoint, "/")
+
+	d, err := NewRemoteDiscovery(ctx, client, fmt.Sprintf("%s/%s", endpoint, WellKnownDiscoveryPath))
+	if err != nil {
+		var rerr ErrDiscoveryRemote
+		if errors.As(err, &rerr) && rerr.StatusCode == http.StatusNotFound {
+			return fmt.Sprintf("%s/%s", endpoint, WellKnownJWKSPath), nil
+		}
+		return "", err
+	}
+
+	if d.Issuer != issuer {
+		return "", ErrDiscoveryRemote{Err: fmt.Errorf("issuer '%s' of the discovery document does not match '%s'", d.Issuer, issuer)}
+	}
+
+	if d.JWKSURI == "" {
+		return "", ErrDiscoveryRemote{Err: fmt.Errorf("discovery document does not contain any jwks_uri")}
+	}
+
+	return d.JWKSURI, nil
+}
@@ -0,0 +1,153 @@
+package token
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestNewDiscovery(t *testing.T) {
+
+	Convey("Given I have a JWKS with keys", t, func() {
+
+		jwks := NewJWKS()
+
+		cert1, key1 := getECCert()
+		_ = jwks.AppendWithPrivate(cert1, key1)
+		cert2, key2 := getRSACert()
+		_ = jwks.AppendWithPrivate(cert2, key2)
+		cert3, key3 := getECCert()
+		_ = jwks.AppendWithPrivate(cert3, key3)
+
+		Convey("When I call NewDiscovery", func() {
+
+			d := NewDiscovery("https://iss", "https://api/", jwks)
+
+			So(d.Issuer, ShouldEqual, "https://iss")
+			So(d.JWKSURI, ShouldEqual, "https://api/.well-known/jwks.json")
+			So(d.IntrospectionEndpoint, ShouldEqual, "https://api/oauth2/introspect")
+			So(d.ResponseTypesSupported, ShouldBeNil)
+			So(d.SubjectTypesSupported, ShouldResemble, []string{"public"})
+			So(d.IDTokenSigningAlgValuesSupported, ShouldResemble, []string{"ES256", "RS256"})
+		})
+	})
+}
+
+func TestDiscoverJWKSURL(t *testing.T) {
+
+	Convey("Given I have a server serving a discovery document", t, func() {
+
+		var issuer string
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if req.URL.Path != "/"+WellKnownDiscoveryPath {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			d, _ := json.Marshal(&Discovery{Issuer: issuer, JWKSURI: "https://somewhere/keys"})
+			w.Write(d) // nolint
+		}))
+		defer ts.Close()
+
+		issuer = ts.URL
+
+		Convey("Calling DiscoverJWKSURL should return the jwks_uri", func() {
+
+			u, err := DiscoverJWKSURL(context.Background(), nil, ts.URL+"/", ts.URL)
+
+			So(err, ShouldBeNil)
+			So(u, ShouldEqual, "https://somewhere/keys")
+		})
+
+		Convey("Calling DiscoverJWKSURL with another issuer should fail", func() {
+
+			u, err := DiscoverJWKSURL(context.Background(), nil, ts.URL, "https://other")
+
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "remote discovery error: issuer '"+ts.URL+"' of the discovery document does not match 'https://other'")
+			So(u, ShouldBeEmpty)
+		})
+
+		Convey("Calling DiscoverJWKSURL on a well known jwks path should return it", func() {
+
+			u, err := DiscoverJWKSURL(context.Background(), nil, ts.URL+"/.well-known/jwks.json", ts.URL)
+
+			So(err, ShouldBeNil)
+			So(u, ShouldEqual, ts.URL+"/.well-known/jwks.json")
+		})
+	})
+
+	Convey("Given I have a server not serving a discovery document", t, func() {
+
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer ts.Close()
+
+		Convey("Calling DiscoverJWKSURL should return the well known jwks path", func() {
+
+			u, err := DiscoverJWKSURL(context.Background(), nil, ts.URL, ts.URL)
+
+			So(err, ShouldBeNil)
+			So(u, ShouldEqual, ts.URL+"/.well-known/jwks.json")
+		})
+	})
+
+	Convey("Given I have a server failing to serve a discovery document", t, func() {
+
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer ts.Close()
+
+		Convey("Calling DiscoverJWKSURL should fail", func() {
+
+			u, err := DiscoverJWKSURL(context.Background(), nil, ts.URL, ts.URL)
+
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "remote discovery error: unexpected status code: 500")
+			So(u, ShouldBeEmpty)
+		})
+	})
+
+	Convey("Given I have a server serving an invalid discovery document", t, func() {
+
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.Write([]byte("not json")) // nolint
+		}))
+		defer ts.Close()
+
+		Convey("Calling DiscoverJWKSURL should fail", func() {
+
+			u, err := DiscoverJWKSURL(context.Background(), nil, ts.URL, ts.URL)
+
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldStartWith, "remote discovery error: unable to parse response body:")
+			So(u, ShouldBeEmpty)
+		})
+	})
+
+	Convey("Given I have a server serving a discovery document without jwks_uri", t, func() {
+
+		var issuer string
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			d, _ := json.Marshal(&Discovery{Issuer: issuer})
+			w.Write(d) // nolint
+		}))
+		defer ts.Close()
+
+		issuer = ts.URL
+
+		Convey("Calling DiscoverJWKSURL should fail", func() {
+
+			u, err := DiscoverJWKSURL(context.Background(), nil, ts.URL, ts.URL)
+
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "remote discovery error: discovery document does not contain any jwks_uri")
+			So(u, ShouldBeEmpty)
+		})
+	})
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
+	"crypto/rsa"
 	"crypto/x509"
 	"encoding/base64"
 	"errors"
@@ -13,17 +15,28 @@ import (
 	"math/big"
 	"net/http"
 	"sync"
+	"time"
 
+	"github.com/golang-jwt/jwt/v4"
 	"go.aporeto.io/elemental"
 )
 
 // Various errors returned by a JWKS.
 var (
-	ErrJWKSNotFound    = errors.New("kid not found in JWKS")
-	ErrJWKSInvalidType = errors.New("certificate must be ecdsa")
-	ErrJWKSKeyExists   = errors.New("key with the same kid already exists")
+	ErrJWKSNotFound         = errors.New("kid not found in JWKS")
+	ErrJWKSInvalidType      = errors.New("certificate must be ecdsa, rsa or ed25519")
+	ErrJWKSInvalidAlgorithm = errors.New("signing algorithm is not compatible with the key")
+	ErrJWKSKeyExists        = errors.New("key with the same kid already exists")
+	ErrJWKSKeyMismatch      = errors.New("private key does not match the certificate")
+	ErrJWKSKeyTooSmall      = errors.New("rsa key must be at least 2048 bits")
+
+	ErrJWKSInvalidSchedule = errors.New("key schedule boundaries must be in order publish, activate, retire, expire")
 )
 
+// MinRSAKeySize is the minimum size, in bits,
+// of the RSA keys accepted in a JWKS.
+const MinRSAKeySize = 2048
+
 // A ErrJWKSRemote represents an error while
 // interacting with a remote JWKS.
 type ErrJWKSRemote struct {
@@ -86,86 +99,252 @@ func NewRemoteJWKS(ctx context.Context, client *http.Client, url string) (*JWKS,
 		return nil, ErrJWKSRemote{Err: fmt.Errorf("unable to parse response body: %w", err)}
 	}
 
-	for _, k := range jwks.Keys {
+	if err := jwks.prepare(); err != nil {
+		return nil, ErrJWKSRemote{Err: err}
+	}
+
+	return jwks, nil
+}
 
-		jwks.keyMap[k.KID] = k
+// ParseJWKS returns a JWKS populated with the
+// keys found in the given JSON encoded data.
+func ParseJWKS(data []byte) (*JWKS, error) {
 
-		if k.X != "" && k.Y != "" {
+	jwks := NewJWKS()
 
-			x, err := base64.RawURLEncoding.DecodeString(k.X)
+	if err := elemental.Decode(elemental.EncodingTypeJSON, data, jwks); err != nil {
+		return nil, fmt.Errorf("unable to parse jwks: %w", err)
+	}
+
+	if err := jwks.prepare(); err != nil {
+		return nil, err
+	}
+
+	return jwks, nil
+}
+
+// prepare indexes the keys and decodes
+// their public components.
+func (j *JWKS) prepare() error {
+
+	for _, k := range j.Keys {
+
+		j.keyMap[k.KID] = k
+
+		switch k.KTY {
+
+		case "RSA":
+
+			n, err := base64.RawURLEncoding.DecodeString(k.N)
 			if err != nil {
-				return nil, ErrJWKSRemote{Err: fmt.Errorf("unable to decode X: %w", err)}
+				return fmt.Errorf("unable to decode N: %w", err)
+			}
+			k.n = &big.Int{}
+			k.n.SetBytes(n)
+			if k.n.BitLen() < MinRSAKeySize {
+				return fmt.Errorf("invalid rsa key size %d: %w", k.n.BitLen(), ErrJWKSKeyTooSmall)
 			}
-			k.x = &big.Int{}
-			k.x.SetBytes(x)
 
-			y, err := base64.RawURLEncoding.DecodeString(k.Y)
+			e, err := base64.RawURLEncoding.DecodeString(k.E)
+			if err != nil {
+				return fmt.Errorf("unable to decode E: %w", err)
+			}
+
+			// Like crypto/rsa, only accept odd exponents
+			// greater than 1 that fit in 31 bits.
+			be := new(big.Int).SetBytes(e)
+			if be.Cmp(big.NewInt(1)) <= 0 || be.Bit(0) == 0 || be.BitLen() > 31 {
+				return fmt.Errorf("invalid rsa exponent '%s'", k.E)
+			}
+			k.e = int(be.Int64())
+
+		case "OKP":
+
+			if k.CRV != "Ed25519" {
+				return fmt.Errorf("unsupported okp curve: '%s'", k.CRV)
+			}
+
+			x, err := base64.RawURLEncoding.DecodeString(k.X)
 			if err != nil {
-				return nil, ErrJWKSRemote{Err: fmt.Errorf("unable to decode Y: %w", err)}
+				return fmt.Errorf("unable to decode X: %w", err)
+			}
+			if len(x) != ed25519.PublicKeySize {
+				return fmt.Errorf("invalid ed25519 key size: %d", len(x))
+			}
+			k.okp = ed25519.PublicKey(x)
+
+		default:
+
+			if k.X != "" && k.Y != "" {
+
+				x, err := base64.RawURLEncoding.DecodeString(k.X)
+				if err != nil {
+					return fmt.Errorf("unable to decode X: %w", err)
+				}
+				k.x = &big.Int{}
+				k.x.SetBytes(x)
+
+				y, err := base64.RawURLEncoding.DecodeString(k.Y)
+				if err != nil {
+					return fmt.Errorf("unable to decode Y: %w", err)
+				}
+				k.y = &big.Int{}
+				k.y.SetBytes(y)
 			}
-			k.y = &big.Int{}
-			k.y.SetBytes(y)
 		}
 	}
 
-	return jwks, nil
+	return nil
 }
 
 // Append appends a new certificate to the JWKS.
-func (j *JWKS) Append(cert *x509.Certificate) error {
-	return j.AppendWithPrivate(cert, nil)
+func (j *JWKS) Append(cert *x509.Certificate, options ...KeyOption) error {
+	return j.AppendWithPrivate(cert, nil, options...)
 }
 
 // AppendWithPrivate appends a new certificate and its private key to the JWKS.
-func (j *JWKS) AppendWithPrivate(cert *x509.Certificate, private crypto.PrivateKey) error {
+// The certificate public key must be an ECDSA, RSA or Ed25519 key, and RSA keys
+// must be at least MinRSAKeySize bits long. The private key can be any
+// crypto.Signer, like a key held by an HSM.
+func (j *JWKS) AppendWithPrivate(cert *x509.Certificate, private crypto.PrivateKey, options ...KeyOption) error {
+
+	cfg := keyConfig{}
+	for _, o := range options {
+		o(&cfg)
+	}
 
-	j.Lock()
-	defer j.Unlock()
+	if signer, ok := private.(crypto.Signer); ok {
+		if public, ok := signer.Public().(interface{ Equal(crypto.PublicKey) bool }); ok && !public.Equal(cert.PublicKey) {
+			return ErrJWKSKeyMismatch
+		}
+	}
+
+	if err := cfg.schedule.Validate(); err != nil {
+		return err
+	}
 
-	public, ok := cert.PublicKey.(*ecdsa.PublicKey)
-	if !ok {
+	k := &JWKSKey{
+		KID:      Fingerprint(cert),
+		Use:      "sign",
+		private:  private,
+		schedule: cfg.schedule,
+	}
+
+	switch public := cert.PublicKey.(type) {
+
+	case *ecdsa.PublicKey:
+		k.KTY = "EC"
+		k.CRV = public.Curve.Params().Name
+		k.X = base64.RawURLEncoding.EncodeToString(public.X.Bytes())
+		k.x = public.X
+		k.Y = base64.RawURLEncoding.EncodeToString(public.Y.Bytes())
+		k.y = public.Y
+
+	case *rsa.PublicKey:
+		if public.N.BitLen() < MinRSAKeySize {
+			return ErrJWKSKeyTooSmall
+		}
+		k.KTY = "RSA"
+		k.N = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+		k.n = public.N
+		k.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+		k.e = public.E
+
+	case ed25519.PublicKey:
+		k.KTY = "OKP"
+		k.CRV = "Ed25519"
+		k.X = base64.RawURLEncoding.EncodeToString(public)
+		k.okp = public
+
+	default:
 		return ErrJWKSInvalidType
 	}
 
-	kid := Fingerprint(cert)
+	k.Alg = cfg.algorithm
+	if k.Alg == "" {
+		k.Alg = k.defaultAlgorithm()
+	}
 
-	if _, ok := j.keyMap[kid]; ok {
-		return ErrJWKSKeyExists
+	if !k.isCompatible(jwt.GetSigningMethod(k.Alg)) {
+		return ErrJWKSInvalidAlgorithm
 	}
 
-	k := &JWKSKey{
-		KTY:     "EC",
-		KID:     kid,
-		Use:     "sign",
-		CRV:     public.Curve.Params().Name,
-		X:       base64.RawURLEncoding.EncodeToString(public.X.Bytes()),
-		x:       public.X,
-		Y:       base64.RawURLEncoding.EncodeToString(public.Y.Bytes()),
-		y:       public.Y,
-		private: private,
+	j.Lock()
+	defer j.Unlock()
+
+	if _, ok := j.keyMap[k.KID]; ok {
+		return ErrJWKSKeyExists
 	}
 
 	j.Keys = append(j.Keys, k)
-	j.keyMap[kid] = k
+	j.keyMap[k.KID] = k
 
 	return nil
 }
 
 // Get returns the key with the given ID.
-// Returns ErrJWKSNotFound if not found.
+// Returns ErrJWKSNotFound if not found, or if the
+// key is not currently published.
 func (j *JWKS) Get(kid string) (*JWKSKey, error) {
 
 	j.RLock()
 	defer j.RUnlock()
 
 	k, ok := j.keyMap[kid]
-	if !ok {
+	if !ok || !k.IsPublished(time.Now()) {
 		return nil, ErrJWKSNotFound
 	}
 
 	return k, nil
 }
 
+// GetActive returns the key that must be used to sign
+// new tokens. If multiple keys are active, the one
+// that has been activated last wins. It returns nil
+// if there is no active key holding a private key.
+func (j *JWKS) GetActive() *JWKSKey {
+
+	j.RLock()
+	defer j.RUnlock()
+
+	now := time.Now()
+
+	var active *JWKSKey
+	for _, k := range j.Keys {
+
+		if k.private == nil || k.State(now) != KeyStateActive {
+			continue
+		}
+
+		if active == nil || !k.schedule.ActivateTime.Before(active.schedule.ActivateTime) {
+			active = k
+		}
+	}
+
+	return active
+}
+
+// Published returns a new JWKS containing only the
+// keys that are currently published.
+func (j *JWKS) Published() *JWKS {
+
+	j.RLock()
+	defer j.RUnlock()
+
+	now := time.Now()
+
+	out := NewJWKS()
+	out.Keys = []*JWKSKey{}
+	for _, k := range j.Keys {
+		if k.IsPublished(now) {
+			out.Keys = append(out.Keys, k)
+			out.keyMap[k.KID] = k
+		}
+	}
+
+	return out
+}
+
 // GetLast returns the last inserted key.
 func (j *JWKS) GetLast() *JWKSKey {
 
@@ -214,14 +393,42 @@ type JWKSKey struct {
 	Use string `json:"use"`
 	Alg string `json:"alg,omitempty"`
 	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
 	X   string `json:"x,omitempty"`
 	Y   string `json:"y,omitempty"`
 	CRV string `json:"crv,omitempty"`
 
-	x       *big.Int
-	y       *big.Int
-	private crypto.PrivateKey
-	public  crypto.PublicKey
+	x        *big.Int
+	y        *big.Int
+	n        *big.Int
+	e        int
+	okp      ed25519.PublicKey
+	private  crypto.PrivateKey
+	public   crypto.PublicKey
+	schedule KeySchedule
+}
+
+// Schedule returns the KeySchedule of the key.
+func (k *JWKSKey) Schedule() KeySchedule {
+	return k.schedule
+}
+
+// State returns the KeyState of the key at the given time.
+func (k *JWKSKey) State(now time.Time) KeyState {
+	return k.schedule.State(now)
+}
+
+// IsPublished returns true if the key must be exposed
+// in the JWKS and can be used to verify tokens at the
+// given time.
+func (k *JWKSKey) IsPublished(now time.Time) bool {
+
+	switch k.State(now) {
+	case KeyStatePublished, KeyStateActive, KeyStateRetired:
+		return true
+	default:
+		return false
+	}
 }
 
 // Curve returns the curve used by the key.
@@ -256,11 +463,73 @@ func (k *JWKSKey) PublicKey() crypto.PublicKey {
 			Curve: k.Curve(),
 		}
 		return k.public
+	case "RSA":
+		k.public = &rsa.PublicKey{
+			N: k.n,
+			E: k.e,
+		}
+		return k.public
+	case "OKP":
+		k.public = k.okp
+		return k.public
 	default:
 		return nil
 	}
 }
 
+// SigningMethod returns the jwt.SigningMethod to use with the key.
+// If the key does not declare any algorithm, the default one for its
+// type is returned. It returns nil if the algorithm is unknown.
+func (k *JWKSKey) SigningMethod() jwt.SigningMethod {
+
+	if k.Alg != "" {
+		return jwt.GetSigningMethod(k.Alg)
+	}
+
+	return jwt.GetSigningMethod(k.defaultAlgorithm())
+}
+
+func (k *JWKSKey) defaultAlgorithm() string {
+
+	switch k.KTY {
+	case "EC":
+		switch k.CRV {
+		case "P-384":
+			return jwt.SigningMethodES384.Alg()
+		case "P-521":
+			return jwt.SigningMethodES512.Alg()
+		default:
+			return jwt.SigningMethodES256.Alg()
+		}
+	case "RSA":
+		return jwt.SigningMethodRS256.Alg()
+	case "OKP":
+		return jwt.SigningMethodEdDSA.Alg()
+	default:
+		return ""
+	}
+}
+
+// isCompatible returns true if the given jwt.SigningMethod
+// can be used with the key.
+func (k *JWKSKey) isCompatible(method jwt.SigningMethod) bool {
+
+	if method == nil {
+		return false
+	}
+
+	switch m := method.(type) {
+	case *jwt.SigningMethodECDSA:
+		return k.KTY == "EC" && m.Alg() == k.defaultAlgorithm()
+	case *jwt.SigningMethodRSA, *jwt.SigningMethodRSAPSS:
+		return k.KTY == "RSA"
+	case *jwt.SigningMethodEd25519:
+		return k.KTY == "OKP" && k.CRV == "Ed25519"
+	default:
+		return false
+	}
+}
+
 // PrivateKey returns the crypto.PrivateKey associated to
 // the public key, if it was given it was added to the JWKS.
 func (k *JWKSKey) PrivateKey() crypto.PrivateKey {
@@ -216,6 +216,152 @@ func TestNewRemoteJWKS(t *testing.T) {
 		So(jwks.Keys[0].x, ShouldHaveSameTypeAs, big.NewInt(42))
 		So(jwks.Keys[0].y, ShouldHaveSameTypeAs, big.NewInt(42))
 	})
+
+	Convey("Given a http server that returns a valid JWKS with RSA and Ed25519 keys", t, func() {
+
+		rsaCert, _ := getRSACert()
+		edCert, _ := getEd25519Cert()
+
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			j := NewJWKS()
+			_ = j.Append(rsaCert, OptionKeyAlgorithm("PS256"))
+			_ = j.Append(edCert)
+
+			d, _ := json.Marshal(j)
+			w.Write(d) // nolint
+		}))
+
+		jwks, err := NewRemoteJWKS(context.Background(), nil, ts.URL)
+		So(err, ShouldBeNil)
+		So(len(jwks.Keys), ShouldEqual, 2)
+
+		So(jwks.Keys[0].KTY, ShouldEqual, "RSA")
+		So(jwks.Keys[0].Alg, ShouldEqual, "PS256")
+		So(jwks.Keys[0].PublicKey(), ShouldResemble, rsaCert.PublicKey)
+
+		So(jwks.Keys[1].KTY, ShouldEqual, "OKP")
+		So(jwks.Keys[1].CRV, ShouldEqual, "Ed25519")
+		So(jwks.Keys[1].Alg, ShouldEqual, "EdDSA")
+		So(jwks.Keys[1].PublicKey(), ShouldResemble, edCert.PublicKey)
+	})
+
+	Convey("Given a http server that returns an invalid RSA key", t, func() {
+
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			j := NewJWKS()
+			j.Keys = []*JWKSKey{
+				{
+					KTY: "RSA",
+					N:   "oh no..",
+					E:   "AQAB",
+				},
+			}
+
+			d, _ := json.Marshal(j)
+			w.Write(d) // nolint
+		}))
+
+		jwks, err := NewRemoteJWKS(context.Background(), nil, ts.URL)
+		So(jwks, ShouldBeNil)
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldEqual, `remote jwks error: unable to decode N: illegal base64 data at input byte 2`)
+	})
+
+	Convey("Given a http server that returns an Ed25519 key with an invalid size", t, func() {
+
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			j := NewJWKS()
+			j.Keys = []*JWKSKey{
+				{
+					KTY: "OKP",
+					CRV: "Ed25519",
+					X:   "AQAB",
+				},
+			}
+
+			d, _ := json.Marshal(j)
+			w.Write(d) // nolint
+		}))
+
+		jwks, err := NewRemoteJWKS(context.Background(), nil, ts.URL)
+		So(jwks, ShouldBeNil)
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldEqual, `remote jwks error: invalid ed25519 key size: 3`)
+	})
+}
+
+func TestParseJWKS(t *testing.T) {
+
+	Convey("Given I call the function with invalid data", t, func() {
+
+		jwks, err := ParseJWKS([]byte("not json"))
+		So(jwks, ShouldBeNil)
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldStartWith, `unable to parse jwks: `)
+	})
+
+	Convey("Given I call the function with an invalid RSA key", t, func() {
+
+		jwks, err := ParseJWKS([]byte(`{"keys":[{"kty":"RSA","n":"oh no..","e":"AQAB"}]}`))
+		So(jwks, ShouldBeNil)
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldEqual, `unable to decode N: illegal base64 data at input byte 2`)
+	})
+
+	Convey("Given I call the function with an RSA key that is too small", t, func() {
+
+		n := base64.RawURLEncoding.EncodeToString(new(big.Int).Lsh(big.NewInt(1), 1023).Bytes())
+
+		jwks, err := ParseJWKS([]byte(`{"keys":[{"kty":"RSA","n":"` + n + `","e":"AQAB"}]}`))
+		So(jwks, ShouldBeNil)
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldEqual, `invalid rsa key size 1024: rsa key must be at least 2048 bits`)
+		So(errors.Is(err, ErrJWKSKeyTooSmall), ShouldBeTrue)
+	})
+
+	Convey("Given I call the function with RSA keys with an invalid exponent", t, func() {
+
+		n := base64.RawURLEncoding.EncodeToString(new(big.Int).Lsh(big.NewInt(1), 2047).Bytes())
+
+		for _, e := range []string{
+			"",
+			base64.RawURLEncoding.EncodeToString([]byte{0}),
+			base64.RawURLEncoding.EncodeToString([]byte{1}),
+			base64.RawURLEncoding.EncodeToString([]byte{1, 0, 0}),
+			base64.RawURLEncoding.EncodeToString([]byte{0x80, 0, 0, 1}),
+			base64.RawURLEncoding.EncodeToString([]byte{1, 0, 0, 0, 0, 0, 0, 0, 1}),
+		} {
+			jwks, err := ParseJWKS([]byte(`{"keys":[{"kty":"RSA","n":"` + n + `","e":"` + e + `"}]}`))
+			So(jwks, ShouldBeNil)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, `invalid rsa exponent '`+e+`'`)
+		}
+	})
+
+	Convey("Given I call the function with an OKP key that is not Ed25519", t, func() {
+
+		x := base64.RawURLEncoding.EncodeToString(make([]byte, 32))
+
+		jwks, err := ParseJWKS([]byte(`{"keys":[{"kty":"OKP","crv":"X25519","x":"` + x + `"}]}`))
+		So(jwks, ShouldBeNil)
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldEqual, `unsupported okp curve: 'X25519'`)
+	})
+
+	Convey("Given I call the function with a valid JWKS", t, func() {
+
+		rsaCert, _ := getRSACert()
+
+		j := NewJWKS()
+		_ = j.Append(rsaCert)
+		d, _ := json.Marshal(j)
+
+		jwks, err := ParseJWKS(d)
+		So(err, ShouldBeNil)
+		So(len(jwks.Keys), ShouldEqual, 1)
+		So(jwks.keyMap, ShouldContainKey, jwks.Keys[0].KID)
+		So(jwks.Keys[0].PublicKey(), ShouldResemble, rsaCert.PublicKey)
+	})
 }
 
 func TestJWKSKeyCurve(t *testing.T) {
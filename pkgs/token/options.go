@@ -0,0 +1,28 @@
+package token
+
+type keyConfig struct {
+	schedule  KeySchedule
+	algorithm string
+}
+
+// A KeyOption can be used to configure a key added to a JWKS.
+type KeyOption func(*keyConfig)
+
+// OptionKeySchedule sets the KeySchedule deciding when the key
+// is published, used to sign and removed from the JWKS.
+// The default is a key that is always active.
+func OptionKeySchedule(schedule KeySchedule) KeyOption {
+	return func(cfg *keyConfig) {
+		cfg.schedule = schedule
+	}
+}
+
+// OptionKeyAlgorithm sets the JWT signing algorithm to use with the key.
+// The algorithm must be compatible with the type of the key.
+// If not set, ES256, ES384 or ES512 will be used for ECDSA keys
+// depending on their curve, RS256 for RSA keys and EdDSA for Ed25519 keys.
+func OptionKeyAlgorithm(alg string) KeyOption {
+	return func(cfg *keyConfig) {
+		cfg.algorithm = alg
+	}
+}
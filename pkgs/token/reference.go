@@ -0,0 +1,35 @@
+package token
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
+
+// ReferencePrefix is the prefix of the opaque reference tokens.
+const ReferencePrefix = "a3sr_"
+
+// A ReferenceResolver resolves an opaque reference
+// token into the signed token it refers to.
+type ReferenceResolver interface {
+	Resolve(ctx context.Context, reference string) (string, error)
+}
+
+// NewReference returns a new random opaque reference token.
+func NewReference() (string, error) {
+
+	data := make([]byte, 32)
+	if _, err := rand.Read(data); err != nil {
+		return "", fmt.Errorf("unable to generate reference: %w", err)
+	}
+
+	return ReferencePrefix + base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// IsReference returns true if the given token
+// is an opaque reference token.
+func IsReference(tokenString string) bool {
+	return strings.HasPrefix(tokenString, ReferencePrefix)
+}
@@ -0,0 +1,26 @@
+package token
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestReference(t *testing.T) {
+
+	Convey("Calling NewReference should work", t, func() {
+
+		ref1, err := NewReference()
+		So(err, ShouldBeNil)
+		So(IsReference(ref1), ShouldBeTrue)
+		So(len(ref1), ShouldEqual, len(ReferencePrefix)+43)
+
+		ref2, err := NewReference()
+		So(err, ShouldBeNil)
+		So(ref2, ShouldNotEqual, ref1)
+	})
+
+	Convey("Calling IsReference on a jwt should return false", t, func() {
+		So(IsReference("aaa.bbb.ccc"), ShouldBeFalse)
+	})
+}
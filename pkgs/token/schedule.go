@@ -0,0 +1,82 @@
+package token
+
+import (
+	"time"
+)
+
+// A KeyState represents the rotation state of a key
+// held in a JWKS.
+type KeyState string
+
+// Various values for KeyState.
+const (
+	// KeyStatePending means the key is not yet published
+	// in the JWKS and cannot be used for anything.
+	KeyStatePending KeyState = "Pending"
+
+	// KeyStatePublished means the key is published in the JWKS
+	// and can be used for verification, but it is not used
+	// to sign new tokens yet.
+	KeyStatePublished KeyState = "Published"
+
+	// KeyStateActive means the key is published and used
+	// to sign new tokens.
+	KeyStateActive KeyState = "Active"
+
+	// KeyStateRetired means the key is not used to sign new
+	// tokens anymore, but is still published so tokens signed
+	// with it can be verified until they expire.
+	KeyStateRetired KeyState = "Retired"
+
+	// KeyStateExpired means the key has been removed from the
+	// published JWKS and cannot be used for anything.
+	KeyStateExpired KeyState = "Expired"
+)
+
+// A KeySchedule holds the rotation schedule of a key.
+// Any zero time means the corresponding boundary is unset:
+// a zero PublishTime or ActivateTime means since forever and
+// a zero RetireTime or ExpireTime means never.
+type KeySchedule struct {
+	PublishTime  time.Time
+	ActivateTime time.Time
+	RetireTime   time.Time
+	ExpireTime   time.Time
+}
+
+// State returns the KeyState of the schedule at the given time.
+func (s KeySchedule) State(now time.Time) KeyState {
+
+	switch {
+	case !s.ExpireTime.IsZero() && !now.Before(s.ExpireTime):
+		return KeyStateExpired
+	case !s.RetireTime.IsZero() && !now.Before(s.RetireTime):
+		return KeyStateRetired
+	case !s.PublishTime.IsZero() && now.Before(s.PublishTime):
+		return KeyStatePending
+	case !s.ActivateTime.IsZero() && now.Before(s.ActivateTime):
+		return KeyStatePublished
+	default:
+		return KeyStateActive
+	}
+}
+
+// Validate verifies the schedule boundaries are in a
+// coherent order.
+func (s KeySchedule) Validate() error {
+
+	bounds := []time.Time{s.PublishTime, s.ActivateTime, s.RetireTime, s.ExpireTime}
+
+	var last time.Time
+	for _, b := range bounds {
+		if b.IsZero() {
+			continue
+		}
+		if b.Before(last) {
+			return ErrJWKSInvalidSchedule
+		}
+		last = b
+	}
+
+	return nil
+}
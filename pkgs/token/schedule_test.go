@@ -0,0 +1,178 @@
+package token
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestKeyScheduleState(t *testing.T) {
+
+	now := time.Now()
+
+	Convey("Given I have an empty schedule", t, func() {
+		s := KeySchedule{}
+		So(s.State(now), ShouldEqual, KeyStateActive)
+		So(s.Validate(), ShouldBeNil)
+	})
+
+	Convey("Given I have a full schedule", t, func() {
+
+		s := KeySchedule{
+			PublishTime:  now.Add(1 * time.Hour),
+			ActivateTime: now.Add(2 * time.Hour),
+			RetireTime:   now.Add(3 * time.Hour),
+			ExpireTime:   now.Add(4 * time.Hour),
+		}
+
+		So(s.Validate(), ShouldBeNil)
+		So(s.State(now), ShouldEqual, KeyStatePending)
+		So(s.State(now.Add(1*time.Hour)), ShouldEqual, KeyStatePublished)
+		So(s.State(now.Add(90*time.Minute)), ShouldEqual, KeyStatePublished)
+		So(s.State(now.Add(2*time.Hour)), ShouldEqual, KeyStateActive)
+		So(s.State(now.Add(3*time.Hour)), ShouldEqual, KeyStateRetired)
+		So(s.State(now.Add(4*time.Hour)), ShouldEqual, KeyStateExpired)
+	})
+
+	Convey("Given I have a schedule with no publish time", t, func() {
+
+		s := KeySchedule{
+			ActivateTime: now.Add(2 * time.Hour),
+		}
+
+		So(s.Validate(), ShouldBeNil)
+		So(s.State(now), ShouldEqual, KeyStatePublished)
+		So(s.State(now.Add(3*time.Hour)), ShouldEqual, KeyStateActive)
+	})
+
+	Convey("Given I have a schedule with no activate time", t, func() {
+
+		s := KeySchedule{
+			PublishTime: now.Add(1 * time.Hour),
+		}
+
+		So(s.Validate(), ShouldBeNil)
+		So(s.State(now), ShouldEqual, KeyStatePending)
+		So(s.State(now.Add(1*time.Hour)), ShouldEqual, KeyStateActive)
+	})
+
+	Convey("Given I have a schedule with boundaries in the wrong order", t, func() {
+
+		s := KeySchedule{
+			ActivateTime: now.Add(2 * time.Hour),
+			RetireTime:   now.Add(1 * time.Hour),
+		}
+
+		So(s.Validate(), ShouldEqual, ErrJWKSInvalidSchedule)
+	})
+}
+
+func TestJWKSRotation(t *testing.T) {
+
+	Convey("Given I have a JWKS with keys in various states", t, func() {
+
+		now := time.Now()
+
+		certRetired, keyRetired := getECCert()
+		certActive, keyActive := getECCert()
+		certPublished, keyPublished := getECCert()
+		certPending, keyPending := getECCert()
+		certExpired, keyExpired := getECCert()
+
+		j := NewJWKS()
+		So(j.AppendWithPrivate(certExpired, keyExpired, OptionKeySchedule(KeySchedule{
+			RetireTime: now.Add(-2 * time.Hour),
+			ExpireTime: now.Add(-1 * time.Hour),
+		})), ShouldBeNil)
+		So(j.AppendWithPrivate(certRetired, keyRetired, OptionKeySchedule(KeySchedule{
+			RetireTime: now.Add(-1 * time.Hour),
+			ExpireTime: now.Add(1 * time.Hour),
+		})), ShouldBeNil)
+		So(j.AppendWithPrivate(certActive, keyActive, OptionKeySchedule(KeySchedule{
+			ActivateTime: now.Add(-1 * time.Hour),
+			RetireTime:   now.Add(1 * time.Hour),
+		})), ShouldBeNil)
+		So(j.AppendWithPrivate(certPublished, keyPublished, OptionKeySchedule(KeySchedule{
+			PublishTime:  now.Add(-1 * time.Hour),
+			ActivateTime: now.Add(1 * time.Hour),
+		})), ShouldBeNil)
+		So(j.AppendWithPrivate(certPending, keyPending, OptionKeySchedule(KeySchedule{
+			PublishTime:  now.Add(1 * time.Hour),
+			ActivateTime: now.Add(2 * time.Hour),
+		})), ShouldBeNil)
+
+		Convey("Then GetActive should return the active key", func() {
+			k := j.GetActive()
+			So(k, ShouldNotBeNil)
+			So(k.KID, ShouldEqual, Fingerprint(certActive))
+		})
+
+		Convey("Then Get should only return published keys", func() {
+
+			_, err := j.Get(Fingerprint(certRetired))
+			So(err, ShouldBeNil)
+			_, err = j.Get(Fingerprint(certActive))
+			So(err, ShouldBeNil)
+			_, err = j.Get(Fingerprint(certPublished))
+			So(err, ShouldBeNil)
+
+			_, err = j.Get(Fingerprint(certPending))
+			So(err, ShouldEqual, ErrJWKSNotFound)
+			_, err = j.Get(Fingerprint(certExpired))
+			So(err, ShouldEqual, ErrJWKSNotFound)
+		})
+
+		Convey("Then Published should only contain published keys", func() {
+
+			p := j.Published()
+			So(len(p.Keys), ShouldEqual, 3)
+			So(p.Keys[0].KID, ShouldEqual, Fingerprint(certRetired))
+			So(p.Keys[1].KID, ShouldEqual, Fingerprint(certActive))
+			So(p.Keys[2].KID, ShouldEqual, Fingerprint(certPublished))
+		})
+	})
+
+	Convey("Given I have a JWKS with two active keys", t, func() {
+
+		now := time.Now()
+
+		cert1, key1 := getECCert()
+		cert2, key2 := getECCert()
+
+		j := NewJWKS()
+		So(j.AppendWithPrivate(cert2, key2, OptionKeySchedule(KeySchedule{ActivateTime: now.Add(-1 * time.Hour)})), ShouldBeNil)
+		So(j.AppendWithPrivate(cert1, key1, OptionKeySchedule(KeySchedule{ActivateTime: now.Add(-2 * time.Hour)})), ShouldBeNil)
+
+		Convey("Then GetActive should return the last activated key", func() {
+			So(j.GetActive().KID, ShouldEqual, Fingerprint(cert2))
+		})
+	})
+
+	Convey("Given I have a JWKS with an active key without private key", t, func() {
+
+		cert1, _ := getECCert()
+
+		j := NewJWKS()
+		So(j.Append(cert1), ShouldBeNil)
+
+		Convey("Then GetActive should return nil", func() {
+			So(j.GetActive(), ShouldBeNil)
+		})
+	})
+
+	Convey("Given I append a key with an invalid schedule", t, func() {
+
+		now := time.Now()
+		cert1, key1 := getECCert()
+
+		j := NewJWKS()
+		err := j.AppendWithPrivate(cert1, key1, OptionKeySchedule(KeySchedule{
+			ActivateTime: now,
+			PublishTime:  now.Add(time.Hour),
+		}))
+
+		So(err, ShouldEqual, ErrJWKSInvalidSchedule)
+		So(len(j.Keys), ShouldEqual, 0)
+	})
+}
@@ -0,0 +1,120 @@
+package token
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// signerMethod is a jwt.SigningMethod signing with any crypto.Signer,
+// like keys held by an HSM or an external signing daemon, which the
+// jwt signing methods do not support. Verification is unchanged.
+type signerMethod struct {
+	jwt.SigningMethod
+}
+
+// Sign signs the given signing string with the given crypto.Signer.
+func (m signerMethod) Sign(signingString string, key any) (string, error) {
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return "", jwt.ErrInvalidKeyType
+	}
+
+	sig, err := signWithSigner(m.SigningMethod, signer, []byte(signingString))
+	if err != nil {
+		return "", err
+	}
+
+	return jwt.EncodeSegment(sig), nil
+}
+
+// signingMethodWithKey returns the jwt.SigningMethod able to
+// sign using the given key. If the key is natively supported by
+// the given method, it is returned as is.
+func signingMethodWithKey(method jwt.SigningMethod, key crypto.PrivateKey) jwt.SigningMethod {
+
+	switch key.(type) {
+	case *ecdsa.PrivateKey, *rsa.PrivateKey, ed25519.PrivateKey:
+		return method
+	case crypto.Signer:
+		return signerMethod{SigningMethod: method}
+	default:
+		return method
+	}
+}
+
+func signWithSigner(method jwt.SigningMethod, signer crypto.Signer, data []byte) ([]byte, error) {
+
+	switch m := method.(type) {
+
+	case *jwt.SigningMethodEd25519:
+		return signer.Sign(rand.Reader, data, crypto.Hash(0))
+
+	case *jwt.SigningMethodRSAPSS:
+		digest, err := hash(m.Hash, data)
+		if err != nil {
+			return nil, err
+		}
+		return signer.Sign(rand.Reader, digest, &rsa.PSSOptions{SaltLength: m.Options.SaltLength, Hash: m.Hash})
+
+	case *jwt.SigningMethodRSA:
+		digest, err := hash(m.Hash, data)
+		if err != nil {
+			return nil, err
+		}
+		return signer.Sign(rand.Reader, digest, m.Hash)
+
+	case *jwt.SigningMethodECDSA:
+		digest, err := hash(m.Hash, data)
+		if err != nil {
+			return nil, err
+		}
+
+		der, err := signer.Sign(rand.Reader, digest, m.Hash)
+		if err != nil {
+			return nil, err
+		}
+
+		// crypto.Signer returns ASN.1 encoded ecdsa signatures,
+		// while jws uses the concatenation of r and s.
+		var sig struct {
+			R, S *big.Int
+		}
+		if _, err := asn1.Unmarshal(der, &sig); err != nil {
+			return nil, fmt.Errorf("unable to decode ecdsa signature: %w", err)
+		}
+
+		if sig.R == nil || sig.S == nil || sig.R.BitLen() > 8*m.KeySize || sig.S.BitLen() > 8*m.KeySize {
+			return nil, fmt.Errorf("invalid ecdsa signature for %s", m.Alg())
+		}
+
+		out := make([]byte, 2*m.KeySize)
+		sig.R.FillBytes(out[:m.KeySize])
+		sig.S.FillBytes(out[m.KeySize:])
+
+		return out, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported signing method: %s", method.Alg())
+	}
+}
+
+func hash(h crypto.Hash, data []byte) ([]byte, error) {
+
+	if !h.Available() {
+		return nil, jwt.ErrHashUnavailable
+	}
+
+	hasher := h.New()
+	hasher.Write(data) // nolint: errcheck
+
+	return hasher.Sum(nil), nil
+}
@@ -0,0 +1,79 @@
+package token
+
+import (
+	"crypto"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// opaqueSigner hides the type of the wrapped key, like
+// a crypto.Signer backed by an HSM would.
+type opaqueSigner struct {
+	signer crypto.Signer
+}
+
+func (s *opaqueSigner) Public() crypto.PublicKey {
+	return s.signer.Public()
+}
+
+func (s *opaqueSigner) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	return s.signer.Sign(rand, digest, opts)
+}
+
+func TestSigner(t *testing.T) {
+
+	for _, tc := range []struct {
+		name    string
+		getCert func() (*x509.Certificate, crypto.PrivateKey)
+		alg     string
+	}{
+		{"ECDSA", getECCert, ""},
+		{"RSA", getRSACert, ""},
+		{"RSA with PS256", getRSACert, "PS256"},
+		{"Ed25519", getEd25519Cert, ""},
+	} {
+
+		Convey(fmt.Sprintf("Given I have a JWKS with an opaque %s signer", tc.name), t, func() {
+
+			cert, key := tc.getCert()
+			signer := &opaqueSigner{signer: key.(crypto.Signer)}
+
+			keychain := NewJWKS()
+			So(keychain.AppendWithPrivate(cert, signer, OptionKeyAlgorithm(tc.alg)), ShouldBeNil)
+
+			k := keychain.GetActive()
+			So(k.PrivateKey(), ShouldEqual, signer)
+
+			Convey("When I sign a token with it", func() {
+
+				idt := NewIdentityToken(Source{Type: "certificate"})
+				idt.Identity = []string{"commonname=joe"}
+
+				tokenString, err := idt.JWTWithSigningMethod(k.SigningMethod(), k.PrivateKey(), k.KID, "iss", jwt.ClaimStrings{"aud"}, time.Now().Add(10*time.Second), nil)
+				So(err, ShouldBeNil)
+
+				Convey("Then I should be able to verify it", func() {
+					idt, err := Parse(tokenString, keychain, "iss", "aud")
+					So(err, ShouldBeNil)
+					So(idt.Identity, ShouldContain, "commonname=joe")
+				})
+			})
+		})
+	}
+
+	Convey("Given I have a signer that does not match the certificate", t, func() {
+
+		cert, _ := getECCert()
+		_, key := getECCert()
+
+		keychain := NewJWKS()
+		So(keychain.AppendWithPrivate(cert, &opaqueSigner{signer: key.(crypto.Signer)}), ShouldEqual, ErrJWKSKeyMismatch)
+		So(len(keychain.Keys), ShouldEqual, 0)
+	})
+}
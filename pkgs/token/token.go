@@ -2,6 +2,7 @@ package token
 
 import (
 	"crypto"
+	"crypto/x509"
 	"fmt"
 	"sort"
 	"strings"
@@ -25,6 +26,36 @@ type Source struct {
 	Name      string `json:"name,omitempty"`
 }
 
+// A Confirmation represents the confirmation claim of a token
+// bound to a client certificate, as described by RFC 8705.
+type Confirmation struct {
+	X5TS256 string `json:"x5t#S256,omitempty"`
+}
+
+// NewConfirmation returns a Confirmation binding
+// a token to the given certificate.
+func NewConfirmation(cert *x509.Certificate) *Confirmation {
+	return &Confirmation{
+		X5TS256: Thumbprint(cert),
+	}
+}
+
+// Matches returns true if the confirmation
+// binds the token to the given certificate.
+func (c *Confirmation) Matches(cert *x509.Certificate) bool {
+	return cert != nil && c.X5TS256 != "" && c.X5TS256 == Thumbprint(cert)
+}
+
+// An Actor represents the party acting on behalf of the subject
+// of a token, as described by RFC 8693. If the actor was itself
+// acting on behalf of another party when the token was exchanged,
+// the chain continues in the nested Actor.
+type Actor struct {
+	Subject  string   `json:"sub,omitempty"`
+	Identity []string `json:"identity,omitempty"`
+	Actor    *Actor   `json:"act,omitempty"`
+}
+
 // An IdentityToken represents a normalized identity token.
 type IdentityToken struct {
 
@@ -41,6 +72,23 @@ type IdentityToken struct {
 	// Restrictions applied on dynamically computed permissions.
 	Restrictions *permissions.Restrictions `json:"restrictions,omitempty"`
 
+	// The ID of the family of the refresh token the token has been
+	// issued from. The family ID is the ID of the first refresh
+	// token of the family.
+	RefreshFamily string `json:"refreshFamily,omitempty"`
+
+	// The confirmation claim binding the token to a
+	// client certificate.
+	Confirmation *Confirmation `json:"cnf,omitempty"`
+
+	// The party acting on behalf of the subject
+	// of the token, if the token has been exchanged.
+	Actor *Actor `json:"act,omitempty"`
+
+	// The authentication methods used to authenticate the
+	// subject of the token, as described by RFC 8176.
+	AMR []string `json:"amr,omitempty"`
+
 	// Information relative to the autentication source used to
 	// validate bearer's Identity.
 	Source Source `json:"-"`
@@ -130,8 +178,28 @@ func finalizeTokenParsing(idt *IdentityToken) error {
 // then any current value will be kept (potentially ending in an already expired token if the current value is
 // also zero).
 // cloak, if not empty, will remove any identity claims that are not prefixed with any string from the array.
+// If the token has an Actor, the identity claims of the actor are added prefixed by @actor:, whatever the cloak.
+// The signing method is derived from the type of the key: ES256, ES384 or ES512 for ECDSA keys depending
+// on their curve, RS256 for RSA keys and EdDSA for Ed25519 keys. Use JWTWithSigningMethod to use another one.
+// The key can be any crypto.Signer, like a key held by an HSM or an external signing daemon.
 func (t *IdentityToken) JWT(key crypto.PrivateKey, kid string, issuer string, audience jwt.ClaimStrings, exp time.Time, cloak []string) (string, error) {
 
+	method, err := signingMethodForKey(key)
+	if err != nil {
+		return "", err
+	}
+
+	return t.JWTWithSigningMethod(method, key, kid, issuer, audience, exp, cloak)
+}
+
+// JWTWithSigningMethod works like JWT, but signs the token using the given jwt.SigningMethod.
+// The method must be compatible with the given crypto.PrivateKey.
+func (t *IdentityToken) JWTWithSigningMethod(method jwt.SigningMethod, key crypto.PrivateKey, kid string, issuer string, audience jwt.ClaimStrings, exp time.Time, cloak []string) (string, error) {
+
+	if method == nil {
+		return "", fmt.Errorf("invalid signing method: nil")
+	}
+
 	t.ID = uuid.Must(uuid.NewV4()).String()
 	t.IssuedAt = jwt.NewNumericDate(time.Now())
 	t.Issuer = issuer
@@ -169,7 +237,13 @@ func (t *IdentityToken) JWT(key crypto.PrivateKey, kid string, issuer string, au
 
 	t.Identity = append(t.Identity, fmt.Sprintf("@issuer=%s", t.Issuer))
 
-	j := jwt.NewWithClaims(jwt.SigningMethodES256, t)
+	if t.Actor != nil {
+		for _, c := range t.Actor.Identity {
+			t.Identity = append(t.Identity, fmt.Sprintf("@actor:%s", c))
+		}
+	}
+
+	j := jwt.NewWithClaims(signingMethodWithKey(method, key), t)
 
 	if kid != "" {
 		j.Header["kid"] = kid
@@ -180,6 +254,22 @@ func (t *IdentityToken) JWT(key crypto.PrivateKey, kid string, issuer string, au
 	return j.SignedString(key)
 }
 
+// StripReservedClaims returns the given identity claims without the
+// reserved claims that are added when a token is signed, namely the
+// @source, @issuer and @actor claims.
+func StripReservedClaims(claims []string) []string {
+
+	out := make([]string, 0, len(claims))
+	for _, c := range claims {
+		if strings.HasPrefix(c, "@source:") || strings.HasPrefix(c, "@issuer=") || strings.HasPrefix(c, "@actor:") {
+			continue
+		}
+		out = append(out, c)
+	}
+
+	return out
+}
+
 // Restrict applies the given permissions to the token. If the token is not already restricted
 // the restrictions will be applied as is. If it is already restricted, the new restrictions will
 // be applied over the existing ones, and the function will return an error if the requested
@@ -2,10 +2,16 @@ package token
 
 import (
 	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"encoding/base64"
 	"encoding/pem"
 	"fmt"
+	"math/big"
 	"reflect"
 	"testing"
 	"time"
@@ -36,6 +42,48 @@ func getECCert() (*x509.Certificate, crypto.PrivateKey) {
 	return cert, key
 }
 
+func getSelfSignedCert(public crypto.PublicKey, private crypto.PrivateKey) *x509.Certificate {
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(42),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, public, private)
+	if err != nil {
+		panic(err)
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		panic(err)
+	}
+
+	return cert
+}
+
+func getRSACert() (*x509.Certificate, crypto.PrivateKey) {
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+
+	return getSelfSignedCert(&key.PublicKey, key), key
+}
+
+func getEd25519Cert() (*x509.Certificate, crypto.PrivateKey) {
+
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+
+	return getSelfSignedCert(public, private), private
+}
+
 func TestNewIdentityToken(t *testing.T) {
 
 	Convey("Given I create a new Midgard claims", t, func() {
@@ -559,3 +607,199 @@ func TestIdentityToken_Restrict(t *testing.T) {
 		})
 	}
 }
+
+func TestSigningAlgorithms(t *testing.T) {
+
+	makeToken := func() *IdentityToken {
+		idt := NewIdentityToken(Source{Type: "certificate"})
+		idt.Identity = []string{"commonname=joe"}
+		return idt
+	}
+
+	for _, tc := range []struct {
+		name    string
+		getCert func() (*x509.Certificate, crypto.PrivateKey)
+		alg     string
+		wantAlg string
+	}{
+		{"ECDSA", getECCert, "", "ES256"},
+		{"RSA", getRSACert, "", "RS256"},
+		{"RSA with PS256", getRSACert, "PS256", "PS256"},
+		{"Ed25519", getEd25519Cert, "", "EdDSA"},
+	} {
+
+		Convey(fmt.Sprintf("Given I have a JWKS with a %s key", tc.name), t, func() {
+
+			cert, key := tc.getCert()
+			kid := Fingerprint(cert)
+
+			keychain := NewJWKS()
+			So(keychain.AppendWithPrivate(cert, key, OptionKeyAlgorithm(tc.alg)), ShouldBeNil)
+
+			k := keychain.GetActive()
+			So(k, ShouldNotBeNil)
+			So(k.Alg, ShouldEqual, tc.wantAlg)
+			So(k.SigningMethod().Alg(), ShouldEqual, tc.wantAlg)
+
+			Convey("When I sign a token using the key signing method", func() {
+
+				tokenString, err := makeToken().JWTWithSigningMethod(k.SigningMethod(), k.PrivateKey(), kid, "iss", jwt.ClaimStrings{"aud"}, time.Now().Add(10*time.Second), nil)
+				So(err, ShouldBeNil)
+
+				Convey("Then I should be able to verify it", func() {
+					idt, err := Parse(tokenString, keychain, "iss", "aud")
+					So(err, ShouldBeNil)
+					So(idt.Identity, ShouldContain, "commonname=joe")
+				})
+			})
+		})
+	}
+
+	Convey("Given I have a JWKS with a RSA key configured for PS256", t, func() {
+
+		cert, key := getRSACert()
+		kid := Fingerprint(cert)
+
+		keychain := NewJWKS()
+		So(keychain.AppendWithPrivate(cert, key, OptionKeyAlgorithm("PS256")), ShouldBeNil)
+
+		Convey("When I sign a token using RS256", func() {
+
+			tokenString, err := makeToken().JWT(key, kid, "iss", jwt.ClaimStrings{"aud"}, time.Now().Add(10*time.Second), nil)
+			So(err, ShouldBeNil)
+
+			Convey("Then verification should fail", func() {
+				idt, err := Parse(tokenString, keychain, "iss", "aud")
+				So(idt, ShouldBeNil)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, fmt.Sprintf("unable to parse jwt: signing method RS256 is not allowed for kid '%s'", kid))
+			})
+		})
+	})
+
+	Convey("Given I try to use an algorithm that does not match the key type", t, func() {
+
+		cert, key := getECCert()
+		keychain := NewJWKS()
+
+		So(keychain.AppendWithPrivate(cert, key, OptionKeyAlgorithm("RS256")), ShouldEqual, ErrJWKSInvalidAlgorithm)
+		So(keychain.AppendWithPrivate(cert, key, OptionKeyAlgorithm("ES384")), ShouldEqual, ErrJWKSInvalidAlgorithm)
+		So(keychain.AppendWithPrivate(cert, key, OptionKeyAlgorithm("HS256")), ShouldEqual, ErrJWKSInvalidAlgorithm)
+		So(keychain.AppendWithPrivate(cert, key, OptionKeyAlgorithm("nope")), ShouldEqual, ErrJWKSInvalidAlgorithm)
+		So(len(keychain.Keys), ShouldEqual, 0)
+	})
+
+	Convey("Given I try to use an RSA key that is too small", t, func() {
+
+		key, err := rsa.GenerateKey(rand.Reader, 1024)
+		So(err, ShouldBeNil)
+		cert := getSelfSignedCert(&key.PublicKey, key)
+		keychain := NewJWKS()
+
+		So(keychain.AppendWithPrivate(cert, key), ShouldEqual, ErrJWKSKeyTooSmall)
+		So(len(keychain.Keys), ShouldEqual, 0)
+	})
+
+	Convey("Given I call JWT with an unsupported key", t, func() {
+
+		_, err := makeToken().JWT("not a key", "kid", "iss", jwt.ClaimStrings{"aud"}, time.Now().Add(10*time.Second), nil)
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldEqual, "unsupported private key type: string")
+	})
+}
+
+func TestConfirmation(t *testing.T) {
+
+	Convey("Given I have a certificate and a confirmation", t, func() {
+
+		cert1, key1 := getECCert()
+		cert2, _ := getECCert()
+
+		cnf := NewConfirmation(cert1)
+
+		Convey("Then the thumbprint should be correct", func() {
+			sum := sha256.Sum256(cert1.Raw)
+			So(cnf.X5TS256, ShouldEqual, base64.RawURLEncoding.EncodeToString(sum[:]))
+		})
+
+		Convey("Then it should only match the bound certificate", func() {
+			So(cnf.Matches(cert1), ShouldBeTrue)
+			So(cnf.Matches(cert2), ShouldBeFalse)
+			So(cnf.Matches(nil), ShouldBeFalse)
+			So((&Confirmation{}).Matches(cert1), ShouldBeFalse)
+		})
+
+		Convey("Then it should be kept in the signed token", func() {
+
+			jwks := NewJWKS()
+			_ = jwks.Append(cert1)
+
+			idt := NewIdentityToken(Source{Type: "mtls"})
+			idt.Confirmation = cnf
+
+			tkn, err := idt.JWT(key1, Fingerprint(cert1), "iss", jwt.ClaimStrings{"aud"}, time.Now().Add(time.Hour), nil)
+			So(err, ShouldBeNil)
+
+			parsed, err := Parse(tkn, jwks, "iss", "aud")
+			So(err, ShouldBeNil)
+			So(parsed.Confirmation, ShouldResemble, cnf)
+		})
+	})
+}
+
+func TestActor(t *testing.T) {
+
+	Convey("Given I have a token with an actor chain", t, func() {
+
+		cert, key := getECCert()
+		jwks := NewJWKS()
+		_ = jwks.Append(cert)
+
+		idt := NewIdentityToken(Source{Type: "mtls"})
+		idt.Identity = []string{"user=john"}
+		idt.Actor = &Actor{
+			Subject:  "service-a",
+			Identity: []string{"app=service-a", "@source:type=mtls"},
+			Actor: &Actor{
+				Subject:  "service-b",
+				Identity: []string{"app=service-b"},
+			},
+		}
+
+		Convey("When I sign it with a cloak", func() {
+
+			tkn, err := idt.JWT(key, Fingerprint(cert), "iss", jwt.ClaimStrings{"aud"}, time.Now().Add(time.Hour), []string{"user="})
+			So(err, ShouldBeNil)
+
+			parsed, err := Parse(tkn, jwks, "iss", "aud")
+			So(err, ShouldBeNil)
+
+			Convey("Then the actor claims should be kept", func() {
+				So(parsed.Identity, ShouldResemble, []string{
+					"@actor:@source:type=mtls",
+					"@actor:app=service-a",
+					"@issuer=iss",
+					"@source:type=mtls",
+					"user=john",
+				})
+			})
+
+			Convey("Then the actor chain should be kept", func() {
+				So(parsed.Actor.Subject, ShouldEqual, "service-a")
+				So(parsed.Actor.Actor.Subject, ShouldEqual, "service-b")
+				So(parsed.Actor.Actor.Identity, ShouldResemble, []string{"app=service-b"})
+			})
+		})
+	})
+}
+
+func TestStripReservedClaims(t *testing.T) {
+
+	Convey("Calling StripReservedClaims should work", t, func() {
+		So(
+			StripReservedClaims([]string{"a=b", "@source:type=mtls", "@issuer=iss", "@actor:c=d", "@other=e"}),
+			ShouldResemble,
+			[]string{"a=b", "@other=e"},
+		)
+	})
+}
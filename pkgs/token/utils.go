@@ -2,12 +2,16 @@ package token
 
 import (
 	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
 	"crypto/sha256"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/base64"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/golang-jwt/jwt/v4"
 	"go.aporeto.io/bahamut"
@@ -40,25 +44,34 @@ func Fingerprint(cert *x509.Certificate) string {
 	return fmt.Sprintf("%02X", sha256.Sum256(cert.Raw)) // #nosec
 }
 
+// Thumbprint returns the base64url encoded SHA-256 thumbprint of the
+// given certificate, as used in the x5t#S256 confirmation claim.
+// It is the digest used by Fingerprint, encoded as RFC 8705 requires.
+func Thumbprint(cert *x509.Certificate) string {
+
+	sum := sha256.Sum256(cert.Raw)
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
 // JWKSFromTokenIssuer will retrieve a remote JWKS from the issuer field
 // in the given idt, using the eventually given tlsConfig to retrieve the JWKS..
+// If the issuer serves an OpenID Connect discovery document, the JWKS is
+// retrieved from its jwks_uri. Otherwise, the well known JWKS path is used.
 // You usually want to pass a non verified IdentityToken here (from ParseUnverified for instance)
 // so you can then correctly verify it using Parse().
 func JWKSFromTokenIssuer(ctx context.Context, idt *IdentityToken, tlsConfig *tls.Config) (*JWKS, error) {
 
-	wellKnownSuffix := ".well-known/jwks.json"
-
-	endpoint := idt.Issuer
-	if !strings.HasSuffix(endpoint, wellKnownSuffix) {
-		endpoint = fmt.Sprintf("%s/%s", strings.TrimRight(endpoint, "/"), wellKnownSuffix)
-	}
-
 	client := &http.Client{
 		Transport: &http.Transport{
 			TLSClientConfig: tlsConfig,
 		},
 	}
 
+	endpoint, err := DiscoverJWKSURL(ctx, client, idt.Issuer, idt.Issuer)
+	if err != nil {
+		return nil, fmt.Errorf("unable to discover remote jwks: %w", err)
+	}
+
 	jwks, err := NewRemoteJWKS(ctx, client, endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("unable to retrieve remote jwks: %w", err)
@@ -71,7 +84,9 @@ func makeKeyFunc(keychain *JWKS) jwt.Keyfunc {
 
 	return func(token *jwt.Token) (any, error) {
 
-		if _, ok := token.Method.(*jwt.SigningMethodECDSA); !ok {
+		switch token.Method.(type) {
+		case *jwt.SigningMethodECDSA, *jwt.SigningMethodRSA, *jwt.SigningMethodRSAPSS, *jwt.SigningMethodEd25519:
+		default:
 			return nil, fmt.Errorf("unexpected signing method: %s", token.Header["alg"])
 		}
 
@@ -85,6 +100,43 @@ func makeKeyFunc(keychain *JWKS) jwt.Keyfunc {
 			return nil, fmt.Errorf("unable to find kid '%s': %w", kid, err)
 		}
 
+		// If the key declares its algorithm, we only accept
+		// this one. Otherwise, we make sure the method at least
+		// matches the type of the key.
+		if (k.Alg != "" && k.Alg != token.Method.Alg()) || !k.isCompatible(token.Method) {
+			return nil, fmt.Errorf("signing method %s is not allowed for kid '%s'", token.Method.Alg(), kid)
+		}
+
 		return k.PublicKey(), nil
 	}
 }
+
+// signingMethodForKey returns the default jwt.SigningMethod
+// to use with the given crypto.PrivateKey.
+func signingMethodForKey(key crypto.PrivateKey) (jwt.SigningMethod, error) {
+
+	var public crypto.PublicKey
+	if signer, ok := key.(crypto.Signer); ok {
+		public = signer.Public()
+	}
+
+	switch public := public.(type) {
+	case *ecdsa.PublicKey:
+		switch public.Curve.Params().Name {
+		case "P-256":
+			return jwt.SigningMethodES256, nil
+		case "P-384":
+			return jwt.SigningMethodES384, nil
+		case "P-521":
+			return jwt.SigningMethodES512, nil
+		default:
+			return nil, fmt.Errorf("unsupported ecdsa curve: %s", public.Curve.Params().Name)
+		}
+	case *rsa.PublicKey:
+		return jwt.SigningMethodRS256, nil
+	case ed25519.PublicKey:
+		return jwt.SigningMethodEdDSA, nil
+	default:
+		return nil, fmt.Errorf("unsupported private key type: %T", key)
+	}
+}
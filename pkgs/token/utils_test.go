@@ -180,6 +180,10 @@ func TestJWKSFromTokenIssuer(t *testing.T) {
 	Convey("given a working remote jwks", t, func() {
 
 		ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if req.URL.Path == "/"+WellKnownDiscoveryPath {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
 			j := NewJWKS()
 			j.Keys = []*JWKSKey{
 				{
@@ -208,7 +212,7 @@ func TestJWKSFromTokenIssuer(t *testing.T) {
 			)
 
 			So(err, ShouldNotBeNil)
-			So(err.Error(), ShouldStartWith, "unable to retrieve remote jwks: remote jwks error: unable to send request:")
+			So(err.Error(), ShouldStartWith, "unable to discover remote jwks: remote discovery error: unable to send request:")
 			So(jwks, ShouldBeNil)
 		})
 